@@ -2,9 +2,7 @@ package main
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"log"
+	"log/slog"
 	"os"
 
 	"github.com/aws/aws-lambda-go/events"
@@ -12,8 +10,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 
-	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/api"
+	"github.com/luck-tech/kanji-log/backend/internal/audit"
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
 	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
 	"github.com/luck-tech/kanji-log/backend/internal/repository"
 )
 
@@ -25,167 +26,97 @@ var (
 
 	// eventHandler はイベント作成のビジネスロジック処理
 	eventHandler *handler.EventHandler
+
+	// authenticator はリクエストの認証処理
+	authenticator *auth.Authenticator
+
+	// idempotencyRepo は Idempotency-Key ごとのレスポンスの保存先
+	// IDEMPOTENCY_TABLE_NAME が未設定の場合は nil（Idempotency-Key を無視する）
+	idempotencyRepo repository.IdempotencyRepository
 )
 
-// init はLambda関数起動時に一度だけ実行される初期化関数
+// setup はLambda関数起動時に一度だけ実行される初期化関数
 // AWS SDK設定、DynamoDBクライアント初期化、依存関係注入を実行
-func init() {
+// テストではsetupを呼ばず、eventHandlerにインメモリリポジトリを注入する
+func setup() {
 	// 環境変数からテーブル名を取得
 	tableName = os.Getenv("TABLE_NAME")
 	if tableName == "" {
-		log.Fatal("環境変数 TABLE_NAME が設定されていません")
+		fatal("環境変数 TABLE_NAME が設定されていません")
 	}
 
 	// AWS SDK v2の設定を読み込み
 	// Lambda環境では自動的にIAMロールの認証情報が使用される
 	cfg, err := config.LoadDefaultConfig(context.TODO())
 	if err != nil {
-		log.Fatalf("AWS設定の読み込みに失敗: %v", err)
+		fatal("AWS設定の読み込みに失敗", slog.Any("error", err))
 	}
 
 	// DynamoDBクライアントを初期化
 	dynamoClient := dynamodb.NewFromConfig(cfg)
 
 	// リポジトリ層とハンドラー層を初期化（依存関係注入）
-	eventRepo := repository.NewDynamoDBEventRepository(dynamoClient, tableName)
-	eventHandler = handler.NewEventHandler(eventRepo)
+	var eventRepo repository.EventRepository = repository.NewDynamoDBEventRepository(dynamoClient, tableName)
 
-	log.Printf("Lambda関数が初期化されました - テーブル名: %s", tableName)
-}
-
-// handleRequest はAPI Gateway Proxy統合からのリクエストを処理
-// HTTPリクエスト → ビジネスロジック実行 → HTTPレスポンス変換
-func handleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
-	// ログ出力：リクエスト詳細をCloudWatch Logsに記録
-	log.Printf("イベント作成リクエスト受信 - Path: %s, Method: %s", request.Path, request.HTTPMethod)
-	log.Printf("リクエストボディ: %s", request.Body)
-
-	// HTTPメソッドの検証
-	if request.HTTPMethod != "POST" {
-		return createErrorResponse(400, "METHOD_NOT_ALLOWED", "POSTメソッドのみサポートされています", nil), nil
-	}
-
-	// 認証情報の取得と検証
-	// API Gateway Cognitoオーソライザーで認証済みのユーザー情報を取得
-	organizerID, err := extractOrganizerID(request)
-	if err != nil {
-		log.Printf("認証エラー: %v", err)
-		return createErrorResponse(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+	// イベントの作成を監査ログに記録する
+	if auditTable := os.Getenv("AUDIT_TABLE_NAME"); auditTable != "" {
+		eventRepo = audit.NewRecorder(eventRepo, repository.NewDynamoDBAuditRepository(dynamoClient, auditTable))
+	} else {
+		slog.Warn("環境変数 AUDIT_TABLE_NAME が未設定のため監査ログを記録しません")
 	}
+	eventHandler = handler.NewEventHandler(eventRepo)
 
-	// リクエストボディをパース
-	var createReq domain.CreateEventRequest
-	if err := json.Unmarshal([]byte(request.Body), &createReq); err != nil {
-		log.Printf("JSONパースエラー: %v", err)
-		return createErrorResponse(400, "INVALID_JSON", "リクエストボディのJSON形式が正しくありません", map[string]interface{}{
-			"parseError": err.Error(),
-		}), nil
+	// 再送による重複作成を防ぐ冪等性レコードのテーブル
+	if idempotencyTable := os.Getenv("IDEMPOTENCY_TABLE_NAME"); idempotencyTable != "" {
+		idempotencyRepo = repository.NewDynamoDBIdempotencyRepository(dynamoClient, idempotencyTable)
+	} else {
+		slog.Warn("環境変数 IDEMPOTENCY_TABLE_NAME が未設定のため Idempotency-Key を無視します")
 	}
 
-	// ビジネスロジックを実行
-	response, err := eventHandler.CreateEvent(ctx, &createReq, organizerID)
+	// 認証設定を環境変数から読み込み
+	authConfig := auth.LoadConfigFromEnv()
+	authenticator, err = auth.New(authConfig)
 	if err != nil {
-		log.Printf("イベント作成エラー: %v", err)
-		return createErrorResponse(500, "INTERNAL_ERROR", "サーバー内部エラーが発生しました", nil), nil
-	}
-
-	// ビジネスロジックレベルでのエラー（バリデーションエラー等）
-	if !response.Success {
-		statusCode := 400 // バリデーションエラーは400
-		if response.Error.Code == "INTERNAL_ERROR" {
-			statusCode = 500
-		}
-		
-		log.Printf("ビジネスロジックエラー: %s - %s", response.Error.Code, response.Error.Message)
-		if response.Error.Details != nil {
-			log.Printf("エラー詳細: %+v", response.Error.Details)
-		}
-		return createErrorResponse(statusCode, response.Error.Code, response.Error.Message, response.Error.Details), nil
+		fatal("認証設定の初期化に失敗", slog.Any("error", err))
 	}
-
-	// 成功レスポンスを生成
-	responseBody, err := json.Marshal(response)
-	if err != nil {
-		log.Printf("レスポンスJSONマーシャリングエラー: %v", err)
-		return createErrorResponse(500, "RESPONSE_ERROR", "レスポンスの生成に失敗しました", nil), nil
+	if authConfig.DevMode {
+		slog.Warn("開発モードのためヘッダーによる認証を許可しています", slog.String("header", auth.DevHeader))
 	}
 
-	log.Printf("イベント作成成功 - ID: %s, Title: %s", response.Data.ID, response.Data.Title)
-
-	// 成功時のHTTPレスポンス
-	return events.APIGatewayProxyResponse{
-		StatusCode: 201, // Created
-		Headers: map[string]string{
-			"Content-Type":                 "application/json",
-			"Access-Control-Allow-Origin":  "*", // CORS対応（開発用）
-			"Access-Control-Allow-Methods": "POST, OPTIONS",
-			"Access-Control-Allow-Headers": "Content-Type, Authorization",
-		},
-		Body: string(responseBody),
-	}, nil
+	slog.Info("Lambda関数が初期化されました", slog.String("tableName", tableName))
 }
 
-// extractOrganizerID はAPI Gatewayのリクエストコンテキストから認証されたユーザーIDを抽出
-// Cognitoオーソライザーが設定された場合、requestContext.authorizer.claims.subに格納される
-func extractOrganizerID(request events.APIGatewayProxyRequest) (string, error) {
-	// 開発環境・テスト時用の簡易認証
-	// 本番環境ではCognitoオーソライザーを使用するため、この部分は変更される
-	if request.Headers["x-organizer-id"] != "" {
-		return request.Headers["x-organizer-id"], nil
-	}
-
-	// Cognitoオーソライザー使用時の実装（将来）
-	// authorizer := request.RequestContext.Authorizer
-	// if claims, ok := authorizer["claims"].(map[string]interface{}); ok {
-	//     if sub, ok := claims["sub"].(string); ok {
-	//         return sub, nil
-	//     }
-	// }
-
-	return "", fmt.Errorf("認証情報が見つかりません")
+// fatal は初期化エラーをログに記録してプロセスを終了する
+func fatal(msg string, attrs ...any) {
+	slog.Error(msg, attrs...)
+	os.Exit(1)
 }
 
-// createErrorResponse は統一されたエラーレスポンス形式を生成
-func createErrorResponse(statusCode int, code string, message string, details map[string]interface{}) events.APIGatewayProxyResponse {
-	errorResponse := map[string]interface{}{
-		"success": false,
-		"error": map[string]interface{}{
-			"code":    code,
-			"message": message,
-		},
-	}
-
-	if details != nil {
-		errorResponse["error"].(map[string]interface{})["details"] = details
-	}
-
-	responseBody, _ := json.Marshal(errorResponse)
-
-	return events.APIGatewayProxyResponse{
-		StatusCode: statusCode,
-		Headers: map[string]string{
-			"Content-Type":                 "application/json",
-			"Access-Control-Allow-Origin":  "*", // CORS対応（開発用）
-			"Access-Control-Allow-Methods": "POST, OPTIONS",
-			"Access-Control-Allow-Headers": "Content-Type, Authorization",
-		},
-		Body: string(responseBody),
-	}
+// handleRequest はAPI Gateway Proxy統合からのリクエストを処理
+// 処理本体は api.CreateEventIdempotent（ローカルサーバー cmd/server と共通）で、
+// メソッド検証・認証・Content-Type検証・CORS等の共通処理は middleware.Stack が担う
+// authenticator をテストで差し替えられるよう、リクエストごとにミドルウェアを組み立てる
+func handleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	route := api.Route{Method: "POST", Path: "/events", Handle: api.CreateEventIdempotent(eventHandler, idempotencyRepo)}
+	return route.Handler(authenticator)(ctx, request)
 }
 
 // main はLambda関数のエントリーポイント
 // AWS Lambda Runtimeによって呼び出される
 func main() {
+	logging.Setup()
+	setup()
 	lambda.Start(handleRequest)
 }
 
 /*
-動作確認用のサンプルリクエスト：
+動作確認用のサンプルリクエスト（AUTH_DEV_MODE=true の環境のみ。それ以外は Authorization: Bearer <JWT> を指定）：
 
 curl -X POST \
   https://your-api-id.execute-api.ap-northeast-1.amazonaws.com/dev/events \
   -H "Content-Type: application/json" \
   -H "x-organizer-id: test-user-123" \
+  -H "Idempotency-Key: 9b2f6c1e-5d1a-4c1b-8f43-2a7e0c3d9e10" \
   -d '{
     "title": "新人歓迎会",
     "purpose": "welcome",
@@ -195,7 +126,7 @@ curl -X POST \
     "hasScheduling": false
   }'
 
-期待されるレスポンス：
+期待されるレスポンス（同じ Idempotency-Key で再送した場合も同じ内容が返る）：
 {
   "success": true,
   "data": {
@@ -0,0 +1,374 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// update はゴールデンファイルを現在の出力で上書きするフラグ
+// 使用例: go test ./cmd/api/create-event -update
+var update = flag.Bool("update", false, "ゴールデンファイルを更新する")
+
+// setupTestHandler はインメモリリポジトリ・固定時刻・連番IDでeventHandlerを差し替える
+// 返却した時刻を進めると、冪等性レコードの有効期限切れを再現できる
+func setupTestHandler(t *testing.T) *clock.FixedClock {
+	t.Helper()
+	fixed := clock.NewFixedClock(time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC))
+	repo := repository.NewMemoryEventRepository(repository.WithClock(fixed))
+	eventHandler = handler.NewEventHandler(repo,
+		handler.WithClock(fixed),
+		handler.WithIDGenerator(idgen.NewSequenceGenerator()),
+	)
+	idempotencyRepo = repository.NewMemoryIdempotencyRepository(repository.WithClock(fixed))
+	setupTestAuthenticator(t, auth.Config{DevMode: true})
+	return fixed
+}
+
+// setupTestAuthenticator は指定した設定でauthenticatorを差し替える
+func setupTestAuthenticator(t *testing.T, config auth.Config) {
+	t.Helper()
+	var err error
+	authenticator, err = auth.New(config)
+	if err != nil {
+		t.Fatalf("auth.New() error = %v", err)
+	}
+}
+
+// newRequest はテスト用のAPI Gatewayリクエストを作成
+// ボディがあり Content-Type が未指定の場合は application/json を付与する
+func newRequest(method string, headers map[string]string, body string) events.APIGatewayProxyRequest {
+	if body != "" {
+		if _, ok := headers["Content-Type"]; !ok {
+			withContentType := map[string]string{"Content-Type": "application/json"}
+			for key, value := range headers {
+				withContentType[key] = value
+			}
+			headers = withContentType
+		}
+	}
+	return events.APIGatewayProxyRequest{
+		HTTPMethod: method,
+		Path:       "/events",
+		Headers:    headers,
+		Body:       body,
+	}
+}
+
+func TestHandleRequest(t *testing.T) {
+	validBody := `{"title":"新人歓迎会","purpose":"welcome","date":"2025-09-30","time":"19:00","notes":"みんなで楽しく歓迎しましょう！","hasScheduling":false}`
+	authHeaders := map[string]string{"x-organizer-id": "test-user-123"}
+
+	tests := []struct {
+		name       string
+		request    events.APIGatewayProxyRequest
+		wantStatus int
+		wantCode   string
+		golden     string
+	}{
+		{
+			name:       "イベント作成成功",
+			request:    newRequest("POST", authHeaders, validBody),
+			wantStatus: 201,
+			golden:     "create_event_success.golden.json",
+		},
+		{
+			name:       "POST以外のメソッド",
+			request:    newRequest("GET", authHeaders, ""),
+			wantStatus: 400,
+			wantCode:   "METHOD_NOT_ALLOWED",
+		},
+		{
+			name:       "認証ヘッダーなし",
+			request:    newRequest("POST", map[string]string{}, validBody),
+			wantStatus: 401,
+			wantCode:   "UNAUTHORIZED",
+		},
+		{
+			name: "Content-Type がJSONでない",
+			request: newRequest("POST", map[string]string{
+				"x-organizer-id": "test-user-123",
+				"Content-Type":   "text/plain",
+			}, validBody),
+			wantStatus: 415,
+			wantCode:   "UNSUPPORTED_MEDIA_TYPE",
+		},
+		{
+			name:       "ボディが上限サイズを超える",
+			request:    newRequest("POST", authHeaders, `{"notes":"`+strings.Repeat("a", middleware.DefaultMaxBodyBytes)+`"}`),
+			wantStatus: 413,
+			wantCode:   "PAYLOAD_TOO_LARGE",
+		},
+		{
+			name:       "JSON形式が不正",
+			request:    newRequest("POST", authHeaders, `{"title":`),
+			wantStatus: 400,
+			wantCode:   "INVALID_JSON",
+		},
+		{
+			name:       "空のボディ",
+			request:    newRequest("POST", authHeaders, ""),
+			wantStatus: 400,
+			wantCode:   "INVALID_JSON",
+		},
+		{
+			name:       "バリデーションエラー",
+			request:    newRequest("POST", authHeaders, `{"title":"飲み会","date":"2024-02-30"}`),
+			wantStatus: 400,
+			wantCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:       "複数フィールドのバリデーションエラー",
+			request:    newRequest("POST", authHeaders, `{"title":"","purpose":"party","date":"2025-09-01","time":"9:00"}`),
+			wantStatus: 400,
+			wantCode:   "VALIDATION_ERROR",
+			golden:     "create_event_validation_error.golden.json",
+		},
+		{
+			name: "英語のバリデーションエラー",
+			request: newRequest("POST", map[string]string{
+				"x-organizer-id":  "test-user-123",
+				"accept-language": "en-US,en;q=0.9",
+			}, `{"title":"","time":"9:00"}`),
+			wantStatus: 400,
+			wantCode:   "VALIDATION_ERROR",
+			golden:     "create_event_validation_error_en.golden.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupTestHandler(t)
+
+			resp, err := handleRequest(context.Background(), tt.request)
+			if err != nil {
+				t.Fatalf("handleRequest() error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("StatusCode = %d, %d を期待 (body: %s)", resp.StatusCode, tt.wantStatus, resp.Body)
+			}
+			if resp.Headers["Content-Type"] != "application/json" {
+				t.Errorf("Content-Type = %q", resp.Headers["Content-Type"])
+			}
+
+			if tt.wantCode != "" {
+				var body struct {
+					Success bool `json:"success"`
+					Error   struct {
+						Code string `json:"code"`
+					} `json:"error"`
+				}
+				if err := json.Unmarshal([]byte(resp.Body), &body); err != nil {
+					t.Fatalf("レスポンスボディのパースに失敗: %v", err)
+				}
+				if body.Success {
+					t.Error("success = true, false を期待")
+				}
+				if body.Error.Code != tt.wantCode {
+					t.Errorf("error.code = %q, %q を期待", body.Error.Code, tt.wantCode)
+				}
+			}
+
+			if tt.golden != "" {
+				assertGolden(t, tt.golden, resp.Body)
+			}
+		})
+	}
+}
+
+func TestHandleRequestAuthentication(t *testing.T) {
+	body := `{"title":"新人歓迎会"}`
+	cognitoRequest := newRequest("POST", map[string]string{"x-organizer-id": "spoofed"}, body)
+	cognitoRequest.RequestContext.Authorizer = map[string]interface{}{
+		"claims": map[string]interface{}{"sub": "cognito-sub-1"},
+	}
+
+	tests := []struct {
+		name            string
+		devMode         bool
+		request         events.APIGatewayProxyRequest
+		wantStatus      int
+		wantOrganizerID string
+	}{
+		{name: "開発モードではヘッダーを受け付ける", devMode: true, request: newRequest("POST", map[string]string{"x-organizer-id": "user-1"}, body), wantStatus: 201, wantOrganizerID: "user-1"},
+		{name: "開発モード以外ではヘッダーを拒否", devMode: false, request: newRequest("POST", map[string]string{"x-organizer-id": "user-1"}, body), wantStatus: 401},
+		{name: "認証情報なし", devMode: true, request: newRequest("POST", nil, body), wantStatus: 401},
+		{name: "オーソライザーのクレームはヘッダーより優先", devMode: true, request: cognitoRequest, wantStatus: 201, wantOrganizerID: "cognito-sub-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupTestHandler(t)
+			setupTestAuthenticator(t, auth.Config{DevMode: tt.devMode})
+
+			resp, err := handleRequest(context.Background(), tt.request)
+			if err != nil {
+				t.Fatalf("handleRequest() error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("StatusCode = %d, %d を期待 (body: %s)", resp.StatusCode, tt.wantStatus, resp.Body)
+			}
+			if tt.wantOrganizerID == "" {
+				return
+			}
+
+			var created struct {
+				Data struct {
+					OrganizerID string `json:"organizerId"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal([]byte(resp.Body), &created); err != nil {
+				t.Fatalf("レスポンスボディのパースに失敗: %v", err)
+			}
+			if created.Data.OrganizerID != tt.wantOrganizerID {
+				t.Errorf("organizerId = %q, %q を期待", created.Data.OrganizerID, tt.wantOrganizerID)
+			}
+		})
+	}
+}
+
+func TestHandleRequestHeaders(t *testing.T) {
+	setupTestHandler(t)
+
+	request := newRequest("POST", map[string]string{"x-organizer-id": "test-user-123"}, `{"title":"新人歓迎会"}`)
+	request.RequestContext.RequestID = "req-123"
+
+	resp, err := handleRequest(context.Background(), request)
+	if err != nil {
+		t.Fatalf("handleRequest() error = %v", err)
+	}
+	if got := resp.Headers["Vary"]; got != "Origin" {
+		t.Errorf("Vary = %q, Origin を期待", got)
+	}
+	if got := resp.Headers[middleware.RequestIDHeader]; got != "req-123" {
+		t.Errorf("%s = %q, req-123 を期待", middleware.RequestIDHeader, got)
+	}
+}
+
+func TestHandleRequestPreflight(t *testing.T) {
+	setupTestHandler(t)
+
+	// プリフライトには認証ヘッダーが付かないが、401・400ではなく204で応答すること
+	request := newRequest("OPTIONS", map[string]string{
+		"Origin":                         "http://localhost:8081",
+		"Access-Control-Request-Method":  "POST",
+		"Access-Control-Request-Headers": "content-type,authorization",
+	}, "")
+
+	resp, err := handleRequest(context.Background(), request)
+	if err != nil {
+		t.Fatalf("handleRequest() error = %v", err)
+	}
+	if resp.StatusCode != 204 {
+		t.Errorf("StatusCode = %d, 204 を期待 (body: %s)", resp.StatusCode, resp.Body)
+	}
+}
+
+func TestHandleRequestIdempotency(t *testing.T) {
+	body := `{"title":"新人歓迎会","date":"2025-09-30"}`
+	withKey := func(organizerID string, key string, body string) events.APIGatewayProxyRequest {
+		return newRequest("POST", map[string]string{"x-organizer-id": organizerID, "Idempotency-Key": key}, body)
+	}
+
+	tests := []struct {
+		name        string
+		retry       events.APIGatewayProxyRequest
+		advance     time.Duration
+		wantStatus  int
+		wantCode    string
+		wantReplay  bool
+		wantEventID string
+	}{
+		{name: "同じキー・同じ内容の再送は初回のレスポンスを返す", retry: withKey("user-1", "key-1", body), wantStatus: 201, wantReplay: true, wantEventID: "evt_00000000000000000000000000000001"},
+		{name: "同じキー・異なる内容は拒否", retry: withKey("user-1", "key-1", `{"title":"送別会"}`), wantStatus: 422, wantCode: "IDEMPOTENCY_KEY_REUSED"},
+		{name: "別の幹事は同じキーを使用できる", retry: withKey("user-2", "key-1", body), wantStatus: 201, wantEventID: "evt_00000000000000000000000000000002"},
+		{name: "キーなしの再送は新しいイベントを作成", retry: newRequest("POST", map[string]string{"x-organizer-id": "user-1"}, body), wantStatus: 201, wantEventID: "evt_00000000000000000000000000000002"},
+		{name: "24時間経過後は新しいリクエストとして処理", retry: withKey("user-1", "key-1", body), advance: 24 * time.Hour, wantStatus: 201, wantEventID: "evt_00000000000000000000000000000002"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixed := setupTestHandler(t)
+
+			first, err := handleRequest(context.Background(), withKey("user-1", "key-1", body))
+			if err != nil || first.StatusCode != 201 {
+				t.Fatalf("初回リクエスト: StatusCode = %d, error = %v (body: %s)", first.StatusCode, err, first.Body)
+			}
+			fixed.Advance(tt.advance)
+
+			resp, err := handleRequest(context.Background(), tt.retry)
+			if err != nil {
+				t.Fatalf("handleRequest() error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("StatusCode = %d, %d を期待 (body: %s)", resp.StatusCode, tt.wantStatus, resp.Body)
+			}
+			if replayed := resp.Headers[middleware.IdempotentReplayedHeader] == "true"; replayed != tt.wantReplay {
+				t.Errorf("%s = %q, 再送として返されたか: %v を期待", middleware.IdempotentReplayedHeader, resp.Headers[middleware.IdempotentReplayedHeader], tt.wantReplay)
+			}
+			if tt.wantReplay && resp.Body != first.Body {
+				t.Errorf("再送のレスポンスが初回と一致しません\n--- got\n%s\n--- want\n%s", resp.Body, first.Body)
+			}
+
+			var got struct {
+				Data struct {
+					ID string `json:"id"`
+				} `json:"data"`
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal([]byte(resp.Body), &got); err != nil {
+				t.Fatalf("レスポンスボディのパースに失敗: %v", err)
+			}
+			if got.Error.Code != tt.wantCode {
+				t.Errorf("error.code = %q, %q を期待", got.Error.Code, tt.wantCode)
+			}
+			if got.Data.ID != tt.wantEventID {
+				t.Errorf("data.id = %q, %q を期待", got.Data.ID, tt.wantEventID)
+			}
+		})
+	}
+}
+
+// assertGolden はレスポンスボディをtestdata配下のゴールデンファイルと比較する
+// JSONは整形してから比較するため、キー順・空白の差異は生じない
+func assertGolden(t *testing.T, name string, body string) {
+	t.Helper()
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, []byte(body), "", "  "); err != nil {
+		t.Fatalf("レスポンスボディの整形に失敗: %v", err)
+	}
+	indented.WriteString("\n")
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, indented.Bytes(), 0o644); err != nil {
+			t.Fatalf("ゴールデンファイルの書き込みに失敗: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ゴールデンファイルの読み込みに失敗: %v", err)
+	}
+	if !bytes.Equal(indented.Bytes(), want) {
+		t.Errorf("レスポンスがゴールデンファイル %s と一致しません\n--- got\n%s\n--- want\n%s", path, indented.String(), want)
+	}
+}
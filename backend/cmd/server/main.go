@@ -0,0 +1,205 @@
+// server は全APIを1つのプロセスで提供するローカル開発用のHTTPサーバー
+//
+// 本番ではエンドポイントごとに cmd/api/* のLambda関数としてデプロイするが、
+// ローカルでは api.Routes の全ルートを net/http のルーターに登録して起動する。
+// リクエストはAPI Gatewayと同じ形式に変換されるため、Lambdaと同じ処理が動作する。
+//
+// 使用例:
+//
+//	go run ./cmd/server                                              # インメモリリポジトリ（再起動でデータは消える）
+//	go run ./cmd/server -dynamodb-endpoint http://localhost:8000     # DynamoDB Local を使用
+//
+// ログは LOG_LEVEL（debug / info / warn / error）で出力レベルを変更できる。
+// 開発用ヘッダー認証（x-organizer-id）はデフォルトで有効。JWT検証は AUTH_* 環境変数で設定する。
+// CORS は CORS_ALLOWED_ORIGINS が未設定の場合、フロントエンドの開発サーバー（Expo Web）のみ許可する。
+// レストラン検索は HOTPEPPER_API_KEY を設定するとホットペッパーグルメAPI、未設定なら同梱の店舗データを使用する。
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+
+	"github.com/luck-tech/kanji-log/backend/internal/api"
+	"github.com/luck-tech/kanji-log/backend/internal/area"
+	"github.com/luck-tech/kanji-log/backend/internal/audit"
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+	"github.com/luck-tech/kanji-log/backend/internal/restaurant"
+)
+
+// defaultLocalOrigins はローカルサーバーでCORSを許可する既定のオリジン（Expo Webの開発サーバー）
+const defaultLocalOrigins = "http://localhost:8081"
+
+func main() {
+	addr := flag.String("addr", envOrDefault("ADDR", ":8080"), "待ち受けアドレス")
+	dynamoEndpoint := flag.String("dynamodb-endpoint", os.Getenv("DYNAMODB_ENDPOINT"), "DynamoDBのエンドポイント（未指定時はインメモリリポジトリ）")
+	tableName := flag.String("table", envOrDefault("TABLE_NAME", "kanji-log-events-local"), "DynamoDBテーブル名")
+	devAuth := flag.Bool("dev-auth", true, "x-organizer-id ヘッダーによる開発用認証を許可する")
+	flag.Parse()
+
+	logging.Setup()
+
+	// middleware.Stack は CORS_* 環境変数を読むため、未設定ならローカル用の既定値を設定する
+	if os.Getenv("CORS_ALLOWED_ORIGINS") == "" {
+		os.Setenv("CORS_ALLOWED_ORIGINS", defaultLocalOrigins)
+	}
+
+	repos, err := newRepositories(*dynamoEndpoint, *tableName)
+	if err != nil {
+		fatal("リポジトリの初期化に失敗", slog.Any("error", err))
+	}
+	eventHandler := handler.NewEventHandler(audit.NewRecorder(repos.events, repos.audit))
+
+	provider, err := newRestaurantProvider()
+	if err != nil {
+		fatal("レストラン検索プロバイダーの初期化に失敗", slog.Any("error", err))
+	}
+
+	stations, err := area.NewDefaultDirectory()
+	if err != nil {
+		fatal("駅データの読み込みに失敗", slog.Any("error", err))
+	}
+
+	authConfig := auth.LoadConfigFromEnv()
+	authConfig.DevMode = authConfig.DevMode || *devAuth
+	authenticator, err := auth.New(authConfig)
+	if err != nil {
+		fatal("認証設定の初期化に失敗", slog.Any("error", err))
+	}
+
+	routes := api.Routes(api.Dependencies{
+		EventHandler:       eventHandler,
+		TemplateHandler:    handler.NewTemplateHandler(eventHandler, repos.templates),
+		SeriesHandler:      handler.NewSeriesHandler(eventHandler, repos.series),
+		ActivityHandler:    handler.NewActivityHandler(eventHandler, repos.audit),
+		RestaurantHandler:  handler.NewRestaurantHandler(eventHandler, provider),
+		AreaHandler:        handler.NewAreaHandler(eventHandler, stations),
+		ReservationHandler: handler.NewReservationHandler(eventHandler),
+		SettlementHandler:  handler.NewSettlementHandler(eventHandler),
+		BudgetHandler:      handler.NewBudgetHandler(eventHandler),
+		RecordHandler:      handler.NewRecordHandler(eventHandler, repos.records),
+		Idempotency:        repository.NewMemoryIdempotencyRepository(),
+	})
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           api.NewHTTPHandler(routes, authenticator),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	for _, route := range routes {
+		slog.Info("ルートを登録しました", slog.String("method", route.Method), slog.String("path", route.Path))
+	}
+	slog.Info("ローカルサーバーを起動しました", slog.String("addr", *addr))
+
+	// Ctrl+C で処理中のリクエストを完了させてから終了する
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			slog.Error("サーバーの停止に失敗", slog.Any("error", err))
+		}
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fatal("サーバーエラー", slog.Any("error", err))
+	}
+	slog.Info("ローカルサーバーを停止しました")
+}
+
+// repositories はローカルサーバーで使用するリポジトリの集合
+type repositories struct {
+	events    repository.EventRepository
+	templates repository.TemplateRepository
+	series    repository.SeriesRepository
+	audit     repository.AuditRepository
+	records   repository.RecordRepository
+}
+
+// newRepositories はエンドポイントの指定に応じてリポジトリを作成
+// endpoint が空の場合はインメモリ、指定された場合はそのDynamoDB（DynamoDB Local等）を使用する
+// テンプレートのテーブル名は TEMPLATE_TABLE_NAME（既定: kanji-log-event-templates-local）、
+// シリーズのテーブル名は SERIES_TABLE_NAME（既定: kanji-log-event-series-local）、
+// 監査ログのテーブル名は AUDIT_TABLE_NAME（既定: kanji-log-event-audit-local）、
+// 開催記録のテーブル名は RECORD_TABLE_NAME（既定: kanji-log-event-records-local）
+func newRepositories(endpoint string, tableName string) (repositories, error) {
+	if endpoint == "" {
+		slog.Info("インメモリリポジトリを使用します")
+		return repositories{
+			events:    repository.NewMemoryEventRepository(),
+			templates: repository.NewMemoryTemplateRepository(),
+			series:    repository.NewMemorySeriesRepository(),
+			audit:     repository.NewMemoryAuditRepository(),
+			records:   repository.NewMemoryRecordRepository(),
+		}, nil
+	}
+
+	cfg, err := config.LoadDefaultConfig(context.Background())
+	if err != nil {
+		return repositories{}, err
+	}
+	client := dynamodb.NewFromConfig(cfg, func(o *dynamodb.Options) {
+		o.BaseEndpoint = aws.String(endpoint)
+	})
+
+	templateTable := envOrDefault("TEMPLATE_TABLE_NAME", "kanji-log-event-templates-local")
+	seriesTable := envOrDefault("SERIES_TABLE_NAME", "kanji-log-event-series-local")
+	auditTable := envOrDefault("AUDIT_TABLE_NAME", "kanji-log-event-audit-local")
+	recordTable := envOrDefault("RECORD_TABLE_NAME", "kanji-log-event-records-local")
+	slog.Info("DynamoDBを使用します",
+		slog.String("endpoint", endpoint),
+		slog.String("tableName", tableName),
+		slog.String("templateTableName", templateTable),
+		slog.String("seriesTableName", seriesTable),
+		slog.String("auditTableName", auditTable),
+		slog.String("recordTableName", recordTable),
+	)
+	return repositories{
+		events:    repository.NewDynamoDBEventRepository(client, tableName),
+		templates: repository.NewDynamoDBTemplateRepository(client, templateTable),
+		series:    repository.NewDynamoDBSeriesRepository(client, seriesTable),
+		audit:     repository.NewDynamoDBAuditRepository(client, auditTable),
+		records:   repository.NewDynamoDBRecordRepository(client, recordTable),
+	}, nil
+}
+
+// newRestaurantProvider はレストラン検索プロバイダーを作成
+// HOTPEPPER_API_KEY が未設定の場合は、ネットワークを使わない同梱の店舗データで検索する
+func newRestaurantProvider() (restaurant.Provider, error) {
+	if apiKey := os.Getenv("HOTPEPPER_API_KEY"); apiKey != "" {
+		slog.Info("ホットペッパーグルメAPIでレストランを検索します")
+		return restaurant.NewHotPepper(apiKey), nil
+	}
+	slog.Info("同梱の店舗データでレストランを検索します（HOTPEPPER_API_KEY 未設定）")
+	return restaurant.NewFixtureFake()
+}
+
+// fatal はエラーをログに記録してプロセスを終了する
+func fatal(msg string, attrs ...any) {
+	slog.Error(msg, attrs...)
+	os.Exit(1)
+}
+
+// envOrDefault は環境変数の値を返す（未設定の場合は defaultValue）
+func envOrDefault(key string, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -7,6 +7,8 @@ require (
 	github.com/aws/aws-sdk-go-v2 v1.38.3
 	github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue v1.12.12
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.26.6
+	github.com/golang-jwt/jwt/v5 v5.2.2
+	golang.org/x/text v0.28.0
 )
 
 require (
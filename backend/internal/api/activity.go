@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+)
+
+// ListActivity は GET /events/{eventId}/activity の処理を返す
+// 「誰がいつ時刻を変えたか」を確認するための変更履歴を新しい順で返す
+func ListActivity(activityHandler *handler.ActivityHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		entries, err := activityHandler.ListActivity(ctx, eventID, principal.UserID)
+		if err != nil {
+			return eventErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, entries), nil
+	}
+}
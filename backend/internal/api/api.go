@@ -0,0 +1,161 @@
+// Package api はAPIエンドポイントごとのHTTP処理（リクエスト解析・レスポンス変換）を提供する
+//
+// 各エンドポイントは middleware.HandlerFunc として実装し、
+// cmd/api/* のLambda関数と cmd/server のローカルサーバーの両方から利用する。
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// Route は1つのAPIエンドポイント（HTTPメソッドとパスの組み合わせ）
+type Route struct {
+	// Method はHTTPメソッド（例: "POST"）
+	Method string
+
+	// Path はAPI Gatewayのリソースパス形式のパス（例: "/events/{eventId}"）
+	// {name} の部分はパスパラメータとして PathParameters に格納される
+	Path string
+
+	// Public が true の場合は認証不要の公開エンドポイント
+	Public bool
+
+	// Handle はエンドポイントの処理本体（共通ミドルウェア適用前）
+	Handle middleware.HandlerFunc
+}
+
+// Handler は共通ミドルウェア（middleware.Stack）を適用したハンドラーを返す
+// Public なルートでは authenticator は使用されない
+func (r Route) Handler(authenticator *auth.Authenticator) middleware.HandlerFunc {
+	config := middleware.Config{Methods: []string{r.Method}}
+	if !r.Public {
+		config.Authenticator = authenticator
+	}
+	return middleware.Chain(r.Handle, middleware.Stack(config)...)
+}
+
+// Dependencies は各エンドポイントが使用するハンドラー・リポジトリ
+type Dependencies struct {
+	// EventHandler はイベント操作のビジネスロジック
+	EventHandler *handler.EventHandler
+
+	// TemplateHandler はイベントテンプレートのビジネスロジック
+	TemplateHandler *handler.TemplateHandler
+
+	// SeriesHandler は定期開催シリーズのビジネスロジック
+	SeriesHandler *handler.SeriesHandler
+
+	// ActivityHandler はイベントの変更履歴の参照
+	ActivityHandler *handler.ActivityHandler
+
+	// RestaurantHandler はイベントのお店探し
+	RestaurantHandler *handler.RestaurantHandler
+
+	// ReservationHandler はお店の予約の記録
+	ReservationHandler *handler.ReservationHandler
+
+	// BudgetHandler はイベントの予算と1人あたりの金額の目安
+	BudgetHandler *handler.BudgetHandler
+
+	// SettlementHandler は開催後の精算（割り勘・集金）
+	SettlementHandler *handler.SettlementHandler
+
+	// AreaHandler はメンバーの最寄り駅からの集合場所の分析
+	AreaHandler *handler.AreaHandler
+
+	// RecordHandler は開催後の記録（幹事ログ）・「みんなの記録」・幹事の個人統計
+	RecordHandler *handler.RecordHandler
+
+	// Idempotency は Idempotency-Key の処理結果の保存先
+	// nil の場合、Idempotency-Key ヘッダーは無視される
+	Idempotency repository.IdempotencyRepository
+}
+
+// Routes は実装済みの全エンドポイントを返す
+// API Gatewayの各リソースと同じパスで、ローカルサーバーに登録される
+func Routes(deps Dependencies) []Route {
+	return []Route{
+		{Method: "GET", Path: "/hello", Public: true, Handle: Hello},
+		{Method: "POST", Path: "/events", Handle: CreateEventIdempotent(deps.EventHandler, deps.Idempotency)},
+		{Method: "GET", Path: "/events/{eventId}", Handle: GetEvent(deps.EventHandler)},
+		{Method: "GET", Path: "/events/{eventId}/activity", Handle: ListActivity(deps.ActivityHandler)},
+		{Method: "GET", Path: "/events/{eventId}/collaborators", Handle: ListCollaborators(deps.EventHandler)},
+		{Method: "POST", Path: "/events/{eventId}/collaborators", Handle: InviteCollaborator(deps.EventHandler)},
+		{Method: "POST", Path: "/events/{eventId}/collaborators/accept", Handle: AcceptInvite(deps.EventHandler)},
+		{Method: "DELETE", Path: "/events/{eventId}/collaborators/{collaboratorId}", Handle: RevokeCollaborator(deps.EventHandler)},
+		{Method: "PUT", Path: "/events/{eventId}/owner", Handle: TransferOwnership(deps.EventHandler)},
+		{Method: "POST", Path: "/events/{eventId}/restaurants/area-analysis", Handle: AnalyzeArea(deps.AreaHandler)},
+		{Method: "POST", Path: "/events/{eventId}/restaurants/search", Handle: SearchRestaurants(deps.RestaurantHandler)},
+		{Method: "GET", Path: "/events/{eventId}/restaurants/suggestions", Handle: SuggestRestaurants(deps.RestaurantHandler)},
+		{Method: "POST", Path: "/events/{eventId}/restaurants/select", Handle: SelectVenue(deps.RestaurantHandler)},
+		{Method: "GET", Path: "/events/{eventId}/reservation/info", Handle: GetReservationInfo(deps.ReservationHandler)},
+		{Method: "POST", Path: "/events/{eventId}/reservation/report", Handle: ReportReservation(deps.ReservationHandler)},
+		{Method: "PUT", Path: "/events/{eventId}/reservation/update", Handle: UpdateReservation(deps.ReservationHandler)},
+		{Method: "GET", Path: "/events/{eventId}/budget", Handle: GetBudgetPlan(deps.BudgetHandler)},
+		{Method: "PUT", Path: "/events/{eventId}/budget", Handle: UpdateBudget(deps.BudgetHandler)},
+		{Method: "GET", Path: "/events/{eventId}/settlement", Handle: GetSettlement(deps.SettlementHandler)},
+		{Method: "PUT", Path: "/events/{eventId}/settlement", Handle: SaveSettlement(deps.SettlementHandler)},
+		{Method: "PUT", Path: "/events/{eventId}/settlement/payments", Handle: UpdatePayment(deps.SettlementHandler)},
+		{Method: "POST", Path: "/events/{eventId}/settlement/reminders", Handle: RemindUnpaid(deps.SettlementHandler)},
+		{Method: "POST", Path: "/events/{eventId}/duplicate", Handle: DuplicateEvent(deps.EventHandler)},
+		{Method: "POST", Path: "/events/{eventId}/template", Handle: SaveTemplate(deps.TemplateHandler)},
+		{Method: "GET", Path: "/templates", Handle: ListTemplates(deps.TemplateHandler)},
+		{Method: "POST", Path: "/templates/{templateId}/events", Handle: CreateEventFromTemplate(deps.TemplateHandler)},
+		{Method: "DELETE", Path: "/templates/{templateId}", Handle: DeleteTemplate(deps.TemplateHandler)},
+		{Method: "POST", Path: "/series", Handle: CreateSeries(deps.SeriesHandler)},
+		{Method: "GET", Path: "/series/{seriesId}", Handle: GetSeries(deps.SeriesHandler)},
+		{Method: "POST", Path: "/series/{seriesId}/generate", Handle: GenerateSeriesEvents(deps.SeriesHandler)},
+		{Method: "PUT", Path: "/series/{seriesId}/events/{eventId}", Handle: UpdateSeriesOccurrence(deps.SeriesHandler)},
+		{Method: "GET", Path: "/records/private", Handle: ListRecords(deps.RecordHandler)},
+		{Method: "POST", Path: "/records/private", Handle: CreateRecord(deps.RecordHandler)},
+		{Method: "GET", Path: "/records/private/{recordId}", Handle: GetRecord(deps.RecordHandler)},
+		{Method: "PUT", Path: "/records/private/{recordId}", Handle: UpdateRecord(deps.RecordHandler)},
+		{Method: "DELETE", Path: "/records/private/{recordId}", Handle: DeleteRecord(deps.RecordHandler)},
+		{Method: "POST", Path: "/records/private/{recordId}/share", Handle: ShareRecord(deps.RecordHandler)},
+		{Method: "DELETE", Path: "/records/private/{recordId}/share", Handle: UnshareRecord(deps.RecordHandler)},
+		{Method: "GET", Path: "/records/shared", Handle: ListSharedRecords(deps.RecordHandler)},
+		{Method: "GET", Path: "/records/stats", Handle: GetStats(deps.RecordHandler)},
+		{Method: "POST", Path: "/records/shared/{recordId}/like", Handle: LikeRecord(deps.RecordHandler)},
+		{Method: "DELETE", Path: "/records/shared/{recordId}/like", Handle: UnlikeRecord(deps.RecordHandler)},
+		{Method: "POST", Path: "/records/shared/{recordId}/report", Handle: ReportRecord(deps.RecordHandler)},
+	}
+}
+
+// decodeOptionalBody はリクエストボディのJSONを target に読み込む
+// 全項目が任意のリクエスト向けで、ボディが空の場合は target をゼロ値のまま成功とする
+// JSONが不正な場合は返却したエラーレスポンスと false を返す
+func decodeOptionalBody(ctx context.Context, request events.APIGatewayProxyRequest, target interface{}) (events.APIGatewayProxyResponse, bool) {
+	if request.Body == "" {
+		return events.APIGatewayProxyResponse{}, true
+	}
+	return decodeBody(ctx, request, target)
+}
+
+// decodeBody はリクエストボディのJSONを target に読み込む
+// JSONが不正な場合（空のボディを含む）は返却したエラーレスポンスと false を返す
+func decodeBody(ctx context.Context, request events.APIGatewayProxyRequest, target interface{}) (events.APIGatewayProxyResponse, bool) {
+	if err := json.Unmarshal([]byte(request.Body), target); err != nil {
+		slog.InfoContext(ctx, "JSONパースエラー", slog.Any("error", err))
+		return middleware.Error(400, "INVALID_JSON", "リクエストボディのJSON形式が正しくありません", map[string]interface{}{
+			"parseError": err.Error(),
+		}), false
+	}
+	return events.APIGatewayProxyResponse{}, true
+}
+
+// dataResponse は {"success": true, "data": ...} 形式の成功レスポンスを返す
+func dataResponse(statusCode int, data interface{}) events.APIGatewayProxyResponse {
+	return middleware.JSON(statusCode, map[string]interface{}{
+		"success": true,
+		"data":    data,
+	})
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/area"
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+)
+
+// AnalyzeArea は POST /events/{eventId}/restaurants/area-analysis の処理を返す
+// ボディを省略した場合は、フォームで回答されたメンバーの最寄り駅から分析する
+func AnalyzeArea(areaHandler *handler.AreaHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.AreaAnalysisRequest
+		if resp, ok := decodeOptionalBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		analysis, err := areaHandler.AnalyzeArea(ctx, eventID, principal.UserID, &req)
+		if err != nil {
+			return areaErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "エリア分析成功",
+			slog.String("station", analysis.CenterArea.Station),
+			slog.Int("members", len(analysis.Members)),
+		)
+		return dataResponse(200, analysis), nil
+	}
+}
+
+// areaErrorResponse はエリア分析のエラーをHTTPレスポンスに変換する
+// 最寄り駅の回答が集まれば解決するため、リクエストの誤りではなく 409 で返す
+func areaErrorResponse(ctx context.Context, err error) events.APIGatewayProxyResponse {
+	if errors.Is(err, area.ErrNoLocations) {
+		return middleware.Error(409, "MEMBER_LOCATIONS_REQUIRED", "最寄り駅のわかるメンバーがいません", nil)
+	}
+	return eventErrorResponse(ctx, err)
+}
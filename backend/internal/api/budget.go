@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+)
+
+// GetBudgetPlan は GET /events/{eventId}/budget の処理を返す
+func GetBudgetPlan(budgetHandler *handler.BudgetHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		plan, err := budgetHandler.GetBudgetPlan(ctx, eventID, principal.UserID)
+		if err != nil {
+			return eventErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, plan), nil
+	}
+}
+
+// UpdateBudget は PUT /events/{eventId}/budget の処理を返す
+func UpdateBudget(budgetHandler *handler.BudgetHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.EventBudget
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		plan, err := budgetHandler.UpdateBudget(ctx, eventID, principal.UserID, &req)
+		if err != nil {
+			return eventErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "予算更新成功",
+			slog.Bool("feasible", plan.Feasible),
+			slog.Int("overBudgetMembers", len(plan.OverBudgetMembers)),
+		)
+		return dataResponse(200, plan), nil
+	}
+}
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+)
+
+// ListCollaborators は GET /events/{eventId}/collaborators の処理を返す
+func ListCollaborators(eventHandler *handler.EventHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		response, err := eventHandler.ListCollaborators(ctx, eventID, principal.UserID)
+		if err != nil {
+			return collaboratorErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, response), nil
+	}
+}
+
+// InviteCollaborator は POST /events/{eventId}/collaborators の処理を返す
+// レスポンスの inviteCode はこの時だけ返すため、フロントエンドで招待リンクにして共有する
+func InviteCollaborator(eventHandler *handler.EventHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.InviteCollaboratorRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		response, err := eventHandler.InviteCollaborator(ctx, eventID, principal.UserID, &req)
+		if err != nil {
+			return collaboratorErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "共同幹事招待成功",
+			slog.String("collaboratorId", response.Collaborator.ID),
+			slog.String("role", response.Collaborator.Role),
+		)
+		return dataResponse(201, response), nil
+	}
+}
+
+// AcceptInvite は POST /events/{eventId}/collaborators/accept の処理を返す
+func AcceptInvite(eventHandler *handler.EventHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.AcceptInviteRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		collaborator, err := eventHandler.AcceptInvite(ctx, eventID, principal.UserID, &req)
+		if err != nil {
+			return collaboratorErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "共同幹事招待承諾", slog.String("collaboratorId", collaborator.ID))
+		return dataResponse(200, collaborator), nil
+	}
+}
+
+// RevokeCollaborator は DELETE /events/{eventId}/collaborators/{collaboratorId} の処理を返す
+func RevokeCollaborator(eventHandler *handler.EventHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		collaboratorID := request.PathParameters["collaboratorId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID), slog.String("collaboratorId", collaboratorID))
+
+		if err := eventHandler.RevokeCollaborator(ctx, eventID, principal.UserID, collaboratorID); err != nil {
+			return collaboratorErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "共同幹事解除成功")
+		return middleware.JSON(200, map[string]bool{"success": true}), nil
+	}
+}
+
+// TransferOwnership は PUT /events/{eventId}/owner の処理を返す
+func TransferOwnership(eventHandler *handler.EventHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.TransferOwnershipRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		event, err := eventHandler.TransferOwnership(ctx, eventID, principal.UserID, &req)
+		if err != nil {
+			return collaboratorErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "所有者移譲成功", slog.String("collaboratorId", req.CollaboratorID))
+		return middleware.JSON(200, domain.CreateEventResponse{Success: true, Data: event}), nil
+	}
+}
+
+// collaboratorErrorResponse は共同幹事操作のエラーをHTTPレスポンスに変換する
+func collaboratorErrorResponse(ctx context.Context, err error) events.APIGatewayProxyResponse {
+	switch {
+	case errors.Is(err, handler.ErrInvalidInviteCode):
+		return middleware.Error(404, "INVITE_NOT_FOUND", "招待が見つからないか、既に使用されています", nil)
+	case errors.Is(err, handler.ErrAlreadyCollaborator):
+		return middleware.Error(409, "ALREADY_COLLABORATOR", "既にこのイベントの幹事です", nil)
+	case errors.Is(err, handler.ErrCollaboratorNotAccepted):
+		return middleware.Error(409, "COLLABORATOR_NOT_ACCEPTED", "招待を承諾していない共同幹事には所有者を移譲できません", nil)
+	default:
+		return eventErrorResponse(ctx, err)
+	}
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// CreateEvent は POST /events の処理を返す
+// HTTPリクエスト → ビジネスロジック実行 → HTTPレスポンス変換
+func CreateEvent(eventHandler *handler.EventHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		// 認証ミドルウェアで検証済みのユーザーIDを幹事IDとして使用
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		// リクエストボディをパース
+		var createReq domain.CreateEventRequest
+		if err := json.Unmarshal([]byte(request.Body), &createReq); err != nil {
+			slog.InfoContext(ctx, "JSONパースエラー", slog.Any("error", err))
+			return middleware.Error(400, "INVALID_JSON", "リクエストボディのJSON形式が正しくありません", map[string]interface{}{
+				"parseError": err.Error(),
+			}), nil
+		}
+
+		// ビジネスロジックを実行
+		// エラーメッセージの言語は middleware.Language がcontextに設定済み
+		response, err := eventHandler.CreateEvent(ctx, &createReq, principal.UserID)
+		if err != nil {
+			slog.ErrorContext(ctx, "イベント作成エラー", slog.Any("error", err))
+			return middleware.Error(500, "INTERNAL_ERROR", "サーバー内部エラーが発生しました", nil), nil
+		}
+
+		// ビジネスロジックレベルでのエラー（バリデーションエラー等）
+		if !response.Success {
+			statusCode := 400 // バリデーションエラーは400
+			if response.Error.Code == "INTERNAL_ERROR" {
+				statusCode = 500
+			}
+
+			slog.InfoContext(ctx, "ビジネスロジックエラー",
+				slog.String("code", response.Error.Code),
+				slog.String("message", response.Error.Message),
+			)
+			slog.DebugContext(ctx, "エラー詳細", slog.Any("details", response.Error.Details))
+			return middleware.Error(statusCode, response.Error.Code, response.Error.Message, response.Error.Details), nil
+		}
+
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, response.Data.ID))
+		slog.InfoContext(ctx, "イベント作成成功")
+
+		// 成功時のHTTPレスポンス（201 Created）
+		return middleware.JSON(201, response), nil
+	}
+}
+
+// CreateEventIdempotent は Idempotency-Key ヘッダーに対応した POST /events の処理を返す
+// タイムアウト後の再送には初回のレスポンスを返し、イベントを重複作成しない
+func CreateEventIdempotent(eventHandler *handler.EventHandler, idempotencyRepo repository.IdempotencyRepository) middleware.HandlerFunc {
+	return middleware.Chain(CreateEvent(eventHandler), middleware.Idempotency(idempotencyRepo))
+}
+
+// GetEvent は GET /events/{eventId} の処理を返す
+// 他の幹事のイベントは存在を明かさないよう、存在しない場合と同じ404を返す
+func GetEvent(eventHandler *handler.EventHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		event, err := eventHandler.GetEvent(ctx, eventID, principal.UserID)
+		if err != nil {
+			return eventErrorResponse(ctx, err), nil
+		}
+
+		return middleware.JSON(200, domain.CreateEventResponse{Success: true, Data: event}), nil
+	}
+}
+
+// eventErrorResponse はイベント操作のエラーをHTTPレスポンスに変換する
+// ロールを持たないユーザーには存在を明かさず404、共同幹事のロールで許可されない操作は403、
+// 読み込み後に他の幹事の更新が保存されていた場合は409を返す
+func eventErrorResponse(ctx context.Context, err error) events.APIGatewayProxyResponse {
+	var validationErr *handler.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		info := validationErr.Info
+		slog.InfoContext(ctx, "バリデーションエラー", slog.String("message", info.Message))
+		return middleware.Error(400, info.Code, info.Message, info.Details)
+	case errors.Is(err, handler.ErrInvalidEventID):
+		return middleware.Error(400, "INVALID_EVENT_ID", "イベントIDの形式が正しくありません", nil)
+	case errors.Is(err, handler.ErrInsufficientPermission):
+		return middleware.Error(403, "FORBIDDEN", "この操作を行う権限がありません", nil)
+	case errors.Is(err, repository.ErrNotFound), errors.Is(err, handler.ErrForbidden):
+		return middleware.Error(404, "NOT_FOUND", "イベントが見つかりません", nil)
+	case errors.Is(err, repository.ErrConflict):
+		return middleware.Error(409, "CONFLICT", "他の幹事が同時にイベントを更新しました。再読み込みしてからやり直してください", nil)
+	default:
+		slog.ErrorContext(ctx, "イベント操作エラー", slog.Any("error", err))
+		return middleware.Error(500, "INTERNAL_ERROR", "サーバー内部エラーが発生しました", nil)
+	}
+}
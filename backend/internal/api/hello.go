@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+)
+
+// HelloResponse は、Hello API のレスポンス構造体です
+//
+// 幹事ナビでの用途:
+// - API 動作確認のためのテストエンドポイント
+// - インフラ構築後の疎通確認
+// - 将来的な API レスポンス形式の基盤
+type HelloResponse struct {
+	Success   bool   `json:"success"`   // API 実行成功フラグ
+	Message   string `json:"message"`   // 返却メッセージ
+	Timestamp string `json:"timestamp"` // レスポンス生成時刻（UTC）
+}
+
+// Hello は、GET /hello の処理です
+// 認証不要で、API の疎通確認用メッセージと現在時刻を返します
+func Hello(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	// レスポンスデータを構築
+	// time.RFC3339: ISO 8601 形式のタイムスタンプ（例: "2025-09-04T12:34:56Z"）
+	response := HelloResponse{
+		Success:   true,
+		Message:   "Hello from Kanji-Log!",
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	// 構造体を JSON 文字列に変換して HTTP 200 OK を返却
+	// JSON 変換エラー時は middleware.JSON が 500 のエラーレスポンスを返す
+	// CORS ヘッダーは middleware.CORS が全レスポンスに付与する
+	return middleware.JSON(200, response), nil
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/google/uuid"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+)
+
+// pathParamPattern はルートパス中の {name} 形式のパスパラメータにマッチする
+var pathParamPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// NewHTTPHandler は全ルートを標準の net/http ルーターに登録したハンドラーを返す
+// 各リクエストはAPI Gateway Proxy統合と同じ events.APIGatewayProxyRequest に変換されるため、
+// Lambdaと同じミドルウェア・ハンドラーがそのまま動作する（ローカル開発用）
+//
+// API Gatewayと同様に、各パスには CORS プリフライト用の OPTIONS も登録する
+func NewHTTPHandler(routes []Route, authenticator *auth.Authenticator) http.Handler {
+	mux := http.NewServeMux()
+	registered := make(map[string]bool)
+	for _, route := range routes {
+		mux.Handle(route.Method+" "+route.Path, adapt(route, route.Handler(authenticator)))
+
+		if !registered[route.Path] && route.Method != "OPTIONS" {
+			registered[route.Path] = true
+			preflight := Route{Method: "OPTIONS", Path: route.Path, Public: true, Handle: noContent}
+			mux.Handle(preflight.Method+" "+preflight.Path, adapt(preflight, preflight.Handler(nil)))
+		}
+	}
+	return mux
+}
+
+// noContent は本文なしの204を返す（プリフライトは middleware.CORS が応答するため、
+// ここに到達するのは Access-Control-Request-Method のない OPTIONS リクエストのみ）
+func noContent(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	return events.APIGatewayProxyResponse{StatusCode: 204}, nil
+}
+
+// adapt は middleware.HandlerFunc を http.Handler に変換する
+func adapt(route Route, h middleware.HandlerFunc) http.Handler {
+	paramNames := pathParamNames(route.Path)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 上限を1バイト超えて読み込み、サイズ超過の判定は middleware.LimitBody に任せる
+		body, err := io.ReadAll(io.LimitReader(r.Body, middleware.DefaultMaxBodyBytes+1))
+		if err != nil {
+			writeResponse(w, middleware.Error(400, "INVALID_REQUEST", "リクエストボディの読み込みに失敗しました", nil))
+			return
+		}
+
+		request := events.APIGatewayProxyRequest{
+			Resource:   route.Path,
+			Path:       r.URL.Path,
+			HTTPMethod: r.Method,
+			Body:       string(body),
+			RequestContext: events.APIGatewayProxyRequestContext{
+				RequestID:    uuid.NewString(),
+				Stage:        "local",
+				ResourcePath: route.Path,
+				HTTPMethod:   r.Method,
+				Path:         r.URL.Path,
+			},
+		}
+		request.Headers, request.MultiValueHeaders = flattenValues(r.Header)
+		request.QueryStringParameters, request.MultiValueQueryStringParameters = flattenValues(r.URL.Query())
+
+		if len(paramNames) > 0 {
+			request.PathParameters = make(map[string]string, len(paramNames))
+			for _, name := range paramNames {
+				request.PathParameters[name] = r.PathValue(name)
+			}
+		}
+
+		response, err := h(r.Context(), request)
+		if err != nil {
+			// Lambdaではハンドラーがエラーを返すとAPI Gatewayが502を返す
+			slog.ErrorContext(r.Context(), "ハンドラーエラー", slog.Any("error", err))
+			writeResponse(w, middleware.Error(502, "INTERNAL_ERROR", "サーバー内部エラーが発生しました", nil))
+			return
+		}
+		writeResponse(w, response)
+	})
+}
+
+// pathParamNames はルートパスに含まれるパスパラメータ名を返す
+func pathParamNames(path string) []string {
+	var names []string
+	for _, match := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// flattenValues はヘッダー・クエリ文字列をAPI Gatewayと同じ単一値・複数値の2つのマップに変換する
+// 単一値のマップには最後の値が入る（API Gatewayと同じ挙動）
+func flattenValues(values map[string][]string) (map[string]string, map[string][]string) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	single := make(map[string]string, len(values))
+	multi := make(map[string][]string, len(values))
+	for key, vals := range values {
+		if len(vals) == 0 {
+			continue
+		}
+		single[key] = vals[len(vals)-1]
+		multi[key] = vals
+	}
+	return single, multi
+}
+
+// writeResponse はAPI Gatewayのレスポンスを http.ResponseWriter に書き出す
+func writeResponse(w http.ResponseWriter, response events.APIGatewayProxyResponse) {
+	for key, value := range response.Headers {
+		w.Header().Set(key, value)
+	}
+	for key, values := range response.MultiValueHeaders {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+
+	body := []byte(response.Body)
+	if response.IsBase64Encoded {
+		decoded, err := base64.StdEncoding.DecodeString(response.Body)
+		if err != nil {
+			slog.Error("レスポンスボディのBase64デコードに失敗", slog.Any("error", err))
+		} else {
+			body = decoded
+		}
+	}
+
+	w.WriteHeader(response.StatusCode)
+	if _, err := w.Write(body); err != nil {
+		slog.Error("レスポンスの書き込みに失敗", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,664 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/area"
+	"github.com/luck-tech/kanji-log/backend/internal/audit"
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+	"github.com/luck-tech/kanji-log/backend/internal/restaurant"
+)
+
+// newTestServer は全ルートを登録したテスト用HTTPサーバーを起動する
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	fixed := clock.NewFixedClock(time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC))
+	auditRepo := repository.NewMemoryAuditRepository(repository.WithClock(fixed))
+	eventHandler := handler.NewEventHandler(
+		audit.NewRecorder(repository.NewMemoryEventRepository(repository.WithClock(fixed)), auditRepo),
+		handler.WithClock(fixed),
+		handler.WithIDGenerator(idgen.NewSequenceGenerator()),
+	)
+	authenticator, err := auth.New(auth.Config{DevMode: true})
+	if err != nil {
+		t.Fatalf("auth.New() error = %v", err)
+	}
+
+	templateHandler := handler.NewTemplateHandler(eventHandler,
+		repository.NewMemoryTemplateRepository(repository.WithClock(fixed)),
+		handler.WithIDGenerator(idgen.NewSequenceGenerator()),
+	)
+	seriesHandler := handler.NewSeriesHandler(eventHandler,
+		repository.NewMemorySeriesRepository(repository.WithClock(fixed)),
+		handler.WithClock(fixed),
+		handler.WithIDGenerator(idgen.NewSequenceGenerator()),
+	)
+	provider, err := restaurant.NewFixtureFake()
+	if err != nil {
+		t.Fatalf("restaurant.NewFixtureFake() error = %v", err)
+	}
+	stations, err := area.NewDefaultDirectory()
+	if err != nil {
+		t.Fatalf("area.NewDefaultDirectory() error = %v", err)
+	}
+	routes := Routes(Dependencies{
+		EventHandler:       eventHandler,
+		TemplateHandler:    templateHandler,
+		SeriesHandler:      seriesHandler,
+		ActivityHandler:    handler.NewActivityHandler(eventHandler, auditRepo),
+		RestaurantHandler:  handler.NewRestaurantHandler(eventHandler, provider, handler.WithClock(fixed)),
+		AreaHandler:        handler.NewAreaHandler(eventHandler, stations),
+		ReservationHandler: handler.NewReservationHandler(eventHandler, handler.WithClock(fixed)),
+		SettlementHandler:  handler.NewSettlementHandler(eventHandler, handler.WithClock(fixed)),
+		BudgetHandler:      handler.NewBudgetHandler(eventHandler),
+		RecordHandler: handler.NewRecordHandler(eventHandler,
+			repository.NewMemoryRecordRepository(repository.WithClock(fixed)),
+			handler.WithClock(fixed),
+			handler.WithIDGenerator(idgen.NewSequenceGenerator()),
+		),
+		Idempotency: repository.NewMemoryIdempotencyRepository(repository.WithClock(fixed)),
+	})
+	server := httptest.NewServer(NewHTTPHandler(routes, authenticator))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// doRequest はリクエストを送信し、ステータスコードとJSONボディを返す
+func doRequest(t *testing.T, method string, url string, organizerID string, body string) (int, map[string]interface{}) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if organizerID != "" {
+		req.Header.Set(auth.DevHeader, organizerID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("リクエスト送信に失敗: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var decoded map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		t.Fatalf("レスポンスボディのパースに失敗: %v", err)
+	}
+	return resp.StatusCode, decoded
+}
+
+func TestHTTPHandlerEventRoutes(t *testing.T) {
+	server := newTestServer(t)
+
+	status, created := doRequest(t, "POST", server.URL+"/events", "owner", `{"title":"新人歓迎会"}`)
+	if status != 201 {
+		t.Fatalf("POST /events StatusCode = %d, 201 を期待 (body: %v)", status, created)
+	}
+	eventID := created["data"].(map[string]interface{})["id"].(string)
+
+	tests := []struct {
+		name        string
+		path        string
+		organizerID string
+		wantStatus  int
+	}{
+		{name: "作成者はパスパラメータで取得できる", path: "/events/" + eventID, organizerID: "owner", wantStatus: 200},
+		{name: "他の幹事には404", path: "/events/" + eventID, organizerID: "someone-else", wantStatus: 404},
+		{name: "存在しないID", path: "/events/evt_ffffffffffffffffffffffffffffffff", organizerID: "owner", wantStatus: 404},
+		{name: "形式が不正なID", path: "/events/evt_123", organizerID: "owner", wantStatus: 400},
+		{name: "認証情報なし", path: "/events/" + eventID, wantStatus: 401},
+		{name: "公開エンドポイントは認証不要", path: "/hello", wantStatus: 200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doRequest(t, "GET", server.URL+tt.path, tt.organizerID, "")
+			if status != tt.wantStatus {
+				t.Errorf("StatusCode = %d, %d を期待 (body: %v)", status, tt.wantStatus, body)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlerTemplateRoutes(t *testing.T) {
+	server := newTestServer(t)
+
+	_, created := doRequest(t, "POST", server.URL+"/events", "owner", `{"title":"送別会","purpose":"farewell","date":"2025-09-30"}`)
+	eventID := created["data"].(map[string]interface{})["id"].(string)
+
+	status, duplicated := doRequest(t, "POST", server.URL+"/events/"+eventID+"/duplicate", "owner", `{"date":"2025-12-26"}`)
+	if status != 201 {
+		t.Fatalf("POST /events/{eventId}/duplicate StatusCode = %d, 201 を期待 (body: %v)", status, duplicated)
+	}
+	if data := duplicated["data"].(map[string]interface{}); data["title"] != "送別会" || data["date"] != "2025-12-26" {
+		t.Errorf("複製したイベント = %v", data)
+	}
+
+	status, saved := doRequest(t, "POST", server.URL+"/events/"+eventID+"/template", "owner", "")
+	if status != 201 {
+		t.Fatalf("POST /events/{eventId}/template StatusCode = %d, 201 を期待 (body: %v)", status, saved)
+	}
+	templateID := saved["data"].(map[string]interface{})["id"].(string)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		organizerID string
+		body        string
+		wantStatus  int
+		wantCode    string
+	}{
+		{name: "複製時の過去日はバリデーションエラー", method: "POST", path: "/events/" + eventID + "/duplicate", organizerID: "owner", body: `{"date":"2025-01-01"}`, wantStatus: 400, wantCode: "VALIDATION_ERROR"},
+		{name: "他の幹事のイベントは複製できない", method: "POST", path: "/events/" + eventID + "/duplicate", organizerID: "someone-else", wantStatus: 404, wantCode: "NOT_FOUND"},
+		{name: "テンプレート一覧", method: "GET", path: "/templates", organizerID: "owner", wantStatus: 200},
+		{name: "テンプレートからイベント作成", method: "POST", path: "/templates/" + templateID + "/events", organizerID: "owner", body: `{"date":"2026-03-27"}`, wantStatus: 201},
+		{name: "形式が不正なテンプレートID", method: "POST", path: "/templates/tpl_1/events", organizerID: "owner", wantStatus: 400, wantCode: "INVALID_TEMPLATE_ID"},
+		{name: "他の幹事のテンプレートは削除できない", method: "DELETE", path: "/templates/" + templateID, organizerID: "someone-else", wantStatus: 404, wantCode: "NOT_FOUND"},
+		{name: "テンプレート削除", method: "DELETE", path: "/templates/" + templateID, organizerID: "owner", wantStatus: 200},
+		{name: "削除済みのテンプレート", method: "POST", path: "/templates/" + templateID + "/events", organizerID: "owner", wantStatus: 404, wantCode: "NOT_FOUND"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doRequest(t, tt.method, server.URL+tt.path, tt.organizerID, tt.body)
+			if status != tt.wantStatus {
+				t.Errorf("StatusCode = %d, %d を期待 (body: %v)", status, tt.wantStatus, body)
+			}
+			if tt.wantCode == "" {
+				return
+			}
+			if errorInfo, _ := body["error"].(map[string]interface{}); errorInfo["code"] != tt.wantCode {
+				t.Errorf("error = %v, code %q を期待", body["error"], tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlerSeriesRoutes(t *testing.T) {
+	server := newTestServer(t)
+
+	status, created := doRequest(t, "POST", server.URL+"/series", "owner",
+		`{"title":"部署飲み","startDate":"2025-09-10","recurrence":{"frequency":"monthly","week":-1,"weekday":"FR"}}`)
+	if status != 201 {
+		t.Fatalf("POST /series StatusCode = %d, 201 を期待 (body: %v)", status, created)
+	}
+	data := created["data"].(map[string]interface{})
+	seriesID := data["series"].(map[string]interface{})["id"].(string)
+	occurrences := data["events"].([]interface{})
+	if len(occurrences) != 3 {
+		t.Fatalf("開催回 = %d件, 9〜11月の最終金曜の3件を期待", len(occurrences))
+	}
+	eventID := occurrences[0].(map[string]interface{})["id"].(string)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		organizerID string
+		body        string
+		wantStatus  int
+		wantCode    string
+	}{
+		{name: "シリーズ取得", method: "GET", path: "/series/" + seriesID, organizerID: "owner", wantStatus: 200},
+		{name: "他の幹事のシリーズ", method: "GET", path: "/series/" + seriesID, organizerID: "someone-else", wantStatus: 404, wantCode: "NOT_FOUND"},
+		{name: "形式が不正なシリーズID", method: "GET", path: "/series/ser_1", organizerID: "owner", wantStatus: 400, wantCode: "INVALID_SERIES_ID"},
+		{name: "開催回の追加生成", method: "POST", path: "/series/" + seriesID + "/generate", organizerID: "owner", wantStatus: 200},
+		{name: "この回のみ編集", method: "PUT", path: "/series/" + seriesID + "/events/" + eventID, organizerID: "owner", body: `{"scope":"this","time":"18:00"}`, wantStatus: 200},
+		{name: "編集範囲が不正", method: "PUT", path: "/series/" + seriesID + "/events/" + eventID, organizerID: "owner", body: `{"scope":"all"}`, wantStatus: 400, wantCode: "VALIDATION_ERROR"},
+		{name: "ボディなし", method: "POST", path: "/series", organizerID: "owner", wantStatus: 400, wantCode: "INVALID_JSON"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doRequest(t, tt.method, server.URL+tt.path, tt.organizerID, tt.body)
+			if status != tt.wantStatus {
+				t.Errorf("StatusCode = %d, %d を期待 (body: %v)", status, tt.wantStatus, body)
+			}
+			if tt.wantCode == "" {
+				return
+			}
+			if errorInfo, _ := body["error"].(map[string]interface{}); errorInfo["code"] != tt.wantCode {
+				t.Errorf("error = %v, code %q を期待", body["error"], tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlerCollaboratorRoutes(t *testing.T) {
+	server := newTestServer(t)
+
+	_, created := doRequest(t, "POST", server.URL+"/events", "owner", `{"title":"新人歓迎会"}`)
+	eventID := created["data"].(map[string]interface{})["id"].(string)
+
+	status, invited := doRequest(t, "POST", server.URL+"/events/"+eventID+"/collaborators", "owner", `{"role":"viewer"}`)
+	if status != 201 {
+		t.Fatalf("POST /events/{eventId}/collaborators StatusCode = %d, 201 を期待 (body: %v)", status, invited)
+	}
+	inviteCode := invited["data"].(map[string]interface{})["inviteCode"].(string)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		organizerID string
+		body        string
+		wantStatus  int
+		wantCode    string
+	}{
+		{name: "招待前は404", method: "GET", path: "/events/" + eventID, organizerID: "viewer-user", wantStatus: 404, wantCode: "NOT_FOUND"},
+		{name: "招待コードが不正", method: "POST", path: "/events/" + eventID + "/collaborators/accept", organizerID: "viewer-user", body: `{"inviteCode":"inv_x"}`, wantStatus: 404, wantCode: "INVITE_NOT_FOUND"},
+		{name: "招待を承諾", method: "POST", path: "/events/" + eventID + "/collaborators/accept", organizerID: "viewer-user", body: `{"inviteCode":"` + inviteCode + `"}`, wantStatus: 200},
+		{name: "閲覧者はイベントを取得できる", method: "GET", path: "/events/" + eventID, organizerID: "viewer-user", wantStatus: 200},
+		{name: "閲覧者は招待できない", method: "POST", path: "/events/" + eventID + "/collaborators", organizerID: "viewer-user", body: `{"role":"editor"}`, wantStatus: 403, wantCode: "FORBIDDEN"},
+		{name: "共同幹事一覧", method: "GET", path: "/events/" + eventID + "/collaborators", organizerID: "viewer-user", wantStatus: 200},
+		{name: "ownerロールは招待で付与できない", method: "POST", path: "/events/" + eventID + "/collaborators", organizerID: "owner", body: `{"role":"owner"}`, wantStatus: 400, wantCode: "VALIDATION_ERROR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doRequest(t, tt.method, server.URL+tt.path, tt.organizerID, tt.body)
+			if status != tt.wantStatus {
+				t.Errorf("StatusCode = %d, %d を期待 (body: %v)", status, tt.wantStatus, body)
+			}
+			if tt.wantCode == "" {
+				return
+			}
+			if errorInfo, _ := body["error"].(map[string]interface{}); errorInfo["code"] != tt.wantCode {
+				t.Errorf("error = %v, code %q を期待", body["error"], tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlerActivityRoute(t *testing.T) {
+	server := newTestServer(t)
+
+	_, created := doRequest(t, "POST", server.URL+"/events", "owner", `{"title":"新人歓迎会"}`)
+	eventID := created["data"].(map[string]interface{})["id"].(string)
+	_, invited := doRequest(t, "POST", server.URL+"/events/"+eventID+"/collaborators", "owner", `{"role":"viewer"}`)
+	inviteCode := invited["data"].(map[string]interface{})["inviteCode"].(string)
+	doRequest(t, "POST", server.URL+"/events/"+eventID+"/collaborators/accept", "viewer-user", `{"inviteCode":"`+inviteCode+`"}`)
+
+	status, body := doRequest(t, "GET", server.URL+"/events/"+eventID+"/activity", "viewer-user", "")
+	if status != 200 {
+		t.Fatalf("GET /events/{eventId}/activity StatusCode = %d, 200 を期待 (body: %v)", status, body)
+	}
+	entries, _ := body["data"].([]interface{})
+	if len(entries) != 3 {
+		t.Fatalf("変更履歴 = %d件, 作成・招待・承諾の3件を期待 (body: %v)", len(entries), body)
+	}
+	latest := entries[0].(map[string]interface{})
+	if latest["actorId"] != "viewer-user" || latest["action"] != "event.updated" {
+		t.Errorf("最新の変更履歴 = %v, viewer-user による更新を期待", latest)
+	}
+	if oldest := entries[2].(map[string]interface{}); oldest["action"] != "event.created" || oldest["actorId"] != "owner" {
+		t.Errorf("最古の変更履歴 = %v, owner による作成を期待", oldest)
+	}
+
+	if status, _ := doRequest(t, "GET", server.URL+"/events/"+eventID+"/activity", "someone-else", ""); status != 404 {
+		t.Errorf("他の幹事の StatusCode = %d, 404 を期待", status)
+	}
+}
+
+func TestHTTPHandlerRestaurantRoutes(t *testing.T) {
+	server := newTestServer(t)
+
+	_, created := doRequest(t, "POST", server.URL+"/events", "owner", `{"title":"新人歓迎会"}`)
+	eventID := created["data"].(map[string]interface{})["id"].(string)
+	path := server.URL + "/events/" + eventID + "/restaurants/search"
+	suggestionsPath := server.URL + "/events/" + eventID + "/restaurants/suggestions"
+
+	if status, body := doRequest(t, "GET", suggestionsPath, "owner", ""); status != 409 {
+		t.Errorf("検索前の GET /events/{eventId}/restaurants/suggestions StatusCode = %d, 409 を期待 (body: %v)", status, body)
+	}
+
+	status, body := doRequest(t, "POST", path, "owner", `{"area":"新宿","budgetMax":4500}`)
+	if status != 200 {
+		t.Fatalf("POST /events/{eventId}/restaurants/search StatusCode = %d, 200 を期待 (body: %v)", status, body)
+	}
+	restaurants, _ := body["data"].(map[string]interface{})["restaurants"].([]interface{})
+	if len(restaurants) != 2 {
+		t.Errorf("restaurants = %d件, 2件を期待 (body: %v)", len(restaurants), body)
+	}
+
+	_, event := doRequest(t, "GET", server.URL+"/events/"+eventID, "owner", "")
+	if _, ok := event["data"].(map[string]interface{})["restaurantSearch"]; !ok {
+		t.Errorf("イベントに検索結果が保存されていません: %v", event)
+	}
+
+	status, body = doRequest(t, "GET", suggestionsPath, "owner", "")
+	if status != 200 {
+		t.Fatalf("GET /events/{eventId}/restaurants/suggestions StatusCode = %d, 200 を期待 (body: %v)", status, body)
+	}
+	if suggestions, _ := body["data"].(map[string]interface{})["restaurants"].([]interface{}); len(suggestions) == 0 {
+		t.Errorf("推薦が空です (body: %v)", body)
+	}
+
+	selectPath := server.URL + "/events/" + eventID + "/restaurants/select"
+	if status, body := doRequest(t, "POST", selectPath, "owner", `{"restaurantId":"unknown"}`); status != 404 {
+		t.Errorf("存在しない店舗の POST /events/{eventId}/restaurants/select StatusCode = %d, 404 を期待 (body: %v)", status, body)
+	}
+	if status, body := doRequest(t, "POST", selectPath, "owner", `{"restaurantId":"fake_shinjuku_001"}`); status != 200 {
+		t.Fatalf("POST /events/{eventId}/restaurants/select StatusCode = %d, 200 を期待 (body: %v)", status, body)
+	}
+	_, event = doRequest(t, "GET", server.URL+"/events/"+eventID, "owner", "")
+	if venue, _ := event["data"].(map[string]interface{})["venue"].(map[string]interface{}); venue["name"] != "炭火焼鳥 鳥心 新宿店" {
+		t.Errorf("venue = %v, 炭火焼鳥 鳥心 新宿店 を期待", venue)
+	}
+
+	areaPath := server.URL + "/events/" + eventID + "/restaurants/area-analysis"
+	if status, body := doRequest(t, "POST", areaPath, "owner", ""); status != 409 {
+		t.Errorf("最寄り駅なしの POST /events/{eventId}/restaurants/area-analysis StatusCode = %d, 409 を期待 (body: %v)", status, body)
+	}
+	status, body = doRequest(t, "POST", areaPath, "owner", `{"memberLocations":[{"name":"田中","station":"池袋"},{"name":"佐藤","station":"渋谷"},{"name":"鈴木","station":"東京"}]}`)
+	if status != 200 {
+		t.Fatalf("POST /events/{eventId}/restaurants/area-analysis StatusCode = %d, 200 を期待 (body: %v)", status, body)
+	}
+	if centerArea, _ := body["data"].(map[string]interface{})["centerArea"].(map[string]interface{}); centerArea["station"] != "市ケ谷" {
+		t.Errorf("centerArea = %v, 市ケ谷 を期待", centerArea)
+	}
+
+	tests := []struct {
+		name        string
+		organizerID string
+		body        string
+		wantStatus  int
+	}{
+		{name: "エリア未指定", organizerID: "owner", body: `{"genre":"居酒屋"}`, wantStatus: 400},
+		{name: "ボディなし", organizerID: "owner", body: "", wantStatus: 400},
+		{name: "他の幹事には404", organizerID: "someone-else", body: `{"area":"新宿"}`, wantStatus: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status, body := doRequest(t, "POST", path, tt.organizerID, tt.body); status != tt.wantStatus {
+				t.Errorf("StatusCode = %d, %d を期待 (body: %v)", status, tt.wantStatus, body)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlerReservationRoutes(t *testing.T) {
+	server := newTestServer(t)
+
+	_, created := doRequest(t, "POST", server.URL+"/events", "owner", `{"title":"新人歓迎会"}`)
+	eventID := created["data"].(map[string]interface{})["id"].(string)
+	base := server.URL + "/events/" + eventID + "/reservation"
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{name: "予約情報", method: "GET", path: "/info", wantStatus: 200},
+		{name: "会場の決定前の報告", method: "POST", path: "/report", body: `{"contactPerson":"田中"}`, wantStatus: 409},
+		{name: "報告前の更新", method: "PUT", path: "/update", body: `{"contactPerson":"田中"}`, wantStatus: 409},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status, body := doRequest(t, tt.method, base+tt.path, "owner", tt.body); status != tt.wantStatus {
+				t.Errorf("%s %s StatusCode = %d, %d を期待 (body: %v)", tt.method, tt.path, status, tt.wantStatus, body)
+			}
+		})
+	}
+
+	doRequest(t, "POST", server.URL+"/events/"+eventID+"/restaurants/select", "owner", `{"restaurantId":"fake_shinjuku_001"}`)
+	if status, body := doRequest(t, "POST", base+"/report", "owner", `{"contactPerson":"田中","reservationId":"R-1234"}`); status != 201 {
+		t.Fatalf("POST /events/{eventId}/reservation/report StatusCode = %d, 201 を期待 (body: %v)", status, body)
+	}
+	if status, body := doRequest(t, "PUT", base+"/update", "owner", `{"contactPerson":"佐藤"}`); status != 200 {
+		t.Fatalf("PUT /events/{eventId}/reservation/update StatusCode = %d, 200 を期待 (body: %v)", status, body)
+	}
+	_, info := doRequest(t, "GET", base+"/info", "owner", "")
+	details, _ := info["data"].(map[string]interface{})["reservationDetails"].(map[string]interface{})
+	if details["contactPerson"] != "佐藤" || details["isConfirmed"] != true {
+		t.Errorf("reservationDetails = %v, 更新後の予約を期待", details)
+	}
+}
+
+func TestHTTPHandlerBudgetRoutes(t *testing.T) {
+	server := newTestServer(t)
+
+	status, created := doRequest(t, "POST", server.URL+"/events", "owner",
+		`{"title":"新人歓迎会","budget":{"targetPerPerson":5000,"totalCap":60000,"subsidy":20000}}`)
+	if status != 201 {
+		t.Fatalf("予算付きの POST /events StatusCode = %d, 201 を期待 (body: %v)", status, created)
+	}
+	event := created["data"].(map[string]interface{})
+	if budget, _ := event["budget"].(map[string]interface{}); budget["subsidy"] != float64(20000) {
+		t.Errorf("budget = %v, 作成時の予算を期待", event["budget"])
+	}
+	if status, body := doRequest(t, "POST", server.URL+"/events", "owner", `{"title":"新人歓迎会","budget":{"totalCap":10000,"subsidy":20000}}`); status != 400 {
+		t.Errorf("会社補助が上限を超える POST /events StatusCode = %d, 400 を期待 (body: %v)", status, body)
+	}
+
+	base := server.URL + "/events/" + event["id"].(string) + "/budget"
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		wantStatus int
+	}{
+		{name: "予算の目安", method: "GET", wantStatus: 200},
+		{name: "予算の更新", method: "PUT", body: `{"targetPerPerson":4000}`, wantStatus: 200},
+		{name: "負の金額", method: "PUT", body: `{"subsidy":-1}`, wantStatus: 400},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status, body := doRequest(t, tt.method, base, "owner", tt.body); status != tt.wantStatus {
+				t.Errorf("%s StatusCode = %d, %d を期待 (body: %v)", tt.method, status, tt.wantStatus, body)
+			}
+		})
+	}
+
+	_, plan := doRequest(t, "GET", base, "owner", "")
+	if data, _ := plan["data"].(map[string]interface{}); data["feasible"] != true || data["headcount"] != float64(0) {
+		t.Errorf("data = %v, 参加メンバーのいないイベントの目安を期待", plan["data"])
+	}
+	if status, body := doRequest(t, "GET", base, "stranger", ""); status != 404 {
+		t.Errorf("他のユーザーの GET /events/{eventId}/budget StatusCode = %d, 404 を期待 (body: %v)", status, body)
+	}
+}
+
+func TestHTTPHandlerSettlementRoutes(t *testing.T) {
+	server := newTestServer(t)
+
+	_, created := doRequest(t, "POST", server.URL+"/events", "owner", `{"title":"新人歓迎会"}`)
+	eventID := created["data"].(map[string]interface{})["id"].(string)
+	base := server.URL + "/events/" + eventID + "/settlement"
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{name: "作成前の精算", method: "GET", path: "", wantStatus: 409},
+		{name: "総額なし", method: "PUT", path: "", body: `{}`, wantStatus: 400},
+		{name: "参加メンバーなしの精算", method: "PUT", path: "", body: `{"totalAmount":10000}`, wantStatus: 409},
+		{name: "作成前の支払い状況の更新", method: "PUT", path: "/payments", body: `{"name":"田中","isPaid":true}`, wantStatus: 409},
+		{name: "作成前の催促", method: "POST", path: "/reminders", wantStatus: 409},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status, body := doRequest(t, tt.method, base+tt.path, "owner", tt.body); status != tt.wantStatus {
+				t.Errorf("%s %s StatusCode = %d, %d を期待 (body: %v)", tt.method, tt.path, status, tt.wantStatus, body)
+			}
+		})
+	}
+
+	if status, body := doRequest(t, "GET", base, "stranger", ""); status != 404 {
+		t.Errorf("他のユーザーの GET /events/{eventId}/settlement StatusCode = %d, 404 を期待 (body: %v)", status, body)
+	}
+}
+
+func TestHTTPHandlerRecordRoutes(t *testing.T) {
+	server := newTestServer(t)
+
+	_, created := doRequest(t, "POST", server.URL+"/events", "owner", `{"title":"新人歓迎会"}`)
+	eventID := created["data"].(map[string]interface{})["id"].(string)
+	base := server.URL + "/records/private"
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{name: "記録一覧", method: "GET", path: "", wantStatus: 200},
+		{name: "完了していないイベントの記録", method: "POST", path: "", body: `{"eventId":"` + eventID + `","rating":4}`, wantStatus: 409},
+		{name: "存在しないイベントの記録", method: "POST", path: "", body: `{"eventId":"evt_00000000000000000000000000000099","rating":4}`, wantStatus: 404},
+		{name: "評価なし", method: "POST", path: "", body: `{"eventId":"` + eventID + `"}`, wantStatus: 400},
+		{name: "不正な記録ID", method: "GET", path: "/rec_invalid", wantStatus: 400},
+		{name: "存在しない記録", method: "GET", path: "/rec_00000000000000000000000000000099", wantStatus: 404},
+		{name: "存在しない記録の更新", method: "PUT", path: "/rec_00000000000000000000000000000099", body: `{"rating":4}`, wantStatus: 404},
+		{name: "存在しない記録の削除", method: "DELETE", path: "/rec_00000000000000000000000000000099", wantStatus: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status, body := doRequest(t, tt.method, base+tt.path, "owner", tt.body); status != tt.wantStatus {
+				t.Errorf("%s %s StatusCode = %d, %d を期待 (body: %v)", tt.method, tt.path, status, tt.wantStatus, body)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlerSharedRecordRoutes(t *testing.T) {
+	server := newTestServer(t)
+	missing := "/rec_00000000000000000000000000000099"
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{name: "共有記録一覧", method: "GET", path: "/records/shared?area=%E6%96%B0%E5%AE%BF&page=1&limit=10", wantStatus: 200},
+		{name: "数値でない金額", method: "GET", path: "/records/shared?minPrice=abc", wantStatus: 400},
+		{name: "未知の目的", method: "GET", path: "/records/shared?purpose=party", wantStatus: 400},
+		{name: "存在しない記録の共有", method: "POST", path: "/records/private" + missing + "/share", wantStatus: 404},
+		{name: "不正なIDの記録の共有停止", method: "DELETE", path: "/records/private/rec_invalid/share", wantStatus: 400},
+		{name: "存在しない共有記録へのいいね", method: "POST", path: "/records/shared" + missing + "/like", wantStatus: 404},
+		{name: "存在しない共有記録のいいね取り消し", method: "DELETE", path: "/records/shared" + missing + "/like", wantStatus: 404},
+		{name: "理由なしの不適切報告", method: "POST", path: "/records/shared" + missing + "/report", body: `{}`, wantStatus: 400},
+		{name: "存在しない共有記録の不適切報告", method: "POST", path: "/records/shared" + missing + "/report", body: `{"reason":"spam"}`, wantStatus: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status, body := doRequest(t, tt.method, server.URL+tt.path, "owner", tt.body); status != tt.wantStatus {
+				t.Errorf("%s %s StatusCode = %d, %d を期待 (body: %v)", tt.method, tt.path, status, tt.wantStatus, body)
+			}
+		})
+	}
+
+	_, body := doRequest(t, "GET", server.URL+"/records/shared", "owner", "")
+	pagination, _ := body["meta"].(map[string]interface{})["pagination"].(map[string]interface{})
+	if pagination["page"] != float64(1) || pagination["limit"] != float64(10) || pagination["total"] != float64(0) {
+		t.Errorf("meta.pagination = %v, 既定のページ分割を期待", pagination)
+	}
+}
+
+func TestHTTPHandlerStatsRoute(t *testing.T) {
+	server := newTestServer(t)
+	doRequest(t, "POST", server.URL+"/events", "owner", `{"title":"新人歓迎会","date":"2099-04-10","purpose":"welcome"}`)
+
+	status, body := doRequest(t, "GET", server.URL+"/records/stats?from=2099-04-01&to=2099-04-30", "owner", "")
+	if status != 200 {
+		t.Fatalf("GET /records/stats StatusCode = %d, 200 を期待 (body: %v)", status, body)
+	}
+	stats, _ := body["data"].(map[string]interface{})
+	byPurpose, _ := stats["eventsByPurpose"].(map[string]interface{})
+	if stats["totalEvents"] != float64(1) || byPurpose["welcome"] != float64(1) {
+		t.Errorf("data = %v, 期間内のイベント1件を期待", stats)
+	}
+
+	if status, body := doRequest(t, "GET", server.URL+"/records/stats?from=2099-04-30&to=2099-04-01", "owner", ""); status != 400 {
+		t.Errorf("期間が逆の GET /records/stats StatusCode = %d, 400 を期待 (body: %v)", status, body)
+	}
+}
+
+func TestAdaptRequest(t *testing.T) {
+	var got events.APIGatewayProxyRequest
+	route := Route{Method: "GET", Path: "/events/{eventId}/members/{memberId}"}
+	h := adapt(route, func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		got = request
+		return events.APIGatewayProxyResponse{
+			StatusCode:        200,
+			Headers:           map[string]string{"Content-Type": "text/plain"},
+			MultiValueHeaders: map[string][]string{"Set-Cookie": {"a=1", "b=2"}},
+			Body:              "ok",
+		}, nil
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle(route.Method+" "+route.Path, h)
+
+	req := httptest.NewRequest("GET", "/events/evt_1/members/mem_2?tag=a&tag=b", nil)
+	req.Header.Set("Accept-Language", "en")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	if got.PathParameters["eventId"] != "evt_1" || got.PathParameters["memberId"] != "mem_2" {
+		t.Errorf("PathParameters = %v", got.PathParameters)
+	}
+	if got.Resource != route.Path || got.Path != "/events/evt_1/members/mem_2" {
+		t.Errorf("Resource = %q, Path = %q", got.Resource, got.Path)
+	}
+	if got.QueryStringParameters["tag"] != "b" || len(got.MultiValueQueryStringParameters["tag"]) != 2 {
+		t.Errorf("QueryStringParameters = %v, MultiValue = %v", got.QueryStringParameters, got.MultiValueQueryStringParameters)
+	}
+	if got.Headers["Accept-Language"] != "en" {
+		t.Errorf("Headers = %v", got.Headers)
+	}
+	if got.RequestContext.RequestID == "" {
+		t.Error("RequestContext.RequestID が空です")
+	}
+
+	if rec.Code != 200 || rec.Body.String() != "ok" {
+		t.Errorf("レスポンス = %d %q", rec.Code, rec.Body.String())
+	}
+	if cookies := rec.Result().Header.Values("Set-Cookie"); len(cookies) != 2 {
+		t.Errorf("Set-Cookie = %v, 2件を期待", cookies)
+	}
+}
+
+func TestHTTPHandlerPreflight(t *testing.T) {
+	server := newTestServer(t)
+
+	req, _ := http.NewRequest("OPTIONS", server.URL+"/events", nil)
+	req.Header.Set("Origin", "http://localhost:8081")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("リクエスト送信に失敗: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != 204 {
+		t.Errorf("StatusCode = %d, 204 を期待", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Vary"); got != "Origin" {
+		t.Errorf("Vary = %q, Origin を期待", got)
+	}
+}
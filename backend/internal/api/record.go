@@ -0,0 +1,146 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// CreateRecord は POST /records/private の処理を返す
+func CreateRecord(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.CreateRecordRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, req.EventID))
+
+		record, err := recordHandler.CreateRecord(ctx, principal.UserID, &req)
+		if err != nil {
+			// 記録の作成で見つからないのは対象のイベントのため、イベントのエラーとして返す
+			if errors.Is(err, handler.ErrEventNotCompleted) || errors.Is(err, handler.ErrRecordExists) {
+				return recordErrorResponse(ctx, err), nil
+			}
+			return eventErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "開催記録作成成功", slog.String("recordId", record.ID))
+		return dataResponse(201, record), nil
+	}
+}
+
+// ListRecords は GET /records/private の処理を返す
+func ListRecords(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		records, err := recordHandler.ListRecords(ctx, principal.UserID)
+		if err != nil {
+			return recordErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, records), nil
+	}
+}
+
+// GetRecord は GET /records/private/{recordId} の処理を返す
+func GetRecord(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		recordID := request.PathParameters["recordId"]
+		ctx = logging.With(ctx, slog.String("recordId", recordID))
+
+		record, err := recordHandler.GetRecord(ctx, recordID, principal.UserID)
+		if err != nil {
+			return recordErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, record), nil
+	}
+}
+
+// UpdateRecord は PUT /records/private/{recordId} の処理を返す
+func UpdateRecord(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.UpdateRecordRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		recordID := request.PathParameters["recordId"]
+		ctx = logging.With(ctx, slog.String("recordId", recordID))
+
+		record, err := recordHandler.UpdateRecord(ctx, recordID, principal.UserID, &req)
+		if err != nil {
+			return recordErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "開催記録更新成功")
+		return dataResponse(200, record), nil
+	}
+}
+
+// DeleteRecord は DELETE /records/private/{recordId} の処理を返す
+func DeleteRecord(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		recordID := request.PathParameters["recordId"]
+		ctx = logging.With(ctx, slog.String("recordId", recordID))
+
+		if err := recordHandler.DeleteRecord(ctx, recordID, principal.UserID); err != nil {
+			return recordErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "開催記録削除成功")
+		return middleware.JSON(200, map[string]bool{"success": true}), nil
+	}
+}
+
+// recordErrorResponse は開催記録の操作のエラーをHTTPレスポンスに変換する
+// 他の幹事の記録はテンプレートと同様に存在を明かさず404を返す
+func recordErrorResponse(ctx context.Context, err error) events.APIGatewayProxyResponse {
+	switch {
+	case errors.Is(err, handler.ErrInvalidRecordID):
+		return middleware.Error(400, "INVALID_RECORD_ID", "記録IDの形式が正しくありません", nil)
+	case errors.Is(err, handler.ErrEventNotCompleted):
+		return middleware.Error(409, "EVENT_NOT_COMPLETED", "完了したイベントのみ記録できます", nil)
+	case errors.Is(err, handler.ErrRecordExists):
+		return middleware.Error(409, "RECORD_ALREADY_EXISTS", "このイベントの記録は作成済みです", nil)
+	case errors.Is(err, handler.ErrRecordUnderReview):
+		return middleware.Error(409, "RECORD_UNDER_REVIEW", "この記録は審査中のため共有できません", nil)
+	case errors.Is(err, handler.ErrOwnRecord):
+		return middleware.Error(409, "OWN_RECORD", "自分の記録にはいいね・不適切報告できません", nil)
+	case errors.Is(err, repository.ErrNotFound), errors.Is(err, handler.ErrForbidden):
+		return middleware.Error(404, "NOT_FOUND", "記録が見つかりません", nil)
+	default:
+		return eventErrorResponse(ctx, err)
+	}
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+)
+
+// GetReservationInfo は GET /events/{eventId}/reservation/info の処理を返す
+func GetReservationInfo(reservationHandler *handler.ReservationHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		info, err := reservationHandler.GetReservationInfo(ctx, eventID, principal.UserID)
+		if err != nil {
+			return reservationErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, info), nil
+	}
+}
+
+// ReportReservation は POST /events/{eventId}/reservation/report の処理を返す
+func ReportReservation(reservationHandler *handler.ReservationHandler) middleware.HandlerFunc {
+	return reservationWriter(reservationHandler.ReportReservation, 201, "予約完了報告成功")
+}
+
+// UpdateReservation は PUT /events/{eventId}/reservation/update の処理を返す
+func UpdateReservation(reservationHandler *handler.ReservationHandler) middleware.HandlerFunc {
+	return reservationWriter(reservationHandler.UpdateReservation, 200, "予約情報更新成功")
+}
+
+// reservationWriter は予約の報告・更新に共通する処理を返す
+// 報告と更新はリクエスト・レスポンスの形式が同じで、呼び出すハンドラーと成功時のステータスだけが異なる
+func reservationWriter(
+	write func(ctx context.Context, eventID string, userID string, req *domain.ReservationRequest) (*domain.Reservation, error),
+	status int,
+	message string,
+) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.ReservationRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		reservation, err := write(ctx, eventID, principal.UserID, &req)
+		if err != nil {
+			return reservationErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, message, slog.Bool("hasReservationId", reservation.ReservationID != ""))
+		return dataResponse(status, reservation), nil
+	}
+}
+
+// reservationErrorResponse は予約のエラーをHTTPレスポンスに変換する
+// 手順の前後関係（会場の決定 → 予約完了の報告 → 更新）が守られていない場合は 409 で返す
+func reservationErrorResponse(ctx context.Context, err error) events.APIGatewayProxyResponse {
+	switch {
+	case errors.Is(err, handler.ErrNoVenue):
+		return middleware.Error(409, "VENUE_REQUIRED", "先に会場を決定してください", nil)
+	case errors.Is(err, handler.ErrReservationExists):
+		return middleware.Error(409, "RESERVATION_ALREADY_REPORTED", "予約完了は既に報告済みです", nil)
+	case errors.Is(err, handler.ErrNoReservation):
+		return middleware.Error(409, "RESERVATION_REPORT_REQUIRED", "先に予約完了を報告してください", nil)
+	default:
+		return eventErrorResponse(ctx, err)
+	}
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+	"github.com/luck-tech/kanji-log/backend/internal/restaurant"
+)
+
+// SearchRestaurants は POST /events/{eventId}/restaurants/search の処理を返す
+// 検索結果はイベントにも保存され、GET /events/{eventId} の restaurantSearch で再取得できる
+func SearchRestaurants(restaurantHandler *handler.RestaurantHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var criteria domain.RestaurantSearchCriteria
+		if resp, ok := decodeBody(ctx, request, &criteria); !ok {
+			return resp, nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		search, err := restaurantHandler.SearchRestaurants(ctx, eventID, principal.UserID, &criteria)
+		if err != nil {
+			return restaurantErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "レストラン検索成功",
+			slog.String("area", search.Criteria.Area),
+			slog.Int("results", len(search.Restaurants)),
+		)
+		return dataResponse(200, search), nil
+	}
+}
+
+// SuggestRestaurants は GET /events/{eventId}/restaurants/suggestions の処理を返す
+// 推薦は保存済みの検索結果から行うため、先に POST /events/{eventId}/restaurants/search を呼ぶ必要がある
+func SuggestRestaurants(restaurantHandler *handler.RestaurantHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		response, err := restaurantHandler.SuggestRestaurants(ctx, eventID, principal.UserID)
+		if err != nil {
+			return restaurantErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, response), nil
+	}
+}
+
+// SelectVenue は POST /events/{eventId}/restaurants/select の処理を返す
+// 決定した会場はイベントの venue に保存され、GET /events/{eventId} でも返される
+func SelectVenue(restaurantHandler *handler.RestaurantHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.SelectVenueRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		venue, err := restaurantHandler.SelectVenue(ctx, eventID, principal.UserID, &req)
+		if err != nil {
+			return restaurantErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "会場決定成功",
+			slog.String("restaurantId", venue.RestaurantID),
+			slog.String("provider", venue.Provider),
+		)
+		return dataResponse(200, venue), nil
+	}
+}
+
+// restaurantErrorResponse はお店探しのエラーをHTTPレスポンスに変換する
+// 外部サービスの障害は利用者の操作では解決しないため 502 で返す
+func restaurantErrorResponse(ctx context.Context, err error) events.APIGatewayProxyResponse {
+	switch {
+	case errors.Is(err, handler.ErrNoRestaurantSearch):
+		return middleware.Error(409, "RESTAURANT_SEARCH_REQUIRED", "先にレストランを検索してください", nil)
+	case errors.Is(err, restaurant.ErrNotFound):
+		return middleware.Error(404, "RESTAURANT_NOT_FOUND", "店舗が見つかりません", nil)
+	case errors.Is(err, restaurant.ErrUnavailable):
+		slog.WarnContext(ctx, "レストラン検索サービスエラー", slog.Any("error", err))
+		return middleware.Error(502, "EXTERNAL_SERVICE_ERROR", "外部サービスとの連携でエラーが発生しました", nil)
+	default:
+		return eventErrorResponse(ctx, err)
+	}
+}
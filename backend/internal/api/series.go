@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// CreateSeries は POST /series の処理を返す
+// シリーズと、生成期間内の開催回をまとめて 201 で返す
+func CreateSeries(seriesHandler *handler.SeriesHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.CreateSeriesRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		response, err := seriesHandler.CreateSeries(ctx, &req, principal.UserID)
+		if err != nil {
+			return seriesErrorResponse(ctx, err), nil
+		}
+
+		ctx = logging.With(ctx, slog.String("seriesId", response.Series.ID))
+		slog.InfoContext(ctx, "シリーズ作成成功", slog.Int("occurrences", len(response.Events)))
+		return dataResponse(201, response), nil
+	}
+}
+
+// GetSeries は GET /series/{seriesId} の処理を返す
+func GetSeries(seriesHandler *handler.SeriesHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		seriesID := request.PathParameters["seriesId"]
+		ctx = logging.With(ctx, slog.String("seriesId", seriesID))
+
+		response, err := seriesHandler.GetSeries(ctx, seriesID, principal.UserID)
+		if err != nil {
+			return seriesErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, response), nil
+	}
+}
+
+// GenerateSeriesEvents は POST /series/{seriesId}/generate の処理を返す
+// 生成期間内で未作成の開催回を作成する（繰り返し呼び出しても重複しない）
+func GenerateSeriesEvents(seriesHandler *handler.SeriesHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		seriesID := request.PathParameters["seriesId"]
+		ctx = logging.With(ctx, slog.String("seriesId", seriesID))
+
+		response, err := seriesHandler.GenerateUpcoming(ctx, seriesID, principal.UserID)
+		if err != nil {
+			return seriesErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "開催回生成成功", slog.String("generatedThrough", response.Series.GeneratedThrough))
+		return dataResponse(200, response), nil
+	}
+}
+
+// UpdateSeriesOccurrence は PUT /series/{seriesId}/events/{eventId} の処理を返す
+func UpdateSeriesOccurrence(seriesHandler *handler.SeriesHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.UpdateOccurrenceRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		seriesID := request.PathParameters["seriesId"]
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String("seriesId", seriesID), slog.String(logging.KeyEventID, eventID))
+
+		response, err := seriesHandler.UpdateOccurrence(ctx, seriesID, eventID, principal.UserID, &req)
+		if err != nil {
+			return seriesErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "開催回更新成功", slog.String("scope", req.Scope))
+		return dataResponse(200, response), nil
+	}
+}
+
+// seriesErrorResponse はシリーズ操作のエラーをHTTPレスポンスに変換する
+// 開催回のイベントIDの形式エラーなど、イベント共通のエラーは eventErrorResponse に委ねる
+func seriesErrorResponse(ctx context.Context, err error) events.APIGatewayProxyResponse {
+	switch {
+	case errors.Is(err, handler.ErrInvalidSeriesID):
+		return middleware.Error(400, "INVALID_SERIES_ID", "シリーズIDの形式が正しくありません", nil)
+	case errors.Is(err, handler.ErrNotInSeries):
+		return middleware.Error(404, "NOT_FOUND", "指定した開催回はこのシリーズにありません", nil)
+	case errors.Is(err, repository.ErrNotFound), errors.Is(err, handler.ErrForbidden):
+		return middleware.Error(404, "NOT_FOUND", "シリーズまたは開催回が見つかりません", nil)
+	default:
+		return eventErrorResponse(ctx, err)
+	}
+}
@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+)
+
+// GetSettlement は GET /events/{eventId}/settlement の処理を返す
+func GetSettlement(settlementHandler *handler.SettlementHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		settlement, err := settlementHandler.GetSettlement(ctx, eventID, principal.UserID)
+		if err != nil {
+			return settlementErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, settlement), nil
+	}
+}
+
+// SaveSettlement は PUT /events/{eventId}/settlement の処理を返す
+func SaveSettlement(settlementHandler *handler.SettlementHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.SettlementRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		settlement, err := settlementHandler.SaveSettlement(ctx, eventID, principal.UserID, &req)
+		if err != nil {
+			return settlementErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "精算保存成功",
+			slog.String("method", settlement.Method),
+			slog.Int("members", len(settlement.Shares)),
+		)
+		return dataResponse(200, settlement), nil
+	}
+}
+
+// UpdatePayment は PUT /events/{eventId}/settlement/payments の処理を返す
+func UpdatePayment(settlementHandler *handler.SettlementHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.PaymentRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		settlement, err := settlementHandler.UpdatePayment(ctx, eventID, principal.UserID, &req)
+		if err != nil {
+			return settlementErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "支払い状況更新成功", slog.Int("unpaidCount", settlement.UnpaidCount))
+		return dataResponse(200, settlement), nil
+	}
+}
+
+// RemindUnpaid は POST /events/{eventId}/settlement/reminders の処理を返す
+func RemindUnpaid(settlementHandler *handler.SettlementHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		reminders, err := settlementHandler.RemindUnpaid(ctx, eventID, principal.UserID)
+		if err != nil {
+			return settlementErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "支払い催促記録成功", slog.Int("count", len(reminders)))
+		return dataResponse(200, reminders), nil
+	}
+}
+
+// settlementErrorResponse は精算のエラーをHTTPレスポンスに変換する
+func settlementErrorResponse(ctx context.Context, err error) events.APIGatewayProxyResponse {
+	switch {
+	case errors.Is(err, handler.ErrNoAttendees):
+		return middleware.Error(409, "ATTENDEES_REQUIRED", "参加と回答したメンバーがいないため精算できません", nil)
+	case errors.Is(err, handler.ErrNoSettlement):
+		return middleware.Error(409, "SETTLEMENT_REQUIRED", "先に精算を作成してください", nil)
+	default:
+		return eventErrorResponse(ctx, err)
+	}
+}
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+)
+
+// ShareRecord は POST /records/private/{recordId}/share の処理を返す
+func ShareRecord(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.ShareRecordRequest
+		if resp, ok := decodeOptionalBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		recordID := request.PathParameters["recordId"]
+		ctx = logging.With(ctx, slog.String("recordId", recordID))
+
+		shared, err := recordHandler.ShareRecord(ctx, recordID, principal.UserID, &req)
+		if err != nil {
+			return recordErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "記録共有成功")
+		return dataResponse(200, shared), nil
+	}
+}
+
+// UnshareRecord は DELETE /records/private/{recordId}/share の処理を返す
+func UnshareRecord(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		recordID := request.PathParameters["recordId"]
+		ctx = logging.With(ctx, slog.String("recordId", recordID))
+
+		if err := recordHandler.UnshareRecord(ctx, recordID, principal.UserID); err != nil {
+			return recordErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "記録共有停止成功")
+		return middleware.JSON(200, map[string]bool{"success": true}), nil
+	}
+}
+
+// ListSharedRecords は GET /records/shared の処理を返す
+// 絞り込み条件はクエリパラメータ（area・purpose・genre・minPrice・maxPrice・liked・page・limit）で指定する
+func ListSharedRecords(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		query, resp, ok := parseSharedRecordQuery(request.QueryStringParameters)
+		if !ok {
+			return resp, nil
+		}
+
+		page, err := recordHandler.ListSharedRecords(ctx, principal.UserID, query)
+		if err != nil {
+			return recordErrorResponse(ctx, err), nil
+		}
+		return middleware.JSON(200, map[string]interface{}{
+			"success": true,
+			"data":    page.Records,
+			"meta":    map[string]interface{}{"pagination": page.Pagination},
+		}), nil
+	}
+}
+
+// LikeRecord は POST /records/shared/{recordId}/like の処理を返す
+func LikeRecord(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return recordLikeHandler(recordHandler.LikeRecord)
+}
+
+// UnlikeRecord は DELETE /records/shared/{recordId}/like の処理を返す
+func UnlikeRecord(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return recordLikeHandler(recordHandler.UnlikeRecord)
+}
+
+// recordLikeHandler はいいね・取り消しの共通処理を返す（どちらも何度呼んでも同じ結果になる）
+func recordLikeHandler(apply func(ctx context.Context, recordID string, userID string) (*domain.RecordLikes, error)) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		recordID := request.PathParameters["recordId"]
+		ctx = logging.With(ctx, slog.String("recordId", recordID))
+
+		likes, err := apply(ctx, recordID, principal.UserID)
+		if err != nil {
+			return recordErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, likes), nil
+	}
+}
+
+// ReportRecord は POST /records/shared/{recordId}/report の処理を返す
+func ReportRecord(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.ReportRecordRequest
+		if resp, ok := decodeBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		recordID := request.PathParameters["recordId"]
+		ctx = logging.With(ctx, slog.String("recordId", recordID))
+
+		if err := recordHandler.ReportRecord(ctx, recordID, principal.UserID, &req); err != nil {
+			return recordErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "不適切報告受付", slog.String("reason", req.Reason))
+		return middleware.JSON(200, map[string]bool{"success": true}), nil
+	}
+}
+
+// parseSharedRecordQuery はクエリパラメータを共有記録の絞り込み条件に変換する
+// 数値・真偽値として解釈できない場合は返却したエラーレスポンスと false を返す
+func parseSharedRecordQuery(params map[string]string) (*domain.SharedRecordQuery, events.APIGatewayProxyResponse, bool) {
+	query := &domain.SharedRecordQuery{
+		Area:    params["area"],
+		Purpose: params["purpose"],
+		Genre:   params["genre"],
+	}
+	for name, target := range map[string]*int{
+		"minPrice": &query.MinPrice,
+		"maxPrice": &query.MaxPrice,
+		"page":     &query.Page,
+		"limit":    &query.Limit,
+	} {
+		value, ok := params[name]
+		if !ok || value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, invalidQueryResponse(name), false
+		}
+		*target = n
+	}
+	if value := params["liked"]; value != "" {
+		liked, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, invalidQueryResponse("liked"), false
+		}
+		query.Liked = liked
+	}
+	return query, events.APIGatewayProxyResponse{}, true
+}
+
+// invalidQueryResponse は形式が正しくないクエリパラメータのエラーレスポンスを返す
+func invalidQueryResponse(name string) events.APIGatewayProxyResponse {
+	return middleware.Error(400, "INVALID_QUERY", "クエリパラメータの形式が正しくありません", map[string]interface{}{
+		"parameter": name,
+	})
+}
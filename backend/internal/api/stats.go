@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+)
+
+// GetStats は GET /records/stats の処理を返す
+// 集計期間はクエリパラメータ from・to（YYYY-MM-DD）で指定する
+func GetStats(recordHandler *handler.RecordHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		query := &domain.StatsQuery{
+			From: request.QueryStringParameters["from"],
+			To:   request.QueryStringParameters["to"],
+		}
+		stats, err := recordHandler.GetStats(ctx, principal.UserID, query)
+		if err != nil {
+			return recordErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, stats), nil
+	}
+}
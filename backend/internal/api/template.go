@@ -0,0 +1,146 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/handler"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/middleware"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// DuplicateEvent は POST /events/{eventId}/duplicate の処理を返す
+// ボディ（新しい日時・タイトル）は省略可能
+func DuplicateEvent(eventHandler *handler.EventHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.DuplicateEventRequest
+		if resp, ok := decodeOptionalBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		sourceID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String("sourceEventId", sourceID))
+
+		event, err := eventHandler.DuplicateEvent(ctx, sourceID, principal.UserID, &req)
+		if err != nil {
+			return eventErrorResponse(ctx, err), nil
+		}
+
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, event.ID))
+		slog.InfoContext(ctx, "イベント複製成功")
+		return middleware.JSON(201, domain.CreateEventResponse{Success: true, Data: event}), nil
+	}
+}
+
+// SaveTemplate は POST /events/{eventId}/template の処理を返す
+func SaveTemplate(templateHandler *handler.TemplateHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.CreateTemplateRequest
+		if resp, ok := decodeOptionalBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		eventID := request.PathParameters["eventId"]
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, eventID))
+
+		template, err := templateHandler.SaveTemplate(ctx, eventID, principal.UserID, &req)
+		if err != nil {
+			return eventErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "テンプレート保存成功", slog.String("templateId", template.ID))
+		return dataResponse(201, template), nil
+	}
+}
+
+// ListTemplates は GET /templates の処理を返す
+func ListTemplates(templateHandler *handler.TemplateHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		templates, err := templateHandler.ListTemplates(ctx, principal.UserID)
+		if err != nil {
+			return templateErrorResponse(ctx, err), nil
+		}
+		return dataResponse(200, templates), nil
+	}
+}
+
+// CreateEventFromTemplate は POST /templates/{templateId}/events の処理を返す
+func CreateEventFromTemplate(templateHandler *handler.TemplateHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		var req domain.DuplicateEventRequest
+		if resp, ok := decodeOptionalBody(ctx, request, &req); !ok {
+			return resp, nil
+		}
+
+		templateID := request.PathParameters["templateId"]
+		ctx = logging.With(ctx, slog.String("templateId", templateID))
+
+		event, err := templateHandler.CreateEventFromTemplate(ctx, templateID, principal.UserID, &req)
+		if err != nil {
+			return templateErrorResponse(ctx, err), nil
+		}
+
+		ctx = logging.With(ctx, slog.String(logging.KeyEventID, event.ID))
+		slog.InfoContext(ctx, "テンプレートからイベント作成成功")
+		return middleware.JSON(201, domain.CreateEventResponse{Success: true, Data: event}), nil
+	}
+}
+
+// DeleteTemplate は DELETE /templates/{templateId} の処理を返す
+func DeleteTemplate(templateHandler *handler.TemplateHandler) middleware.HandlerFunc {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return middleware.Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+		}
+
+		templateID := request.PathParameters["templateId"]
+		ctx = logging.With(ctx, slog.String("templateId", templateID))
+
+		if err := templateHandler.DeleteTemplate(ctx, templateID, principal.UserID); err != nil {
+			return templateErrorResponse(ctx, err), nil
+		}
+
+		slog.InfoContext(ctx, "テンプレート削除成功")
+		return middleware.JSON(200, map[string]bool{"success": true}), nil
+	}
+}
+
+// templateErrorResponse はテンプレート操作のエラーをHTTPレスポンスに変換する
+// 他の幹事のテンプレートはイベントと同様に存在を明かさず404を返す
+func templateErrorResponse(ctx context.Context, err error) events.APIGatewayProxyResponse {
+	switch {
+	case errors.Is(err, handler.ErrInvalidTemplateID):
+		return middleware.Error(400, "INVALID_TEMPLATE_ID", "テンプレートIDの形式が正しくありません", nil)
+	case errors.Is(err, repository.ErrNotFound), errors.Is(err, handler.ErrForbidden):
+		return middleware.Error(404, "NOT_FOUND", "テンプレートが見つかりません", nil)
+	default:
+		return eventErrorResponse(ctx, err)
+	}
+}
@@ -0,0 +1,130 @@
+package area
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// MaxCandidates は集合場所の候補として返す駅の最大数
+const MaxCandidates = 5
+
+// ErrNoLocations は駅データで座標がわかるメンバーが1人もいないことを表す
+var ErrNoLocations = errors.New("最寄り駅のわかるメンバーがいません")
+
+// Analyze はメンバーの最寄り駅から集合場所の候補を求める
+//
+// 候補は駅データの全駅を対象に、最も遠いメンバーまでの距離が短い順（同じなら平均距離が短い順）に並べる。
+// 1人だけが極端に遠くなる場所を避けるため、平均ではなく最大距離を優先する（ミニマックス）。
+// 最寄り駅が空、または駅データにないメンバーは UnresolvedMembers に入れて計算から除く。
+func (d *Directory) Analyze(locations []domain.MemberLocation) (*domain.AreaAnalysis, error) {
+	resolved := make([]domain.MemberLocation, 0, len(locations))
+	unresolved := make([]domain.MemberLocation, 0)
+	for _, location := range locations {
+		station, ok := d.Lookup(location.Station)
+		if location.Station == "" || !ok {
+			unresolved = append(unresolved, location)
+			continue
+		}
+		location.Station = station.Name
+		location.Latitude = station.Latitude
+		location.Longitude = station.Longitude
+		resolved = append(resolved, location)
+	}
+	if len(resolved) == 0 {
+		return nil, ErrNoLocations
+	}
+
+	candidates := make([]domain.AreaCandidate, 0, len(d.stations))
+	for _, station := range d.stations {
+		candidates = append(candidates, evaluate(station, resolved))
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].MaxDistance != candidates[j].MaxDistance {
+			return candidates[i].MaxDistance < candidates[j].MaxDistance
+		}
+		return candidates[i].AverageDistance < candidates[j].AverageDistance
+	})
+	if len(candidates) > MaxCandidates {
+		candidates = candidates[:MaxCandidates]
+	}
+
+	suggested := make([]string, 0, len(candidates))
+	for i := range candidates {
+		suggested = append(suggested, candidates[i].Station)
+		roundSummary(&candidates[i].DistanceSummary)
+	}
+
+	fairest := candidates[0]
+	centroid := centroidOf(resolved)
+	if nearest, ok := d.Nearest(centroid.Latitude, centroid.Longitude); ok {
+		centroid.NearestStation = nearest.Name
+	}
+	centroid.Latitude = round(centroid.Latitude, 4)
+	centroid.Longitude = round(centroid.Longitude, 4)
+
+	return &domain.AreaAnalysis{
+		CenterArea: domain.CenterArea{
+			Name:              fairest.Station + "駅周辺",
+			Station:           fairest.Station,
+			Latitude:          fairest.Latitude,
+			Longitude:         fairest.Longitude,
+			SuggestedStations: suggested,
+		},
+		Centroid:          centroid,
+		Analysis:          fairest.DistanceSummary,
+		Candidates:        candidates,
+		Members:           resolved,
+		UnresolvedMembers: unresolved,
+	}, nil
+}
+
+// evaluate は駅 station から各メンバーの最寄り駅までの距離を求める（距離は丸めない）
+func evaluate(station Station, members []domain.MemberLocation) domain.AreaCandidate {
+	candidate := domain.AreaCandidate{
+		Station:   station.Name,
+		Latitude:  station.Latitude,
+		Longitude: station.Longitude,
+		Distances: make([]domain.MemberDistance, 0, len(members)),
+	}
+	total := 0.0
+	for _, member := range members {
+		distance := Distance(member.Latitude, member.Longitude, station.Latitude, station.Longitude)
+		total += distance
+		candidate.MaxDistance = math.Max(candidate.MaxDistance, distance)
+		candidate.Distances = append(candidate.Distances, domain.MemberDistance{
+			Name:     member.Name,
+			Station:  member.Station,
+			Distance: round(distance, 1),
+		})
+	}
+	candidate.AverageDistance = total / float64(len(members))
+	return candidate
+}
+
+// centroidOf はメンバーの最寄り駅の緯度・経度の平均を返す
+// 対象は首都圏程度の範囲のため、球面ではなく単純な平均で近似する
+func centroidOf(members []domain.MemberLocation) domain.Centroid {
+	var c domain.Centroid
+	for _, member := range members {
+		c.Latitude += member.Latitude
+		c.Longitude += member.Longitude
+	}
+	c.Latitude /= float64(len(members))
+	c.Longitude /= float64(len(members))
+	return c
+}
+
+// roundSummary は距離の集計を 0.1km 単位に丸める
+func roundSummary(summary *domain.DistanceSummary) {
+	summary.AverageDistance = round(summary.AverageDistance, 1)
+	summary.MaxDistance = round(summary.MaxDistance, 1)
+}
+
+// round は value を小数点以下 digits 桁に四捨五入する
+func round(value float64, digits int) float64 {
+	scale := math.Pow(10, float64(digits))
+	return math.Round(value*scale) / scale
+}
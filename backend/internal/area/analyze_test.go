@@ -0,0 +1,118 @@
+package area
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+func TestLookup(t *testing.T) {
+	directory, err := NewDefaultDirectory()
+	if err != nil {
+		t.Fatalf("NewDefaultDirectory() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		input  string
+		want   string
+		wantOK bool
+	}{
+		{name: "駅名", input: "新宿", want: "新宿", wantOK: true},
+		{name: "末尾の駅と空白は無視", input: " 新宿駅 ", want: "新宿", wantOK: true},
+		{name: "別名", input: "市ヶ谷", want: "市ケ谷", wantOK: true},
+		{name: "データにない駅", input: "札幌", wantOK: false},
+		{name: "空文字", input: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := directory.Lookup(tt.input)
+			if ok != tt.wantOK || got.Name != tt.want {
+				t.Errorf("Lookup(%q) = %q, %v, %q, %v を期待", tt.input, got.Name, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	// 東京駅〜新宿駅はおよそ6.1km
+	got := Distance(35.6812, 139.7671, 35.6896, 139.7006)
+	if math.Abs(got-6.1) > 0.1 {
+		t.Errorf("Distance() = %.2f, 約6.1 を期待", got)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	directory, err := NewDefaultDirectory()
+	if err != nil {
+		t.Fatalf("NewDefaultDirectory() error = %v", err)
+	}
+
+	t.Run("最も遠いメンバーの距離が最小の駅を選ぶ", func(t *testing.T) {
+		got, err := directory.Analyze([]domain.MemberLocation{
+			{Name: "田中", Station: "池袋"},
+			{Name: "佐藤", Station: "渋谷駅"},
+			{Name: "鈴木", Station: "東京"},
+			{Name: "高橋", Station: "札幌"},
+			{Name: "伊藤"},
+		})
+		if err != nil {
+			t.Fatalf("Analyze() error = %v", err)
+		}
+
+		// 重心に最も近いのは四ツ谷だが、池袋からの距離が短い市ケ谷の方が公平
+		if got.CenterArea.Station != "市ケ谷" || got.CenterArea.Name != "市ケ谷駅周辺" {
+			t.Errorf("CenterArea = %+v, 市ケ谷 を期待", got.CenterArea)
+		}
+		if got.Centroid.NearestStation != "四ツ谷" {
+			t.Errorf("Centroid.NearestStation = %q, 四ツ谷 を期待", got.Centroid.NearestStation)
+		}
+		if got.Analysis != (domain.DistanceSummary{AverageDistance: 4.2, MaxDistance: 4.8}) {
+			t.Errorf("Analysis = %+v, 平均4.2km・最大4.8km を期待", got.Analysis)
+		}
+		if len(got.Candidates) != MaxCandidates || len(got.CenterArea.SuggestedStations) != MaxCandidates {
+			t.Fatalf("候補 = %d件, %d件を期待", len(got.Candidates), MaxCandidates)
+		}
+		for i := 1; i < len(got.Candidates); i++ {
+			if got.Candidates[i-1].MaxDistance > got.Candidates[i].MaxDistance {
+				t.Errorf("候補が最大距離の昇順ではありません: %+v", got.Candidates)
+			}
+		}
+		want := []domain.MemberDistance{
+			{Name: "田中", Station: "池袋", Distance: 4.8},
+			{Name: "佐藤", Station: "渋谷", Distance: 4.8},
+			{Name: "鈴木", Station: "東京", Distance: 3.1},
+		}
+		for i, distance := range got.Candidates[0].Distances {
+			if distance != want[i] {
+				t.Errorf("Distances[%d] = %+v, %+v を期待", i, distance, want[i])
+			}
+		}
+		if len(got.Members) != 3 || len(got.UnresolvedMembers) != 2 {
+			t.Errorf("Members = %d名, UnresolvedMembers = %d名, 3名・2名を期待", len(got.Members), len(got.UnresolvedMembers))
+		}
+	})
+
+	t.Run("全員が同じ駅ならその駅", func(t *testing.T) {
+		got, err := directory.Analyze([]domain.MemberLocation{
+			{Name: "田中", Station: "新宿"},
+			{Name: "佐藤", Station: "新宿"},
+		})
+		if err != nil {
+			t.Fatalf("Analyze() error = %v", err)
+		}
+		if got.CenterArea.Station != "新宿" || got.Analysis.MaxDistance != 0 {
+			t.Errorf("CenterArea = %+v, Analysis = %+v, 新宿・距離0 を期待", got.CenterArea, got.Analysis)
+		}
+	})
+
+	t.Run("駅のわかるメンバーがいない", func(t *testing.T) {
+		_, err := directory.Analyze([]domain.MemberLocation{{Name: "田中", Station: "札幌"}})
+		if !errors.Is(err, ErrNoLocations) {
+			t.Errorf("Analyze() error = %v, ErrNoLocations を期待", err)
+		}
+	})
+}
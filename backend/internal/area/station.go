@@ -0,0 +1,106 @@
+// Package area はメンバーの最寄り駅から、全員が集まりやすい集合場所を求める
+//
+// 駅の座標は同梱のデータ（stations.json、首都圏の主要駅）を使用し、ネットワークには接続しない。
+// 距離は駅どうしの直線距離（km）で、乗り換えや路線の所要時間は考慮しない。
+package area
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// stationsJSON は Directory の既定の駅データ
+//
+//go:embed stations.json
+var stationsJSON []byte
+
+// earthRadiusKm は距離の計算に使う地球の半径
+const earthRadiusKm = 6371.0
+
+// Station は駅名と座標
+type Station struct {
+	// Name は駅名（"駅" を含まない）
+	Name string `json:"name"`
+
+	// Latitude・Longitude は駅の緯度・経度
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+
+	// Aliases は表記の揺れ（例: "市ヶ谷"）
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// Directory は駅名から座標を引く駅の一覧
+type Directory struct {
+	stations []Station
+
+	// index は正規化した駅名・別名から stations の位置への索引
+	index map[string]int
+}
+
+// NewDirectory は stations を検索対象とする Directory を作成
+func NewDirectory(stations []Station) *Directory {
+	d := &Directory{
+		stations: append([]Station(nil), stations...),
+		index:    make(map[string]int, len(stations)),
+	}
+	for i, station := range d.stations {
+		d.index[normalizeStation(station.Name)] = i
+		for _, alias := range station.Aliases {
+			d.index[normalizeStation(alias)] = i
+		}
+	}
+	return d
+}
+
+// NewDefaultDirectory は同梱の駅データ（stations.json）を検索対象とする Directory を作成
+func NewDefaultDirectory() (*Directory, error) {
+	var stations []Station
+	if err := json.Unmarshal(stationsJSON, &stations); err != nil {
+		return nil, fmt.Errorf("駅データの読み込みに失敗: %w", err)
+	}
+	return NewDirectory(stations), nil
+}
+
+// Lookup は駅名から駅を探す
+// 全角・半角の違い、前後の空白、末尾の "駅" は無視する
+func (d *Directory) Lookup(name string) (Station, bool) {
+	i, ok := d.index[normalizeStation(name)]
+	if !ok {
+		return Station{}, false
+	}
+	return d.stations[i], true
+}
+
+// Nearest は座標に最も近い駅を返す（駅データが空の場合は false）
+func (d *Directory) Nearest(latitude, longitude float64) (Station, bool) {
+	var nearest Station
+	best := math.Inf(1)
+	for _, station := range d.stations {
+		if distance := Distance(latitude, longitude, station.Latitude, station.Longitude); distance < best {
+			nearest, best = station, distance
+		}
+	}
+	return nearest, len(d.stations) > 0
+}
+
+// Distance は2地点間の直線距離（km）をハバーサインの公式で求める
+func Distance(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
+
+// normalizeStation は駅名を索引用に正規化する
+func normalizeStation(name string) string {
+	name = strings.TrimSpace(norm.NFKC.String(name))
+	return strings.TrimSuffix(name, "駅")
+}
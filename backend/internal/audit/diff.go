@@ -0,0 +1,181 @@
+// Package audit はイベントの変更を監査ログ（domain.AuditEntry）として記録する
+//
+// EventRepository をラップした Recorder を経由して保存すると、作成・更新・削除のたびに
+// 変更者（認証済みユーザー）・操作種別・フィールドごとの差分が自動的に追記される。
+// ハンドラーは監査ログを意識せずにイベントを保存すればよい。
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// ignoredFields は差分に含めないフィールド（保存のたびに変わる、または変更内容ではないもの）
+var ignoredFields = map[string]bool{
+	"id":        true,
+	"createdAt": true,
+	"updatedAt": true,
+	"version":   true,
+}
+
+// summarizedFields は値をそのまま記録せず、要約して記録するフィールド
+// 監査ログは共同幹事全員が閲覧できるため、メンバーの連絡先・好み（アレルギー等）は残さない。
+// お店の検索結果は検索のたびに丸ごと変わり監査ログが肥大化するため、件数と検索者のみ残す
+var summarizedFields = map[string]func(value interface{}) interface{}{
+	"members":          summarizeMembers,
+	"restaurantSearch": summarizeRestaurantSearch,
+}
+
+// memberSummary は監査ログに記録するメンバーの項目
+// 回答日時はフォーム回答の有無を追えるよう残す（回答内容は記録しない）
+type memberSummary struct {
+	Name       string     `json:"name"`
+	Status     string     `json:"status"`
+	ResponseAt *time.Time `json:"responseAt,omitempty"`
+}
+
+// restaurantSearchSummary は監査ログに記録するお店の検索結果の要約
+type restaurantSearchSummary struct {
+	RestaurantCount int       `json:"restaurantCount"`
+	SearchedBy      string    `json:"searchedBy"`
+	SearchedAt      time.Time `json:"searchedAt"`
+}
+
+// Diff は2つのイベントのフィールドごとの差分を domain.Event の定義順で返す
+// before が nil の場合（作成時）は、after でゼロ値でないフィールドを変更として返す
+// 値はJSON表現に変換するため、JSONに出力されない値（招待コードなど）は記録されない
+func Diff(before *domain.Event, after *domain.Event) []domain.FieldChange {
+	changes := make([]domain.FieldChange, 0)
+	if after == nil {
+		return changes
+	}
+
+	afterValue := reflect.ValueOf(after).Elem()
+	var beforeValue reflect.Value
+	if before != nil {
+		beforeValue = reflect.ValueOf(before).Elem()
+	}
+
+	eventType := afterValue.Type()
+	for i := 0; i < eventType.NumField(); i++ {
+		name := jsonName(eventType.Field(i))
+		if name == "" || ignoredFields[name] {
+			continue
+		}
+
+		newValue := recordedValue(name, afterValue.Field(i))
+		var oldValue interface{}
+		if before != nil {
+			oldValue = recordedValue(name, beforeValue.Field(i))
+		} else if afterValue.Field(i).IsZero() {
+			continue
+		}
+
+		if !reflect.DeepEqual(oldValue, newValue) {
+			changes = append(changes, domain.FieldChange{Field: name, Before: oldValue, After: newValue})
+		}
+	}
+	return changes
+}
+
+// Classify は変更内容から監査ログの操作種別を判定する
+// 複数の種類の変更を含む場合は、ステータス → メンバーの増減 → フォーム回答 → 会場の決定 → その他の更新 の順に優先する
+func Classify(before *domain.Event, after *domain.Event) string {
+	switch {
+	case before == nil:
+		return domain.AuditActionCreated
+	case after == nil:
+		return domain.AuditActionDeleted
+	case before.Status != after.Status:
+		return domain.AuditActionStatusChanged
+	case len(after.Members) > len(before.Members):
+		return domain.AuditActionMemberAdded
+	case len(after.Members) < len(before.Members):
+		return domain.AuditActionMemberRemoved
+	case hasNewResponse(before.Members, after.Members):
+		return domain.AuditActionFormSubmitted
+	case after.Venue != nil && !reflect.DeepEqual(before.Venue, after.Venue):
+		return domain.AuditActionVenueSelected
+	default:
+		return domain.AuditActionUpdated
+	}
+}
+
+// hasNewResponse はメンバーの回答日時（ResponseAt）が新たに設定・更新されたかを返す
+func hasNewResponse(before []domain.Member, after []domain.Member) bool {
+	for i := range after {
+		if after[i].ResponseAt == nil {
+			continue
+		}
+		if i >= len(before) || before[i].ResponseAt == nil || !before[i].ResponseAt.Equal(*after[i].ResponseAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonName はフィールドのJSON名を返す（json:"-" の場合は空文字列）
+func jsonName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// recordedValue はフィールドの値を監査ログに記録する形（要約・JSON表現）に変換する
+func recordedValue(name string, field reflect.Value) interface{} {
+	value := field.Interface()
+	if summarize, ok := summarizedFields[name]; ok {
+		value = summarize(value)
+	}
+	return toJSONValue(value)
+}
+
+// summarizeMembers はメンバーを名前・参加状況・回答日時のみに絞る
+func summarizeMembers(value interface{}) interface{} {
+	members, _ := value.([]domain.Member)
+	if members == nil {
+		return nil
+	}
+	summaries := make([]memberSummary, 0, len(members))
+	for _, member := range members {
+		summaries = append(summaries, memberSummary{Name: member.Name, Status: member.Status, ResponseAt: member.ResponseAt})
+	}
+	return summaries
+}
+
+// summarizeRestaurantSearch はお店の検索結果を件数・検索者・検索日時に絞る
+func summarizeRestaurantSearch(value interface{}) interface{} {
+	search, _ := value.(*domain.RestaurantSearch)
+	if search == nil {
+		return nil
+	}
+	return restaurantSearchSummary{
+		RestaurantCount: len(search.Restaurants),
+		SearchedBy:      search.SearchedBy,
+		SearchedAt:      search.SearchedAt,
+	}
+}
+
+// toJSONValue は値をJSONに変換して読み戻した汎用的な値（map・slice・string 等）を返す
+// 構造体のフィールド名がJSON名に揃い、比較・保存の形式がAPIのレスポンスと一致する
+func toJSONValue(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil
+	}
+	return decoded
+}
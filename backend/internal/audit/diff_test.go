@@ -0,0 +1,169 @@
+package audit
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+func TestDiff(t *testing.T) {
+	before := &domain.Event{ID: "evt_1", Title: "新人歓迎会", Time: "19:00", Status: "planning", UpdatedAt: time.Unix(0, 0)}
+	after := &domain.Event{ID: "evt_1", Title: "新人歓迎会", Time: "18:30", Status: "planning", UpdatedAt: time.Unix(100, 0)}
+
+	changes := Diff(before, after)
+	if len(changes) != 1 {
+		t.Fatalf("Diff() = %+v, time の変更のみを期待", changes)
+	}
+	if got := changes[0]; got.Field != "time" || got.Before != "19:00" || got.After != "18:30" {
+		t.Errorf("Diff() = %+v, time: 19:00 → 18:30 を期待", got)
+	}
+
+	// 作成時は設定されたフィールドのみ（ID・日時は含めない）
+	created := Diff(nil, after)
+	fields := make([]string, 0, len(created))
+	for _, change := range created {
+		fields = append(fields, change.Field)
+		if change.Before != nil {
+			t.Errorf("作成時の Before = %v, nil を期待", change.Before)
+		}
+	}
+	if len(fields) != 3 || fields[0] != "title" {
+		t.Errorf("作成時の差分フィールド = %v, title/time/status を期待", fields)
+	}
+
+	// 招待コードはJSONに出力されないため差分に含まれない
+	withInvite := *before
+	withInvite.Collaborators = []domain.Collaborator{{ID: "col_1", InviteCode: "inv_secret"}}
+	for _, change := range Diff(before, &withInvite) {
+		if after, _ := change.After.([]interface{}); len(after) == 1 {
+			if _, ok := after[0].(map[string]interface{})["inviteCode"]; ok {
+				t.Errorf("差分に招待コードが含まれています: %+v", change)
+			}
+		}
+	}
+}
+
+func TestDiffOmitsMemberDetails(t *testing.T) {
+	responded := time.Unix(50, 0).UTC()
+	before := &domain.Event{Members: []domain.Member{{Name: "田中", Email: "tanaka@example.com", Status: "pending"}}}
+	after := &domain.Event{
+		Members: []domain.Member{{
+			Name: "田中", Email: "tanaka@example.com", Status: "attending", ResponseAt: &responded,
+			Preferences: map[string]interface{}{"allergies": []interface{}{"えび"}},
+		}},
+		RestaurantSearch: &domain.RestaurantSearch{
+			Criteria:    domain.RestaurantSearchCriteria{Keyword: "えび料理"},
+			Restaurants: []domain.Restaurant{{ID: "J001", Name: "和食 さくら"}, {ID: "J002", Name: "海鮮 まる"}},
+			SearchedBy:  "owner",
+		},
+	}
+
+	changes := Diff(before, after)
+	data, err := json.Marshal(changes)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	for _, secret := range []string{"tanaka@example.com", "allergies", "えび", "和食 さくら"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("差分に %q が含まれています: %s", secret, data)
+		}
+	}
+
+	if len(changes) != 2 || changes[0].Field != "members" || changes[1].Field != "restaurantSearch" {
+		t.Fatalf("Diff() = %+v, members・restaurantSearch の変更を期待", changes)
+	}
+	member := changes[0].After.([]interface{})[0].(map[string]interface{})
+	if member["name"] != "田中" || member["status"] != "attending" || member["responseAt"] == nil {
+		t.Errorf("members の差分 = %+v, 名前・参加状況・回答日時を期待", member)
+	}
+	if search := changes[1].After.(map[string]interface{}); search["restaurantCount"] != float64(2) || search["searchedBy"] != "owner" {
+		t.Errorf("restaurantSearch の差分 = %+v, 件数2・検索者 owner を期待", search)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	responded := time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC)
+	base := domain.Event{Status: "planning", Members: []domain.Member{{Name: "田中"}}}
+
+	tests := []struct {
+		name   string
+		before *domain.Event
+		after  func(e domain.Event) *domain.Event
+		want   string
+	}{
+		{
+			name:   "作成",
+			before: nil,
+			after:  func(e domain.Event) *domain.Event { return &e },
+			want:   domain.AuditActionCreated,
+		},
+		{
+			name:   "ステータス変更はメンバー追加より優先",
+			before: &base,
+			after: func(e domain.Event) *domain.Event {
+				e.Status = "confirmed"
+				e.Members = append([]domain.Member{}, e.Members...)
+				e.Members = append(e.Members, domain.Member{Name: "佐藤"})
+				return &e
+			},
+			want: domain.AuditActionStatusChanged,
+		},
+		{
+			name:   "メンバー追加",
+			before: &base,
+			after: func(e domain.Event) *domain.Event {
+				e.Members = append([]domain.Member{}, e.Members...)
+				e.Members = append(e.Members, domain.Member{Name: "佐藤"})
+				return &e
+			},
+			want: domain.AuditActionMemberAdded,
+		},
+		{
+			name:   "メンバー削除",
+			before: &base,
+			after: func(e domain.Event) *domain.Event {
+				e.Members = nil
+				return &e
+			},
+			want: domain.AuditActionMemberRemoved,
+		},
+		{
+			name:   "フォーム回答",
+			before: &base,
+			after: func(e domain.Event) *domain.Event {
+				e.Members = []domain.Member{{Name: "田中", ResponseAt: &responded}}
+				return &e
+			},
+			want: domain.AuditActionFormSubmitted,
+		},
+		{
+			name:   "会場の決定",
+			before: &base,
+			after: func(e domain.Event) *domain.Event {
+				e.Venue = &domain.Venue{Name: "炭火焼鳥 鳥心", Address: "東京都新宿区新宿3-1-1"}
+				return &e
+			},
+			want: domain.AuditActionVenueSelected,
+		},
+		{
+			name:   "内容の更新",
+			before: &base,
+			after: func(e domain.Event) *domain.Event {
+				e.Title = "歓送迎会"
+				return &e
+			},
+			want: domain.AuditActionUpdated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Classify(tt.before, tt.after(base)); got != tt.want {
+				t.Errorf("Classify() = %q, %q を期待", got, tt.want)
+			}
+		})
+	}
+}
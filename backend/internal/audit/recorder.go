@@ -0,0 +1,131 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// Recorder は監査ログを記録する repository.EventRepository のラッパー
+// 変更者は context の認証情報（auth.PrincipalFromContext）から取得する
+//
+// 使用例:
+//
+//	eventRepo := audit.NewRecorder(repository.NewDynamoDBEventRepository(client, table), auditRepo)
+//	eventHandler := handler.NewEventHandler(eventRepo)
+type Recorder struct {
+	// events は実際にイベントを保存するリポジトリ
+	events repository.EventRepository
+
+	// entries は監査ログの保存先
+	entries repository.AuditRepository
+
+	// idGen は監査ログIDの生成元
+	idGen idgen.Generator
+}
+
+// RecorderOption は Recorder の依存関係を差し替えるための関数オプション
+type RecorderOption func(*Recorder)
+
+// WithIDGenerator は監査ログIDの生成元を差し替える（テストで連番にする場合に使用）
+func WithIDGenerator(g idgen.Generator) RecorderOption {
+	return func(r *Recorder) {
+		r.idGen = g
+	}
+}
+
+// NewRecorder は events への保存時に entries へ監査ログを追記する Recorder を作成
+func NewRecorder(events repository.EventRepository, entries repository.AuditRepository, opts ...RecorderOption) *Recorder {
+	r := &Recorder{
+		events:  events,
+		entries: entries,
+		idGen:   idgen.UUIDGenerator{},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// CreateEvent はイベントを保存し、設定された値を作成の監査ログとして記録
+func (r *Recorder) CreateEvent(ctx context.Context, event *domain.Event) (*domain.Event, error) {
+	created, err := r.events.CreateEvent(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	r.record(ctx, created.ID, nil, created)
+	return created, nil
+}
+
+// GetEvent はイベントを取得（記録なし）
+func (r *Recorder) GetEvent(ctx context.Context, eventID string) (*domain.Event, error) {
+	return r.events.GetEvent(ctx, eventID)
+}
+
+// UpdateEvent は保存済みの内容と比較した差分を記録してからイベントを更新
+// 差分がない保存（同じ内容での上書き）は記録しない
+func (r *Recorder) UpdateEvent(ctx context.Context, event *domain.Event) (*domain.Event, error) {
+	before, err := r.events.GetEvent(ctx, event.ID)
+	if err != nil {
+		slog.WarnContext(ctx, "監査ログ用の変更前データの取得に失敗", slog.Any("error", err))
+	}
+
+	updated, err := r.events.UpdateEvent(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	if before != nil {
+		r.record(ctx, updated.ID, before, updated)
+	}
+	return updated, nil
+}
+
+// DeleteEvent はイベントを削除し、削除の監査ログを記録
+func (r *Recorder) DeleteEvent(ctx context.Context, eventID string) error {
+	if err := r.events.DeleteEvent(ctx, eventID); err != nil {
+		return err
+	}
+	r.append(ctx, &domain.AuditEntry{EventID: eventID, Action: domain.AuditActionDeleted, Changes: []domain.FieldChange{}})
+	return nil
+}
+
+// ListEventsByOrganizer はイベント一覧を取得（記録なし）
+func (r *Recorder) ListEventsByOrganizer(ctx context.Context, organizerID string, filters map[string]interface{}) ([]*domain.Event, error) {
+	return r.events.ListEventsByOrganizer(ctx, organizerID, filters)
+}
+
+// ListEventsBySeries はシリーズの開催回を取得（記録なし）
+func (r *Recorder) ListEventsBySeries(ctx context.Context, seriesID string) ([]*domain.Event, error) {
+	return r.events.ListEventsBySeries(ctx, seriesID)
+}
+
+// record は変更前後の差分を監査ログとして追記する
+func (r *Recorder) record(ctx context.Context, eventID string, before *domain.Event, after *domain.Event) {
+	changes := Diff(before, after)
+	if before != nil && len(changes) == 0 {
+		return
+	}
+	r.append(ctx, &domain.AuditEntry{EventID: eventID, Action: Classify(before, after), Changes: changes})
+}
+
+// append は変更者とIDを設定して監査ログを追記する
+// イベントの保存は完了しているため、追記に失敗しても操作自体は失敗させずエラーログを残す
+func (r *Recorder) append(ctx context.Context, entry *domain.AuditEntry) {
+	entry.ID = r.idGen.NewID("aud")
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		entry.ActorID = principal.UserID
+	}
+
+	if err := r.entries.AppendAuditEntry(ctx, entry); err != nil {
+		slog.ErrorContext(ctx, "監査ログの記録に失敗",
+			slog.String(logging.KeyEventID, entry.EventID),
+			slog.String("action", entry.Action),
+			slog.Any("error", err),
+		)
+	}
+}
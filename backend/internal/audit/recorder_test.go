@@ -0,0 +1,73 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// failingAuditRepository は常に追記に失敗する AuditRepository
+type failingAuditRepository struct {
+	repository.AuditRepository
+}
+
+func (failingAuditRepository) AppendAuditEntry(ctx context.Context, entry *domain.AuditEntry) error {
+	return errors.New("書き込みエラー")
+}
+
+func TestRecorder(t *testing.T) {
+	fixed := clock.NewFixedClock(time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC))
+	entries := repository.NewMemoryAuditRepository(repository.WithClock(fixed))
+	recorder := NewRecorder(repository.NewMemoryEventRepository(repository.WithClock(fixed)), entries,
+		WithIDGenerator(idgen.NewSequenceGenerator()),
+	)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "owner"})
+
+	event, err := recorder.CreateEvent(ctx, &domain.Event{ID: "evt_1", Title: "新人歓迎会", Time: "19:00", OrganizerID: "owner"})
+	if err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+
+	// 同じ内容での保存は記録しない
+	if _, err := recorder.UpdateEvent(ctx, event); err != nil {
+		t.Fatalf("UpdateEvent() error = %v", err)
+	}
+
+	changed := *event
+	changed.Time = "18:30"
+	fixed.Advance(time.Hour)
+	if _, err := recorder.UpdateEvent(auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "editor"}), &changed); err != nil {
+		t.Fatalf("UpdateEvent() error = %v", err)
+	}
+
+	got, err := entries.ListAuditEntries(ctx, "evt_1")
+	if err != nil {
+		t.Fatalf("ListAuditEntries() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("監査ログ = %d件, 作成と更新の2件を期待", len(got))
+	}
+	if got[0].Action != domain.AuditActionCreated || got[0].ActorID != "owner" || got[0].ID == "" {
+		t.Errorf("作成の監査ログが期待と異なります: %+v", got[0])
+	}
+	update := got[1]
+	if update.Action != domain.AuditActionUpdated || update.ActorID != "editor" || !update.CreatedAt.Equal(fixed.Now()) {
+		t.Errorf("更新の監査ログが期待と異なります: %+v", update)
+	}
+	if len(update.Changes) != 1 || update.Changes[0].Field != "time" {
+		t.Errorf("Changes = %+v, time の変更のみを期待", update.Changes)
+	}
+
+	// 監査ログの追記に失敗してもイベントの保存は成功させる
+	failing := NewRecorder(repository.NewMemoryEventRepository(), failingAuditRepository{})
+	if _, err := failing.CreateEvent(ctx, &domain.Event{ID: "evt_2", Title: "歓送迎会"}); err != nil {
+		t.Errorf("CreateEvent() error = %v, 監査ログの失敗は無視を期待", err)
+	}
+}
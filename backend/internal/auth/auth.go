@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// ErrUnauthenticated は認証情報がない、または検証に失敗したことを表すエラー
+// 呼び出し側は errors.Is(err, auth.ErrUnauthenticated) で判定し、401を返す
+var ErrUnauthenticated = errors.New("認証情報が見つかりません")
+
+// 認証方式（Principal.Method に設定される値）
+const (
+	// MethodAuthorizer はAPI Gateway Cognitoオーソライザーで検証済みのクレーム
+	MethodAuthorizer = "authorizer"
+
+	// MethodJWT はLambda内でJWKSを使って検証したJWT
+	MethodJWT = "jwt"
+
+	// MethodDevHeader は開発モードでの x-organizer-id ヘッダー
+	MethodDevHeader = "dev_header"
+)
+
+// DevHeader は開発モードでのみ受け付ける簡易認証ヘッダー名
+const DevHeader = "x-organizer-id"
+
+// Principal は認証済みのユーザー情報
+type Principal struct {
+	// UserID はCognitoユーザーの sub（幹事IDとして使用）
+	UserID string
+
+	// Email はユーザーのメールアドレス（クレームに含まれる場合のみ）
+	Email string
+
+	// Method はどの方式で認証されたか（MethodAuthorizer / MethodJWT / MethodDevHeader）
+	Method string
+}
+
+// Config は認証の設定
+type Config struct {
+	// DevMode が true の場合のみ x-organizer-id ヘッダーによる簡易認証を許可する
+	// 本番環境では絶対に有効にしてはならない
+	DevMode bool
+
+	// JWKSFile はJWT署名検証用の公開鍵セット（JWKS）ファイルのパス（任意）
+	// Cognitoの https://cognito-idp.<region>.amazonaws.com/<userPoolId>/.well-known/jwks.json を
+	// デプロイパッケージに同梱して指定する（Lambdaから外部通信せずに検証できる）
+	JWKSFile string
+
+	// Issuer はJWTの iss クレームの期待値（任意）
+	// 例: https://cognito-idp.ap-northeast-1.amazonaws.com/ap-northeast-1_XXXXXXXXX
+	Issuer string
+
+	// ClientID はCognitoアプリクライアントID（任意）
+	// IDトークンの aud、アクセストークンの client_id と照合する
+	ClientID string
+}
+
+// LoadConfigFromEnv は環境変数から認証設定を読み込む
+//
+// 環境変数:
+//   - AUTH_DEV_MODE: "true" の場合に開発モード（ヘッダー認証を許可）
+//   - AUTH_JWKS_FILE: JWKSファイルのパス
+//   - AUTH_ISSUER: JWTの発行者
+//   - AUTH_CLIENT_ID: CognitoアプリクライアントID
+func LoadConfigFromEnv() Config {
+	return Config{
+		DevMode:  os.Getenv("AUTH_DEV_MODE") == "true",
+		JWKSFile: os.Getenv("AUTH_JWKS_FILE"),
+		Issuer:   os.Getenv("AUTH_ISSUER"),
+		ClientID: os.Getenv("AUTH_CLIENT_ID"),
+	}
+}
+
+// Authenticator はAPI Gatewayリクエストから認証済みユーザーを特定する
+//
+// 判定順序:
+//  1. API Gateway Cognitoオーソライザーのクレーム（requestContext.authorizer.claims.sub）
+//  2. Authorization: Bearer <JWT> をJWKSで検証（JWKSFile 設定時のみ）
+//  3. x-organizer-id ヘッダー（DevMode 時のみ）
+type Authenticator struct {
+	config   Config
+	verifier *jwtVerifier
+}
+
+// New は設定からAuthenticatorを作成
+// JWKSFile が指定されている場合は起動時に読み込み、不正な場合はエラーを返す
+func New(config Config) (*Authenticator, error) {
+	a := &Authenticator{config: config}
+
+	if config.JWKSFile != "" {
+		keys, err := loadJWKSFile(config.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("JWKSファイルの読み込みに失敗: %w", err)
+		}
+		a.verifier = newJWTVerifier(keys, config.Issuer, config.ClientID)
+	}
+
+	return a, nil
+}
+
+// Authenticate はリクエストから認証済みユーザーを取得する
+// 認証できない場合は ErrUnauthenticated をラップしたエラーを返す
+func (a *Authenticator) Authenticate(request events.APIGatewayProxyRequest) (*Principal, error) {
+	// 1. Cognitoオーソライザーで検証済みのクレーム
+	if principal, ok := principalFromAuthorizer(request.RequestContext.Authorizer); ok {
+		return principal, nil
+	}
+
+	// 2. Bearerトークンのローカル検証
+	if token, ok := bearerToken(request); ok {
+		if a.verifier == nil {
+			return nil, fmt.Errorf("JWT検証が設定されていません: %w", ErrUnauthenticated)
+		}
+		principal, err := a.verifier.verify(token)
+		if err != nil {
+			return nil, fmt.Errorf("JWTの検証に失敗: %v: %w", err, ErrUnauthenticated)
+		}
+		return principal, nil
+	}
+
+	// 3. 開発モードのみ許可するヘッダー認証
+	if a.config.DevMode {
+		if userID := header(request, DevHeader); userID != "" {
+			return &Principal{UserID: userID, Method: MethodDevHeader}, nil
+		}
+	}
+
+	return nil, ErrUnauthenticated
+}
+
+// principalFromAuthorizer はCognitoオーソライザーのクレームからユーザー情報を取得
+// API Gateway REST API では requestContext.authorizer.claims に格納される
+func principalFromAuthorizer(authorizer map[string]interface{}) (*Principal, bool) {
+	claims, ok := authorizer["claims"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	sub, ok := claims["sub"].(string)
+	if !ok || sub == "" {
+		return nil, false
+	}
+
+	email, _ := claims["email"].(string)
+	return &Principal{UserID: sub, Email: email, Method: MethodAuthorizer}, true
+}
+
+// bearerToken は Authorization: Bearer <token> からトークンを取り出す
+func bearerToken(request events.APIGatewayProxyRequest) (string, bool) {
+	value := header(request, "Authorization")
+	scheme, token, found := strings.Cut(value, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// header はヘッダー名の大文字・小文字を区別せずに値を取得
+func header(request events.APIGatewayProxyRequest, name string) string {
+	for key, value := range request.Headers {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testIssuer   = "https://cognito-idp.ap-northeast-1.amazonaws.com/ap-northeast-1_test"
+	testClientID = "test-client-id"
+	testKeyID    = "test-key"
+)
+
+// newTestKey はテスト用のRSA鍵を生成し、その公開鍵だけを含むJWKSファイルを書き出す
+func newTestKey(t *testing.T) (*rsa.PrivateKey, string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("RSA鍵の生成に失敗: %v", err)
+	}
+
+	set := jsonWebKeySet{Keys: []jsonWebKey{{
+		Kty: "RSA",
+		Kid: testKeyID,
+		Alg: "RS256",
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+	data, _ := json.Marshal(set)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("JWKSファイルの書き込みに失敗: %v", err)
+	}
+	return key, path
+}
+
+// signToken はテスト用のJWTを生成する
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("JWTの署名に失敗: %v", err)
+	}
+	return signed
+}
+
+// validClaims はCognito IDトークン相当の正しいクレームを返す
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":       "user-sub-1",
+		"email":     "kanji@example.com",
+		"iss":       testIssuer,
+		"aud":       testClientID,
+		"token_use": "id",
+		"exp":       time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func bearerRequest(token string) events.APIGatewayProxyRequest {
+	return events.APIGatewayProxyRequest{Headers: map[string]string{"Authorization": "Bearer " + token}}
+}
+
+func TestAuthenticateJWT(t *testing.T) {
+	key, jwksPath := newTestKey(t)
+	otherKey, _ := newTestKey(t)
+
+	a, err := New(Config{JWKSFile: jwksPath, Issuer: testIssuer, ClientID: testClientID})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	withClaims := func(modify func(jwt.MapClaims)) jwt.MapClaims {
+		claims := validClaims()
+		modify(claims)
+		return claims
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "正しいIDトークン", token: signToken(t, key, testKeyID, validClaims())},
+		{
+			name: "正しいアクセストークン",
+			token: signToken(t, key, testKeyID, withClaims(func(c jwt.MapClaims) {
+				delete(c, "aud")
+				c["token_use"] = "access"
+				c["client_id"] = testClientID
+			})),
+		},
+		{name: "別の鍵で署名", token: signToken(t, otherKey, testKeyID, validClaims()), wantErr: true},
+		{name: "未知の kid", token: signToken(t, key, "unknown", validClaims()), wantErr: true},
+		{name: "有効期限切れ", token: signToken(t, key, testKeyID, withClaims(func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() })), wantErr: true},
+		{name: "有効期限なし", token: signToken(t, key, testKeyID, withClaims(func(c jwt.MapClaims) { delete(c, "exp") })), wantErr: true},
+		{name: "発行者が異なる", token: signToken(t, key, testKeyID, withClaims(func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" })), wantErr: true},
+		{name: "クライアントIDが異なる", token: signToken(t, key, testKeyID, withClaims(func(c jwt.MapClaims) { c["aud"] = "other-client" })), wantErr: true},
+		{name: "token_use が不正", token: signToken(t, key, testKeyID, withClaims(func(c jwt.MapClaims) { c["token_use"] = "refresh" })), wantErr: true},
+		{name: "sub がない", token: signToken(t, key, testKeyID, withClaims(func(c jwt.MapClaims) { delete(c, "sub") })), wantErr: true},
+		{name: "JWT形式でない", token: "not-a-jwt", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := a.Authenticate(bearerRequest(tt.token))
+			if tt.wantErr {
+				if !errors.Is(err, ErrUnauthenticated) {
+					t.Errorf("Authenticate() error = %v, ErrUnauthenticated を期待", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if principal.UserID != "user-sub-1" || principal.Method != MethodJWT {
+				t.Errorf("Authenticate() = %+v", principal)
+			}
+		})
+	}
+}
+
+func TestAuthenticateSources(t *testing.T) {
+	authorizerRequest := events.APIGatewayProxyRequest{
+		Headers: map[string]string{DevHeader: "spoofed"},
+		RequestContext: events.APIGatewayProxyRequestContext{
+			Authorizer: map[string]interface{}{
+				"claims": map[string]interface{}{"sub": "cognito-sub", "email": "kanji@example.com"},
+			},
+		},
+	}
+	headerRequest := events.APIGatewayProxyRequest{Headers: map[string]string{"X-Organizer-Id": "dev-user"}}
+
+	tests := []struct {
+		name       string
+		config     Config
+		request    events.APIGatewayProxyRequest
+		wantUserID string
+		wantMethod string
+		wantErr    bool
+	}{
+		{name: "オーソライザーのクレーム", request: authorizerRequest, wantUserID: "cognito-sub", wantMethod: MethodAuthorizer},
+		{name: "開発モードのヘッダー（大文字小文字を区別しない）", config: Config{DevMode: true}, request: headerRequest, wantUserID: "dev-user", wantMethod: MethodDevHeader},
+		{name: "開発モード以外ではヘッダーを拒否", request: headerRequest, wantErr: true},
+		{name: "JWKS未設定のBearerトークンは拒否", config: Config{DevMode: true}, request: bearerRequest("token"), wantErr: true},
+		{name: "認証情報なし", config: Config{DevMode: true}, request: events.APIGatewayProxyRequest{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := New(tt.config)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			principal, err := a.Authenticate(tt.request)
+			if tt.wantErr {
+				if !errors.Is(err, ErrUnauthenticated) {
+					t.Errorf("Authenticate() error = %v, ErrUnauthenticated を期待", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if principal.UserID != tt.wantUserID || principal.Method != tt.wantMethod {
+				t.Errorf("Authenticate() = %+v, UserID=%q Method=%q を期待", principal, tt.wantUserID, tt.wantMethod)
+			}
+		})
+	}
+}
+
+func TestNewInvalidJWKS(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, []byte(`{"keys":[]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(Config{JWKSFile: path}); err == nil {
+		t.Error("空のJWKSでエラーを期待しました")
+	}
+	if _, err := New(Config{JWKSFile: filepath.Join(t.TempDir(), "missing.json")}); err == nil {
+		t.Error("存在しないJWKSファイルでエラーを期待しました")
+	}
+}
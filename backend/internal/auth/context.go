@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"context"
+)
+
+// contextKey はcontextにPrincipalを格納するためのキー型
+type contextKey struct{}
+
+// WithPrincipal は認証済みユーザーを格納したcontextを返す
+// middleware.Authenticate が認証成功時に呼び出す
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, principal)
+}
+
+// PrincipalFromContext はcontextに格納された認証済みユーザーを返す
+// 認証ミドルウェアを通っていない場合は false を返す
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(contextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// jsonWebKey はJWKSに含まれる1つの公開鍵（RSAのみ対応）
+// Cognitoは RS256 で署名するため、RSA公開鍵の n（モジュラス）と e（指数）を使用する
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// jsonWebKeySet はJWKSファイルの形式
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// loadJWKSFile はJWKSファイルを読み込み、kid をキーとしたRSA公開鍵のマップを返す
+func loadJWKSFile(path string) (map[string]*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseJWKS(data)
+}
+
+// parseJWKS はJWKSのJSONを解析する
+// RSA以外の鍵や署名用途以外の鍵は無視する
+func parseJWKS(data []byte) (map[string]*rsa.PublicKey, error) {
+	var set jsonWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("JWKSのJSON解析に失敗: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, key := range set.Keys {
+		if key.Kty != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, fmt.Errorf("鍵 %s のモジュラスが不正です: %w", key.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return nil, fmt.Errorf("鍵 %s の指数が不正です: %w", key.Kid, err)
+		}
+
+		keys[key.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKSに有効なRSA公開鍵がありません")
+	}
+	return keys, nil
+}
+
+// jwtVerifier はJWKSの公開鍵を使ってJWTを検証する
+// 署名アルゴリズム・有効期限・発行者・クライアントIDをチェックする
+type jwtVerifier struct {
+	keys     map[string]*rsa.PublicKey
+	clientID string
+	parser   *jwt.Parser
+}
+
+// newJWTVerifier はJWT検証器を作成
+// issuer が空の場合は iss クレームを検証しない
+func newJWTVerifier(keys map[string]*rsa.PublicKey, issuer string, clientID string) *jwtVerifier {
+	parserOptions := []jwt.ParserOption{
+		// alg=none や HS256 への差し替え攻撃を防ぐため RS256 のみ許可
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithExpirationRequired(),
+	}
+	if issuer != "" {
+		parserOptions = append(parserOptions, jwt.WithIssuer(issuer))
+	}
+
+	return &jwtVerifier{
+		keys:     keys,
+		clientID: clientID,
+		parser:   jwt.NewParser(parserOptions...),
+	}
+}
+
+// verify はJWTを検証し、クレームからユーザー情報を返す
+func (v *jwtVerifier) verify(tokenString string) (*Principal, error) {
+	claims := jwt.MapClaims{}
+	if _, err := v.parser.ParseWithClaims(tokenString, claims, v.keyFunc); err != nil {
+		return nil, err
+	}
+
+	// Cognitoのトークン種別: IDトークン（aud にクライアントID）とアクセストークン（client_id にクライアントID）
+	tokenUse, _ := claims["token_use"].(string)
+	switch tokenUse {
+	case "id":
+		if v.clientID != "" && !audienceContains(claims, v.clientID) {
+			return nil, fmt.Errorf("aud がクライアントIDと一致しません")
+		}
+	case "access":
+		if clientID, _ := claims["client_id"].(string); v.clientID != "" && clientID != v.clientID {
+			return nil, fmt.Errorf("client_id がクライアントIDと一致しません")
+		}
+	default:
+		return nil, fmt.Errorf("未対応の token_use です: %q", tokenUse)
+	}
+
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		return nil, fmt.Errorf("sub クレームがありません")
+	}
+	email, _ := claims["email"].(string)
+
+	return &Principal{UserID: sub, Email: email, Method: MethodJWT}, nil
+}
+
+// keyFunc はJWTヘッダーの kid に対応する公開鍵を返す
+func (v *jwtVerifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, exists := v.keys[kid]
+	if !exists {
+		return nil, fmt.Errorf("未知の鍵IDです: %q", kid)
+	}
+	return key, nil
+}
+
+// audienceContains は aud クレームに指定値が含まれるかを判定する
+func audienceContains(claims jwt.MapClaims, value string) bool {
+	audience, err := claims.GetAudience()
+	if err != nil {
+		return false
+	}
+	for _, aud := range audience {
+		if aud == value {
+			return true
+		}
+	}
+	return false
+}
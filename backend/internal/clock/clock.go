@@ -0,0 +1,51 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock は現在時刻を提供するインターフェース
+// time.Now() の直接呼び出しを置き換え、テスト時に時刻を固定できるようにする
+type Clock interface {
+	// Now は現在時刻（UTC）を返す
+	Now() time.Time
+}
+
+// SystemClock は実際のシステム時刻を返す本番用のClock実装
+type SystemClock struct{}
+
+// Now は現在のシステム時刻をUTCで返す
+func (SystemClock) Now() time.Time {
+	return time.Now().UTC()
+}
+
+// FixedClock は常に同じ時刻を返すClock実装
+// テストでCreatedAt/UpdatedAtや過去日チェックの結果を固定するために使用
+// 並行して動くテストから Now と Advance を呼べるよう、時刻の読み書きは mu で保護する
+type FixedClock struct {
+	mu sync.Mutex
+
+	// t は Now() が返す固定時刻
+	t time.Time
+}
+
+// NewFixedClock は指定時刻を返し続けるFixedClockを作成
+func NewFixedClock(t time.Time) *FixedClock {
+	return &FixedClock{t: t.UTC()}
+}
+
+// Now は固定された時刻を返す
+func (c *FixedClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+// Advance は固定時刻を指定した時間だけ進める
+// 更新日時の変化を検証するテストで使用
+func (c *FixedClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = c.t.Add(d)
+}
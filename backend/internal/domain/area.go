@@ -0,0 +1,93 @@
+package domain
+
+// AreaAnalysisRequest は POST /events/{eventId}/restaurants/area-analysis のリクエスト
+// MemberLocations を省略した場合は、参加メンバーのフォーム回答（最寄り駅）を使用する
+type AreaAnalysisRequest struct {
+	// MemberLocations は分析に使うメンバーの最寄り駅（任意、最大100件）
+	MemberLocations []MemberLocation `json:"memberLocations,omitempty" label:"メンバーの最寄り駅" label_en:"Member locations" validate:"max=100"`
+}
+
+// MemberLocation はメンバーの最寄り駅と、その座標
+type MemberLocation struct {
+	// Name はメンバーの表示名
+	Name string `json:"name"`
+
+	// Station は最寄り駅名（"新宿" / "新宿駅" のどちらでもよい）
+	Station string `json:"station"`
+
+	// Latitude・Longitude は駅の緯度・経度（駅が見つからない場合は 0）
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+}
+
+// AreaAnalysis はメンバーの最寄り駅から求めた集合場所の候補
+type AreaAnalysis struct {
+	// CenterArea は最も公平な集合場所（最も遠いメンバーの移動距離が最小になる駅）
+	CenterArea CenterArea `json:"centerArea"`
+
+	// Centroid はメンバーの最寄り駅の重心（地理的な中心）
+	Centroid Centroid `json:"centroid"`
+
+	// Analysis は CenterArea までの移動距離の集計
+	Analysis DistanceSummary `json:"analysis"`
+
+	// Candidates は集合場所の候補（公平な順）
+	Candidates []AreaCandidate `json:"candidates"`
+
+	// Members は分析に使用したメンバーの最寄り駅
+	Members []MemberLocation `json:"members"`
+
+	// UnresolvedMembers は最寄り駅が未回答、または駅のデータにないため分析から除いたメンバー
+	UnresolvedMembers []MemberLocation `json:"unresolvedMembers"`
+}
+
+// CenterArea は集合場所として推薦する駅
+type CenterArea struct {
+	// Name はエリア名（例: "新宿駅周辺"）
+	Name string `json:"name"`
+
+	// Station は駅名
+	Station string `json:"station"`
+
+	// Latitude・Longitude は駅の緯度・経度
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+
+	// SuggestedStations は候補の駅名（公平な順）
+	SuggestedStations []string `json:"suggestedStations"`
+}
+
+// Centroid はメンバーの最寄り駅の重心と、重心に最も近い駅
+type Centroid struct {
+	Latitude       float64 `json:"latitude"`
+	Longitude      float64 `json:"longitude"`
+	NearestStation string  `json:"nearestStation"`
+}
+
+// DistanceSummary は集合場所までの移動距離（直線距離、km）の集計
+type DistanceSummary struct {
+	AverageDistance float64 `json:"averageDistance"`
+	MaxDistance     float64 `json:"maxDistance"`
+}
+
+// AreaCandidate は集合場所の候補の駅と、各メンバーからの距離
+type AreaCandidate struct {
+	// Station は駅名
+	Station string `json:"station"`
+
+	// Latitude・Longitude は駅の緯度・経度
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+
+	DistanceSummary
+
+	// Distances はメンバーごとの直線距離（km）
+	Distances []MemberDistance `json:"distances"`
+}
+
+// MemberDistance はメンバーの最寄り駅から候補の駅までの直線距離
+type MemberDistance struct {
+	Name     string  `json:"name"`
+	Station  string  `json:"station"`
+	Distance float64 `json:"distance"`
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"time"
+)
+
+// 監査ログの操作種別
+const (
+	// AuditActionCreated はイベントの作成
+	AuditActionCreated = "event.created"
+
+	// AuditActionUpdated はイベント内容（タイトル・日時・備考など）の更新
+	AuditActionUpdated = "event.updated"
+
+	// AuditActionStatusChanged はステータスの変更（企画中 → 確定 など）
+	AuditActionStatusChanged = "event.status_changed"
+
+	// AuditActionMemberAdded はメンバーの追加
+	AuditActionMemberAdded = "member.added"
+
+	// AuditActionMemberRemoved はメンバーの削除
+	AuditActionMemberRemoved = "member.removed"
+
+	// AuditActionFormSubmitted は参加者によるフォームの回答（メンバーの回答日時が更新された）
+	AuditActionFormSubmitted = "form.submitted"
+
+	// AuditActionVenueSelected は会場（お店）の決定・変更
+	AuditActionVenueSelected = "venue.selected"
+
+	// AuditActionDeleted はイベントの削除
+	AuditActionDeleted = "event.deleted"
+)
+
+// AuditEntry はイベントに対する1回の変更操作の監査ログ
+// 追記のみで、更新・削除は行わない
+type AuditEntry struct {
+	// ID は監査ログの識別子（形式: "aud_" + ランダム文字列）
+	ID string `json:"id" dynamodbav:"id"`
+
+	// EventID は変更されたイベントのID（DynamoDB パーティションキー）
+	EventID string `json:"eventId" dynamodbav:"eventId"`
+
+	// SortKey は同一イベント内で記録順に並べるためのキー（"<記録日時>#<ID>"、DynamoDB ソートキー）
+	SortKey string `json:"-" dynamodbav:"sk"`
+
+	// ActorID は変更したユーザーのID（認証なしの操作では空）
+	ActorID string `json:"actorId" dynamodbav:"actorId"`
+
+	// Action は操作種別（AuditAction* の値）
+	Action string `json:"action" dynamodbav:"action"`
+
+	// Changes はフィールドごとの変更内容（作成時は設定された値、削除時は空）
+	Changes []FieldChange `json:"changes" dynamodbav:"changes"`
+
+	// CreatedAt は記録日時
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+// FieldChange は1つのフィールドの変更前後の値
+// 値は domain.Event のJSON表現（フィールド名もJSON名）で保持する
+//
+// JSON例:
+//
+//	{"field": "time", "before": "19:00", "after": "18:30"}
+type FieldChange struct {
+	// Field は変更されたフィールドのJSON名
+	Field string `json:"field" dynamodbav:"field"`
+
+	// Before は変更前の値（作成時は nil）
+	Before interface{} `json:"before" dynamodbav:"before"`
+
+	// After は変更後の値
+	After interface{} `json:"after" dynamodbav:"after"`
+}
@@ -0,0 +1,67 @@
+package domain
+
+// EventBudget はイベントの予算（金額はすべて円、0 は未設定）
+// イベント作成時の budget と PUT /events/{eventId}/budget のリクエストを兼ねる
+type EventBudget struct {
+	// TargetPerPerson は1人あたりのお店の金額の目安
+	TargetPerPerson int `json:"targetPerPerson,omitempty" dynamodbav:"targetPerPerson,omitempty" label:"1人あたりの目安" label_en:"Target per person" validate:"omitempty,gte=1,lte=100000"`
+
+	// TotalCap はお店に支払う総額の上限（会社補助を含む）
+	TotalCap int `json:"totalCap,omitempty" dynamodbav:"totalCap,omitempty" label:"総額の上限" label_en:"Total cap" validate:"omitempty,gte=1,lte=10000000"`
+
+	// Subsidy は会社補助の金額（参加者で均等に割ってメンバーの負担から差し引く）
+	Subsidy int `json:"subsidy,omitempty" dynamodbav:"subsidy,omitempty" label:"会社補助" label_en:"Company subsidy" validate:"omitempty,gte=1,lte=10000000"`
+}
+
+// IsZero は予算の項目がすべて未設定かどうかを返す
+func (b EventBudget) IsZero() bool {
+	return b == EventBudget{}
+}
+
+// BudgetPlan は予算と参加メンバーの予算の範囲から求めた1人あたりの金額の目安（GET /events/{eventId}/budget のレスポンス）
+// 対象は参加と回答したメンバーで、メンバーの予算はフォームの budgetRange（メンバーが負担する金額）
+type BudgetPlan struct {
+	// Budget はイベントの予算（未設定の場合は nil）
+	Budget *EventBudget `json:"budget"`
+
+	// Headcount は参加と回答したメンバーの人数、AnsweredCount はそのうち予算を回答した人数
+	Headcount     int `json:"headcount"`
+	AnsweredCount int `json:"answeredCount"`
+
+	// MemberRange は予算を回答した全員が負担できる1人あたりの金額の範囲（回答がない・共通する範囲がない場合は nil）
+	MemberRange *BudgetRange `json:"memberRange"`
+
+	// SubsidyPerPerson は会社補助を参加人数で割った1人あたりの金額（1円未満は切り捨て）
+	SubsidyPerPerson int `json:"subsidyPerPerson"`
+
+	// CapPerPerson は総額の上限を参加人数で割った1人あたりの上限（上限・参加者がない場合は nil）
+	CapPerPerson *int `json:"capPerPerson"`
+
+	// Feasible は全員の予算と総額の上限を同時に満たす金額があるかどうか
+	Feasible bool `json:"feasible"`
+
+	// PriceBand はお店の1人あたりの金額として選べる範囲（Max が 0 の場合は上限なし）
+	// 会社補助の分だけメンバーの予算より高いお店を選べる。満たせない場合・制約がない場合は nil
+	PriceBand *BudgetRange `json:"priceBand"`
+
+	// TargetWithinBand は1人あたりの目安が PriceBand に収まるかどうか（目安が未設定の場合は nil）
+	TargetWithinBand *bool `json:"targetWithinBand"`
+
+	// OverBudgetMembers は1人あたりの目安の金額で、負担額が予算の上限を超えるメンバー（超過額の多い順）
+	OverBudgetMembers []OverBudgetMember `json:"overBudgetMembers"`
+}
+
+// OverBudgetMember は予算の上限を超えるメンバー
+type OverBudgetMember struct {
+	// Name はメンバーの名前
+	Name string `json:"name"`
+
+	// Max はメンバーが回答した予算の上限
+	Max int `json:"max"`
+
+	// Payment は目安の金額から1人あたりの会社補助を引いたメンバーの負担額
+	Payment int `json:"payment"`
+
+	// Excess は Payment が Max を超える金額
+	Excess int `json:"excess"`
+}
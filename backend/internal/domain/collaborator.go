@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"time"
+)
+
+// 共同幹事のロール
+// イベントの作成者（Event.OrganizerID）が owner で、招待した共同幹事は editor か viewer になる
+const (
+	// RoleOwner はイベントの所有者。共同幹事の招待・解除、所有者の移譲ができる（1イベントに1人）
+	RoleOwner = "owner"
+
+	// RoleEditor はイベント内容を編集できる共同幹事
+	RoleEditor = "editor"
+
+	// RoleViewer は閲覧のみできる共同幹事
+	RoleViewer = "viewer"
+)
+
+// 招待の状態
+const (
+	// CollaboratorStatusPending は招待済みで未承諾
+	CollaboratorStatusPending = "pending"
+
+	// CollaboratorStatusAccepted は招待を承諾済み（UserID が設定される）
+	CollaboratorStatusAccepted = "accepted"
+)
+
+// Permission はイベントに対する操作の種類
+// ハンドラーは操作ごとに必要な Permission を指定してイベントを取得する
+type Permission int
+
+const (
+	// PermissionView はイベントの閲覧・複製・テンプレート保存
+	PermissionView Permission = iota + 1
+
+	// PermissionEdit はイベント内容の編集（お店選び・予約情報・記録など）
+	PermissionEdit
+
+	// PermissionManage は共同幹事の招待・解除と所有者の移譲
+	PermissionManage
+)
+
+// rolePermissions はロールごとに許可される最も強い操作
+var rolePermissions = map[string]Permission{
+	RoleOwner:  PermissionManage,
+	RoleEditor: PermissionEdit,
+	RoleViewer: PermissionView,
+}
+
+// RoleAllows はロールが permission の操作を許可されているかを返す
+// 上位のロールは下位のロールの操作をすべて含む（owner ⊃ editor ⊃ viewer）
+func RoleAllows(role string, permission Permission) bool {
+	return rolePermissions[role] >= permission
+}
+
+// RoleOf はユーザーのイベントに対するロールを返す
+// 所有者は "owner"、承諾済みの共同幹事はそのロール、それ以外（未承諾を含む）は空文字列
+func (e *Event) RoleOf(userID string) string {
+	if userID == "" {
+		return ""
+	}
+	if e.OrganizerID == userID {
+		return RoleOwner
+	}
+	for _, collaborator := range e.Collaborators {
+		if collaborator.Status == CollaboratorStatusAccepted && collaborator.UserID == userID {
+			return collaborator.Role
+		}
+	}
+	return ""
+}
+
+// Collaborator はイベントの共同幹事
+// 招待リンク（InviteCode）を受け取ったユーザーが承諾すると、そのユーザーIDで権限を持つ
+type Collaborator struct {
+	// ID は共同幹事の識別子（形式: "col_" + ランダム文字列）
+	ID string `json:"id" dynamodbav:"id"`
+
+	// UserID は承諾したユーザーのID（未承諾の間は空）
+	UserID string `json:"userId,omitempty" dynamodbav:"userId,omitempty"`
+
+	// Email は招待時に幹事が入力した連絡先（表示用、任意）
+	Email string `json:"email,omitempty" dynamodbav:"email,omitempty"`
+
+	// Role はロール（"editor" / "viewer"）
+	Role string `json:"role" dynamodbav:"role"`
+
+	// Status は招待の状態（"pending" / "accepted"）
+	Status string `json:"status" dynamodbav:"status"`
+
+	// InviteCode は招待を承諾するための秘密のコード
+	// 招待を作成した幹事にのみ一度だけ返し、イベント取得のレスポンスには含めない
+	InviteCode string `json:"-" dynamodbav:"inviteCode,omitempty"`
+
+	// InvitedBy は招待したユーザーのID
+	InvitedBy string `json:"invitedBy" dynamodbav:"invitedBy"`
+
+	// InvitedAt は招待日時
+	InvitedAt time.Time `json:"invitedAt" dynamodbav:"invitedAt"`
+
+	// AcceptedAt は承諾日時（未承諾の場合は nil）
+	AcceptedAt *time.Time `json:"acceptedAt,omitempty" dynamodbav:"acceptedAt,omitempty"`
+}
+
+// InviteCollaboratorRequest は共同幹事を招待する際のリクエスト構造体
+type InviteCollaboratorRequest struct {
+	// Email は招待相手の連絡先（任意、表示用）
+	Email string `json:"email,omitempty" label:"メールアドレス" label_en:"Email" validate:"omitempty,max=254"`
+
+	// Role は付与するロール（必須）。owner は所有者の移譲でのみ付与できる
+	Role string `json:"role" label:"ロール" label_en:"Role" validate:"required,oneof=editor viewer"`
+}
+
+// InviteCollaboratorResponse は招待作成のレスポンスのデータ
+type InviteCollaboratorResponse struct {
+	// Collaborator は作成した共同幹事（未承諾）
+	Collaborator *Collaborator `json:"collaborator"`
+
+	// InviteCode は招待リンクに含めるコード（このレスポンスでのみ返す）
+	InviteCode string `json:"inviteCode"`
+}
+
+// CollaboratorsResponse は共同幹事一覧のレスポンスのデータ
+type CollaboratorsResponse struct {
+	// OwnerID は所有者のユーザーID
+	OwnerID string `json:"ownerId"`
+
+	// Role はリクエストしたユーザー自身のロール（画面の操作ボタンの出し分けに使用）
+	Role string `json:"role"`
+
+	// Collaborators は共同幹事（招待中を含む）
+	Collaborators []Collaborator `json:"collaborators"`
+}
+
+// AcceptInviteRequest は招待を承諾する際のリクエスト構造体
+type AcceptInviteRequest struct {
+	// InviteCode は招待リンクに含まれるコード（必須）
+	InviteCode string `json:"inviteCode" label:"招待コード" label_en:"Invite code" validate:"required,max=100"`
+}
+
+// TransferOwnershipRequest は所有者を移譲する際のリクエスト構造体
+type TransferOwnershipRequest struct {
+	// CollaboratorID は新しい所有者にする共同幹事のID（承諾済みであること）
+	CollaboratorID string `json:"collaboratorId" label:"共同幹事ID" label_en:"Collaborator ID" validate:"required"`
+}
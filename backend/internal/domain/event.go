@@ -47,11 +47,45 @@ type Event struct {
 	// true: 複数候補日で調整, false: 日程確定済み
 	HasScheduling bool `json:"hasScheduling" dynamodbav:"hasScheduling"`
 
+	// Budget は1人あたりの目安・総額の上限・会社補助（未設定の場合は nil）
+	Budget *EventBudget `json:"budget,omitempty" dynamodbav:"budget,omitempty"`
+
+	// FormQuestions は参加者に回答してもらうフォームの質問項目
+	// フォーム未作成の場合は空（複製・テンプレートではこの設定を引き継ぐ）
+	FormQuestions []FormQuestion `json:"formQuestions,omitempty" dynamodbav:"formQuestions,omitempty"`
+
+	// Collaborators は共同幹事（招待中を含む）の一覧
+	// 所有者（OrganizerID）は含まない。権限の判定は RoleOf を使用する
+	Collaborators []Collaborator `json:"collaborators,omitempty" dynamodbav:"collaborators,omitempty"`
+
+	// SeriesID は定期開催シリーズから生成された開催回の場合のシリーズID（単発のイベントは空）
+	SeriesID string `json:"seriesId,omitempty" dynamodbav:"seriesId,omitempty"`
+
+	// SeriesException はこの開催回だけを個別に編集したかどうか
+	// true の開催回はシリーズの「以降すべて」の編集で上書きしない
+	SeriesException bool `json:"seriesException,omitempty" dynamodbav:"seriesException,omitempty"`
+
+	// RestaurantSearch は最新のレストラン検索の結果（未検索の場合は nil）
+	RestaurantSearch *RestaurantSearch `json:"restaurantSearch,omitempty" dynamodbav:"restaurantSearch,omitempty"`
+
+	// Venue は決定した会場（未決定の場合は nil）
+	Venue *Venue `json:"venue,omitempty" dynamodbav:"venue,omitempty"`
+
+	// Reservation は報告済みのお店の予約（未報告の場合は nil）
+	Reservation *Reservation `json:"reservation,omitempty" dynamodbav:"reservation,omitempty"`
+
+	// Settlement は開催後の精算（未作成の場合は nil）
+	Settlement *Settlement `json:"settlement,omitempty" dynamodbav:"settlement,omitempty"`
+
 	// CreatedAt はイベント作成日時（ISO 8601形式）
 	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
 
 	// UpdatedAt は最終更新日時（ISO 8601形式）
 	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
+
+	// Version は楽観的ロック用の版数（保存のたびに1ずつ増える）
+	// 読み込んだ時点から別のリクエストが保存していた場合、更新は ErrConflict で失敗する
+	Version int `json:"version" dynamodbav:"version"`
 }
 
 // Member は参加メンバーの情報
@@ -79,29 +113,35 @@ type Member struct {
 
 // CreateEventRequest はイベント作成時のリクエスト構造体
 // API Gateway 経由で受け取る JSON データの形式を定義
+// validate タグは internal/validation で検証され、label / label_en タグは
+// エラーメッセージの表示名（日本語 / 英語）になる
 type CreateEventRequest struct {
 	// Title はイベントタイトル（必須）
 	// バリデーション: 1文字以上100文字以下
-	Title string `json:"title" validate:"required,min=1,max=100"`
+	Title string `json:"title" label:"イベントタイトル" label_en:"Event title" validate:"required,min=1,max=100"`
 
 	// Purpose はイベントの目的（任意、デフォルト: "other"）
 	// 許可値: "welcome", "farewell", "year_end", "social", "other"
-	Purpose string `json:"purpose,omitempty" validate:"omitempty,oneof=welcome farewell year_end social other"`
+	Purpose string `json:"purpose,omitempty" label:"イベント目的" label_en:"Event purpose" validate:"omitempty,oneof=welcome farewell year_end social other"`
 
 	// Date は開催予定日（任意、YYYY-MM-DD形式）
 	// バリデーション: 日付形式チェック、過去日禁止
-	Date string `json:"date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	Date string `json:"date,omitempty" label:"日付" label_en:"Date" validate:"omitempty,datetime=2006-01-02,notpast=2006-01-02"`
 
 	// Time は開催時刻（任意、HH:MM形式）
 	// バリデーション: 時刻形式チェック
-	Time string `json:"time,omitempty" validate:"omitempty,datetime=15:04"`
+	Time string `json:"time,omitempty" label:"時刻" label_en:"Time" validate:"omitempty,datetime=15:04"`
 
 	// Notes は補足事項（任意）
 	// バリデーション: 最大1000文字
-	Notes string `json:"notes,omitempty" validate:"omitempty,max=1000"`
+	Notes string `json:"notes,omitempty" label:"備考" label_en:"Notes" validate:"omitempty,max=1000"`
 
 	// HasScheduling は日程調整機能使用フラグ（任意、デフォルト: false）
 	HasScheduling bool `json:"hasScheduling,omitempty"`
+
+	// Budget は予算（任意）
+	// バリデーション: 各金額は1円以上、会社補助は総額の上限以下
+	Budget *EventBudget `json:"budget,omitempty" label:"予算" label_en:"Budget"`
 }
 
 // CreateEventResponse はイベント作成時のレスポンス構造体
@@ -0,0 +1,27 @@
+package domain
+
+// FormQuestion は参加者フォームの質問項目
+// フロントエンドの FormQuestion 型と同じ形式
+type FormQuestion struct {
+	// ID は質問の識別子（例: "q_001"）
+	ID string `json:"id" dynamodbav:"id"`
+
+	// Question は質問文（例: "食べ物のアレルギーはありますか？"）
+	Question string `json:"question" dynamodbav:"question"`
+
+	// Type は質問の種類
+	// 値: "name", "email", "phone", "allergy", "alcohol", "budget", "genre", "station", "custom"
+	Type string `json:"type" dynamodbav:"type"`
+
+	// Required は回答必須かどうか
+	Required bool `json:"required" dynamodbav:"required"`
+
+	// Enabled はフォームに表示するかどうか
+	Enabled bool `json:"enabled" dynamodbav:"enabled"`
+
+	// CanDisable は幹事が非表示にできるかどうか（名前など必須の質問は false）
+	CanDisable bool `json:"canDisable" dynamodbav:"canDisable"`
+
+	// Options は選択式の質問の選択肢（任意）
+	Options []string `json:"options,omitempty" dynamodbav:"options,omitempty"`
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+)
+
+// 冪等性レコードの処理状況
+const (
+	// IdempotencyStatusInProgress は最初のリクエストを処理中（レスポンス未保存）
+	IdempotencyStatusInProgress = "in_progress"
+
+	// IdempotencyStatusCompleted は処理が完了し、レスポンスを保存済み
+	IdempotencyStatusCompleted = "completed"
+)
+
+// IdempotencyRecord は Idempotency-Key ヘッダー付きリクエストの処理結果
+// タイムアウト後の再送で同じイベントが重複作成されないよう、
+// 初回のレスポンスを保存して再送時にそのまま返す
+type IdempotencyRecord struct {
+	// Key は幹事IDと Idempotency-Key を連結した識別子（DynamoDB パーティションキー）
+	// 形式: "<organizerId>#<Idempotency-Key>"（幹事ごとにキーの名前空間を分ける）
+	Key string `json:"key" dynamodbav:"key"`
+
+	// OrganizerID はリクエストを送信した幹事のユーザーID
+	OrganizerID string `json:"organizerId" dynamodbav:"organizerId"`
+
+	// IdempotencyKey はクライアントが指定した Idempotency-Key ヘッダーの値
+	IdempotencyKey string `json:"idempotencyKey" dynamodbav:"idempotencyKey"`
+
+	// RequestHash はリクエスト（メソッド・パス・ボディ）のSHA-256ハッシュ
+	// 同じキーで異なる内容のリクエストが送られた場合の検出に使用
+	RequestHash string `json:"requestHash" dynamodbav:"requestHash"`
+
+	// Status は処理状況（IdempotencyStatusInProgress / IdempotencyStatusCompleted）
+	Status string `json:"status" dynamodbav:"status"`
+
+	// StatusCode は保存したレスポンスのHTTPステータスコード
+	StatusCode int `json:"statusCode,omitempty" dynamodbav:"statusCode,omitempty"`
+
+	// ResponseBody は保存したレスポンスのボディ
+	ResponseBody string `json:"responseBody,omitempty" dynamodbav:"responseBody,omitempty"`
+
+	// CreatedAt は最初のリクエストを受け付けた日時
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+
+	// ExpiresAt は有効期限（Unix秒）
+	// DynamoDB の TTL 属性として使用し、期限切れのレコードは自動削除される
+	ExpiresAt int64 `json:"expiresAt" dynamodbav:"expiresAt"`
+
+	// LockedUntil は処理中のキーを確保しておく期限（Unix秒）
+	// 処理中に Lambda がタイムアウト・異常終了して完了も解放もされなかった場合、
+	// この期限を過ぎたレコードは存在しないものとして扱い、同じキーの再送で処理をやり直す
+	LockedUntil int64 `json:"lockedUntil,omitempty" dynamodbav:"lockedUntil,omitempty"`
+}
+
+// Completed は処理が完了し、レスポンスを返せる状態かどうかを返す
+func (r *IdempotencyRecord) Completed() bool {
+	return r.Status == IdempotencyStatusCompleted
+}
+
+// Abandoned は処理中のまま確保の期限（LockedUntil）を過ぎたかどうかを返す
+func (r *IdempotencyRecord) Abandoned(now time.Time) bool {
+	return !r.Completed() && r.LockedUntil <= now.Unix()
+}
@@ -0,0 +1,76 @@
+package domain
+
+// 金額の端数処理のルール（1人あたりの金額の計算に使用）
+// 幹事が集金しやすいよう、単位（100円・1,000円）と方向（切り上げ・四捨五入・切り捨て）を選べる
+const (
+	// RoundingNone は1円単位（1円未満は切り上げ）
+	RoundingNone = "none"
+
+	// RoundingCeil100・RoundingCeil1000 は100円・1,000円単位に切り上げ（集金額の不足を防ぐ）
+	RoundingCeil100  = "ceil_100"
+	RoundingCeil1000 = "ceil_1000"
+
+	// RoundingRound100・RoundingRound1000 は100円・1,000円単位に四捨五入
+	RoundingRound100  = "round_100"
+	RoundingRound1000 = "round_1000"
+
+	// RoundingFloor100・RoundingFloor1000 は100円・1,000円単位に切り捨て（不足分は幹事が負担）
+	RoundingFloor100  = "floor_100"
+	RoundingFloor1000 = "floor_1000"
+
+	// RoundingOrganizer は1円未満を切り捨て、割り切れない端数は幹事が負担する（精算の既定）
+	RoundingOrganizer = "organizer"
+)
+
+// DefaultRounding は端数処理のルールを指定しなかった場合に使うルール
+const DefaultRounding = RoundingNone
+
+// roundingRule は端数処理のルールの単位と方向
+type roundingRule struct {
+	unit int
+	mode string
+}
+
+// roundingRules は端数処理のルールごとの単位と方向
+var roundingRules = map[string]roundingRule{
+	RoundingNone:      {unit: 1, mode: "ceil"},
+	RoundingCeil100:   {unit: 100, mode: "ceil"},
+	RoundingCeil1000:  {unit: 1000, mode: "ceil"},
+	RoundingRound100:  {unit: 100, mode: "round"},
+	RoundingRound1000: {unit: 1000, mode: "round"},
+	RoundingFloor100:  {unit: 100, mode: "floor"},
+	RoundingFloor1000: {unit: 1000, mode: "floor"},
+	RoundingOrganizer: {unit: 1, mode: "floor"},
+}
+
+// PerPerson は総額 total を people 人で割った1人あたりの金額を、ルール rounding で端数処理して返す
+// people が0以下の場合は0、未知のルールは DefaultRounding として扱う
+func PerPerson(total int, people int, rounding string) int {
+	if people <= 0 {
+		return 0
+	}
+	rule, ok := roundingRules[rounding]
+	if !ok {
+		rule = roundingRules[DefaultRounding]
+	}
+
+	// total / (people * unit) を整数演算で端数処理し、単位を掛け戻す
+	divisor := people * rule.unit
+	quotient, remainder := total/divisor, total%divisor
+	switch rule.mode {
+	case "ceil":
+		if remainder > 0 {
+			quotient++
+		}
+	case "round":
+		if remainder*2 >= divisor {
+			quotient++
+		}
+	}
+	return quotient * rule.unit
+}
+
+// RoundAmount は金額 amount をルール rounding で端数処理して返す
+func RoundAmount(amount int, rounding string) int {
+	return PerPerson(amount, 1, rounding)
+}
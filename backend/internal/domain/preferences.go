@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// 飲酒の好み（MemberPreferences.AlcoholPreference の値）
+const (
+	// AlcoholYes はお酒を飲む
+	AlcoholYes = "yes"
+
+	// AlcoholNo はお酒を飲まない
+	AlcoholNo = "no"
+
+	// AlcoholSometimes はたまに飲む
+	AlcoholSometimes = "sometimes"
+)
+
+// MemberPreferences はフォームで回答されたメンバーの好み
+// Member.Preferences（JSONの自由形式）をフロントエンドの MemberPreferences 型として読み取ったもの
+type MemberPreferences struct {
+	// Allergies は食物アレルギー（例: "えび", "そば"）
+	Allergies []string `json:"allergies,omitempty"`
+
+	// FavoriteGenres は好きな料理ジャンル（例: "居酒屋", "和食"）
+	FavoriteGenres []string `json:"favoriteGenres,omitempty"`
+
+	// BudgetRange は1人あたりの予算の範囲（未回答の場合は nil）
+	BudgetRange *BudgetRange `json:"budgetRange,omitempty"`
+
+	// AlcoholPreference は飲酒の好み（"yes" / "no" / "sometimes"、未回答の場合は空）
+	AlcoholPreference string `json:"alcoholPreference,omitempty"`
+
+	// DietaryRestrictions はアレルギー以外の食事制限（例: "ベジタリアン", "ハラール"）
+	DietaryRestrictions []string `json:"dietaryRestrictions,omitempty"`
+
+	// NearestStation は最寄り駅（例: "新宿", "渋谷駅"）
+	NearestStation string `json:"nearestStation,omitempty"`
+}
+
+// BudgetRange は1人あたりの予算の範囲（円）
+// Max が 0 の場合は上限なし
+type BudgetRange struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// CommonBudgetRange は全員の予算の範囲に収まる1人あたりの金額の範囲を返す
+// 予算を回答したメンバーのみを対象とし、回答者がいない・共通する範囲がない場合は nil
+func CommonBudgetRange(preferences []MemberPreferences) *BudgetRange {
+	var common *BudgetRange
+	for _, p := range preferences {
+		if p.BudgetRange == nil {
+			continue
+		}
+		if common == nil {
+			budget := *p.BudgetRange
+			common = &budget
+			continue
+		}
+		if p.BudgetRange.Min > common.Min {
+			common.Min = p.BudgetRange.Min
+		}
+		if p.BudgetRange.Max > 0 && (common.Max == 0 || p.BudgetRange.Max < common.Max) {
+			common.Max = p.BudgetRange.Max
+		}
+	}
+	if common != nil && common.Max > 0 && common.Min > common.Max {
+		return nil
+	}
+	return common
+}
+
+// noneAnswers は「なし」を意味する回答（アレルギー・食事制限として扱わない）
+var noneAnswers = map[string]bool{
+	"なし":   true,
+	"特になし": true,
+	"無し":   true,
+	"none": true,
+}
+
+// ParsePreferences はメンバーの好みを型付きの MemberPreferences として返す
+// JSON・DynamoDB のどちらから読み込んだ値（数値は float64、配列は []interface{}）にも対応し、
+// 型が合わない項目は未回答として扱う
+func (m Member) ParsePreferences() MemberPreferences {
+	p := m.Preferences
+	preferences := MemberPreferences{
+		Allergies:           stringList(p["allergies"]),
+		FavoriteGenres:      stringList(p["favoriteGenres"]),
+		AlcoholPreference:   stringValue(p["alcoholPreference"]),
+		DietaryRestrictions: stringList(p["dietaryRestrictions"]),
+		NearestStation:      stringValue(p["nearestStation"]),
+	}
+	if budget, ok := p["budgetRange"].(map[string]interface{}); ok {
+		minValue, minOK := intValue(budget["min"])
+		maxValue, maxOK := intValue(budget["max"])
+		if minOK || maxOK {
+			preferences.BudgetRange = &BudgetRange{Min: minValue, Max: maxValue}
+		}
+	}
+	return preferences
+}
+
+// stringValue は文字列の前後の空白を除いて返す（文字列でない場合は空）
+func stringValue(value interface{}) string {
+	s, _ := value.(string)
+	return strings.TrimSpace(s)
+}
+
+// stringList は文字列の配列を返す（単一の文字列は1要素として扱い、空・「なし」は除く）
+func stringList(value interface{}) []string {
+	var items []string
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			items = append(items, stringValue(item))
+		}
+	case []string:
+		for _, item := range v {
+			items = append(items, strings.TrimSpace(item))
+		}
+	case string:
+		items = append(items, strings.TrimSpace(v))
+	}
+
+	list := make([]string, 0, len(items))
+	for _, item := range items {
+		if item == "" || noneAnswers[strings.ToLower(item)] {
+			continue
+		}
+		list = append(list, item)
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	return list
+}
+
+// intValue は数値（float64・int・数字の文字列）を整数として返す
+func intValue(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case float64:
+		return int(v), true
+	case int:
+		return v, true
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		return n, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,122 @@
+package domain
+
+import "time"
+
+// EventRecord は開催後に幹事が残す記録（幹事ログ）
+// 完了したイベントごとに1件作成でき、会場・費用・評価を次回のお店選びに活かす
+type EventRecord struct {
+	// ID は記録の一意識別子（DynamoDB パーティションキー）
+	// 形式: "rec_" + ランダム文字列
+	ID string `json:"id" dynamodbav:"id"`
+
+	// EventID は記録の対象のイベントID
+	EventID string `json:"eventId" dynamodbav:"eventId"`
+
+	// OrganizerID は記録を作成した幹事のユーザーID（記録を閲覧・編集できるのはこの幹事のみ）
+	OrganizerID string `json:"organizerId" dynamodbav:"organizerId"`
+
+	// Event は記録の作成時点のイベントの概要（イベントが後で変更されても記録は変わらない）
+	Event RecordEvent `json:"event" dynamodbav:"event"`
+
+	// Venue は会場（会場が未決定で入力もない場合は nil）
+	Venue *Venue `json:"venue,omitempty" dynamodbav:"venue,omitempty"`
+
+	// Rating はお店の評価（1〜5）
+	Rating int `json:"rating" dynamodbav:"rating"`
+
+	// Notes は感想・次回へのメモ
+	Notes string `json:"notes" dynamodbav:"notes"`
+
+	// TotalCost は総額（円）
+	TotalCost int `json:"totalCost" dynamodbav:"totalCost"`
+
+	// Attendees は参加人数
+	Attendees int `json:"attendees" dynamodbav:"attendees"`
+
+	// CostPerPerson は1人あたりの金額（TotalCost・Attendees・Rounding から計算）
+	CostPerPerson int `json:"costPerPerson" dynamodbav:"costPerPerson"`
+
+	// Rounding は1人あたりの金額の端数処理のルール（Rounding* の値）
+	Rounding string `json:"rounding" dynamodbav:"rounding"`
+
+	// IsShared は「みんなの記録」に共有しているかどうか
+	IsShared bool `json:"isShared" dynamodbav:"isShared"`
+
+	// Share は公開した内容（一度も共有していない場合は nil、共有を停止しても残す）
+	Share *RecordShare `json:"share,omitempty" dynamodbav:"share,omitempty"`
+
+	// ModerationStatus は通報による審査の状況（審査待ちの場合は ModerationPendingReview）
+	ModerationStatus string `json:"moderationStatus,omitempty" dynamodbav:"moderationStatus,omitempty"`
+
+	// LikedBy はいいねしたユーザーID（いいねした人は幹事にも公開しない）
+	// DynamoDB では並行するいいねを ADD・DELETE で更新できるよう文字列セットとして保存する
+	LikedBy []string `json:"-" dynamodbav:"likedBy,omitempty,stringset"`
+
+	// Reports は不適切報告（報告者は幹事にも公開しない）
+	Reports []RecordReport `json:"-" dynamodbav:"reports,omitempty"`
+
+	// CreatedAt・UpdatedAt は記録の作成日時・最終更新日時
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+// RecordEvent は記録に残すイベントの概要
+type RecordEvent struct {
+	Title   string `json:"title" dynamodbav:"title"`
+	Purpose string `json:"purpose" dynamodbav:"purpose"`
+	Date    string `json:"date" dynamodbav:"date"`
+}
+
+// CreateRecordRequest は POST /records/private のリクエスト
+type CreateRecordRequest struct {
+	// EventID は記録するイベントのID（完了したイベントのみ）
+	EventID string `json:"eventId" label:"イベントID" label_en:"Event ID" validate:"required,max=100"`
+
+	// Rating はお店の評価（1〜5）
+	Rating int `json:"rating" label:"評価" label_en:"Rating" validate:"gte=1,lte=5"`
+
+	// Notes は感想・次回へのメモ
+	Notes string `json:"notes" label:"メモ" label_en:"Notes" validate:"max=1000"`
+
+	// TotalCost は総額（円）
+	TotalCost int `json:"totalCost" label:"総額" label_en:"Total cost" validate:"gte=0,lte=10000000"`
+
+	// Attendees は参加人数（省略時は参加と回答したメンバーの人数）
+	Attendees int `json:"attendees,omitempty" label:"参加人数" label_en:"Attendees" validate:"gte=0,lte=1000"`
+
+	// Rounding は1人あたりの金額の端数処理（省略時は1円単位）
+	Rounding string `json:"rounding,omitempty" label:"端数処理" label_en:"Rounding" validate:"omitempty,oneof=none ceil_100 ceil_1000 round_100 round_1000 floor_100 floor_1000"`
+
+	// Venue は会場（省略時はイベントで決定した会場）
+	Venue *RecordVenueRequest `json:"venue,omitempty" label:"会場" label_en:"Venue"`
+}
+
+// UpdateRecordRequest は PUT /records/private/{recordId} のリクエスト
+// 記録の内容を置き換える（対象のイベントは変更できない）
+type UpdateRecordRequest struct {
+	// Rating はお店の評価（1〜5）
+	Rating int `json:"rating" label:"評価" label_en:"Rating" validate:"gte=1,lte=5"`
+
+	// Notes は感想・次回へのメモ
+	Notes string `json:"notes" label:"メモ" label_en:"Notes" validate:"max=1000"`
+
+	// TotalCost は総額（円）
+	TotalCost int `json:"totalCost" label:"総額" label_en:"Total cost" validate:"gte=0,lte=10000000"`
+
+	// Attendees は参加人数（省略時は記録済みの人数）
+	Attendees int `json:"attendees,omitempty" label:"参加人数" label_en:"Attendees" validate:"gte=0,lte=1000"`
+
+	// Rounding は1人あたりの金額の端数処理（省略時は記録済みのルール）
+	Rounding string `json:"rounding,omitempty" label:"端数処理" label_en:"Rounding" validate:"omitempty,oneof=none ceil_100 ceil_1000 round_100 round_1000 floor_100 floor_1000"`
+
+	// Venue は会場（省略時は記録済みの会場）
+	Venue *RecordVenueRequest `json:"venue,omitempty" label:"会場" label_en:"Venue"`
+}
+
+// RecordVenueRequest は記録に手入力する会場
+type RecordVenueRequest struct {
+	Name    string `json:"name" label:"店名" label_en:"Name" validate:"required,max=100"`
+	Address string `json:"address" label:"住所" label_en:"Address" validate:"max=200"`
+	Genre   string `json:"genre" label:"ジャンル" label_en:"Genre" validate:"max=50"`
+	Area    string `json:"area" label:"エリア" label_en:"Area" validate:"max=50"`
+}
@@ -0,0 +1,88 @@
+package domain
+
+import "time"
+
+// Reservation はお店の予約の記録（幹事による予約完了の報告）
+type Reservation struct {
+	// VenueName は予約したお店の名前（報告した時点の会場の店名）
+	VenueName string `json:"venueName" dynamodbav:"venueName"`
+
+	// ReservationID はお店から伝えられた予約番号（電話予約などで番号がない場合は空）
+	ReservationID string `json:"reservationId,omitempty" dynamodbav:"reservationId,omitempty"`
+
+	// ContactPerson は予約の名義・お店との連絡担当者
+	ContactPerson string `json:"contactPerson" dynamodbav:"contactPerson"`
+
+	// SpecialRequests はお店に伝えた要望（アレルギー対応・席の希望など）
+	SpecialRequests string `json:"specialRequests,omitempty" dynamodbav:"specialRequests,omitempty"`
+
+	// IsConfirmed は予約が確定しているかどうか（報告した時点で true）
+	IsConfirmed bool `json:"isConfirmed" dynamodbav:"isConfirmed"`
+
+	// ConfirmedAt は予約完了を報告した日時
+	ConfirmedAt *time.Time `json:"confirmedAt,omitempty" dynamodbav:"confirmedAt,omitempty"`
+
+	// ReportedBy は予約完了を報告したユーザーID
+	ReportedBy string `json:"reportedBy" dynamodbav:"reportedBy"`
+
+	// UpdatedBy・UpdatedAt は最後に予約情報を更新したユーザーIDと日時
+	UpdatedBy string    `json:"updatedBy" dynamodbav:"updatedBy"`
+	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+// ReservationRequest は予約完了の報告（POST .../reservation/report）・更新（PUT .../reservation/update）のリクエスト
+type ReservationRequest struct {
+	// ReservationID は予約番号（任意）
+	ReservationID string `json:"reservationId" label:"予約番号" label_en:"Reservation ID" validate:"max=50"`
+
+	// ContactPerson は予約の名義（必須）
+	ContactPerson string `json:"contactPerson" label:"予約者名" label_en:"Contact person" validate:"required,max=50"`
+
+	// SpecialRequests はお店に伝えた要望（任意）
+	SpecialRequests string `json:"specialRequests" label:"お店への要望" label_en:"Special requests" validate:"max=500"`
+}
+
+// ReservationInfo は予約の電話・フォームで伝える内容をまとめたもの（GET .../reservation/info のレスポンス）
+type ReservationInfo struct {
+	// Restaurant は決定した会場（未決定の場合は nil）
+	Restaurant *Venue `json:"restaurant"`
+
+	// Event はイベントの概要
+	Event ReservationEvent `json:"event"`
+
+	// Headcount は参加と回答したメンバーの人数（予約人数）
+	Headcount int `json:"headcount"`
+
+	// PendingCount はまだ回答していないメンバーの人数（人数が増える可能性がある）
+	PendingCount int `json:"pendingCount"`
+
+	// DrinkerCount はお酒を飲む参加者の人数（飲み放題の人数の目安）
+	DrinkerCount int `json:"drinkerCount"`
+
+	// Allergies は参加者のアレルギーと人数（回答順）
+	Allergies []RestrictionCount `json:"allergies"`
+
+	// DietaryRestrictions は参加者の食事制限と人数（回答順）
+	DietaryRestrictions []RestrictionCount `json:"dietaryRestrictions"`
+
+	// BudgetPerPerson は参加者全員の予算に収まる1人あたりの金額の範囲
+	// 予算の回答がない、または全員に共通する範囲がない場合は nil
+	BudgetPerPerson *BudgetRange `json:"budgetPerPerson"`
+
+	// Reservation は報告済みの予約（未報告の場合は nil）
+	Reservation *Reservation `json:"reservationDetails"`
+}
+
+// ReservationEvent は予約に必要なイベントの概要
+type ReservationEvent struct {
+	Title       string `json:"title"`
+	Date        string `json:"date"`
+	Time        string `json:"time"`
+	MemberCount int    `json:"memberCount"`
+}
+
+// RestrictionCount はアレルギー・食事制限ごとの該当人数
+type RestrictionCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
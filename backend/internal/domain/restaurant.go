@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"time"
+)
+
+// Restaurant は飲み会の候補となる店舗の情報
+// 検索プロバイダー（ホットペッパーグルメ等）の結果を共通の形式に変換したもの
+// フロントエンドの Restaurant 型と同じ形式
+type Restaurant struct {
+	// ID はプロバイダー内での店舗ID（例: ホットペッパーの "J001234567"）
+	ID string `json:"id" dynamodbav:"id"`
+
+	// Provider は店舗情報の取得元（例: "hotpepper", "fake"）
+	Provider string `json:"provider" dynamodbav:"provider"`
+
+	// Name は店舗名
+	Name string `json:"name" dynamodbav:"name"`
+
+	// Genre はジャンル名（例: "居酒屋"）
+	Genre string `json:"genre" dynamodbav:"genre"`
+
+	// Area はエリア名（例: "新宿"）
+	Area string `json:"area" dynamodbav:"area"`
+
+	// Station は最寄り駅名（任意）
+	Station string `json:"station,omitempty" dynamodbav:"station,omitempty"`
+
+	// Address は住所
+	Address string `json:"address" dynamodbav:"address"`
+
+	// Phone は電話番号（任意）
+	Phone string `json:"phone,omitempty" dynamodbav:"phone,omitempty"`
+
+	// Latitude・Longitude は店舗の緯度・経度（世界測地系、不明な場合は 0）
+	Latitude  float64 `json:"latitude,omitempty" dynamodbav:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty" dynamodbav:"longitude,omitempty"`
+
+	// Rating は評価（0〜5、不明な場合は 0）
+	Rating float64 `json:"rating,omitempty" dynamodbav:"rating,omitempty"`
+
+	// PriceRange は1人あたりの予算の表示用文字列（例: "3001～4000円"）
+	PriceRange string `json:"priceRange" dynamodbav:"priceRange"`
+
+	// BudgetMin・BudgetMax は1人あたりの予算の範囲（円、上限なしの場合 BudgetMax は 0）
+	BudgetMin int `json:"budgetMin,omitempty" dynamodbav:"budgetMin,omitempty"`
+	BudgetMax int `json:"budgetMax,omitempty" dynamodbav:"budgetMax,omitempty"`
+
+	// Capacity は総席数（不明な場合は 0）
+	Capacity int `json:"capacity,omitempty" dynamodbav:"capacity,omitempty"`
+
+	// Features は店舗の特徴（例: "個室あり", "飲み放題", "禁煙"）
+	Features []string `json:"features,omitempty" dynamodbav:"features,omitempty"`
+
+	// ImageURL は店舗画像のURL（任意）
+	ImageURL string `json:"imageUrl,omitempty" dynamodbav:"imageUrl,omitempty"`
+
+	// MapURL は地図のURL（任意）
+	MapURL string `json:"mapUrl,omitempty" dynamodbav:"mapUrl,omitempty"`
+
+	// ReservationURL は予約ページのURL（任意）
+	ReservationURL string `json:"reservationUrl,omitempty" dynamodbav:"reservationUrl,omitempty"`
+}
+
+// RestaurantSearchCriteria はレストラン検索の条件
+// POST /events/{eventId}/restaurants/search のリクエストボディを兼ねる
+// Area・Genre・Keyword 以外は任意で、0 の場合は条件に含めない
+type RestaurantSearchCriteria struct {
+	// Area はエリア名・駅名（必須、例: "新宿"）
+	Area string `json:"area" dynamodbav:"area" label:"エリア" label_en:"Area" validate:"required,max=50"`
+
+	// Genre はジャンル名（任意、例: "居酒屋"）
+	Genre string `json:"genre,omitempty" dynamodbav:"genre,omitempty" label:"ジャンル" label_en:"Genre" validate:"omitempty,max=50"`
+
+	// Keyword は店名・特徴などの自由キーワード（任意）
+	Keyword string `json:"keyword,omitempty" dynamodbav:"keyword,omitempty" label:"キーワード" label_en:"Keyword" validate:"omitempty,max=100"`
+
+	// BudgetMin・BudgetMax は1人あたりの予算の範囲（円）
+	BudgetMin int `json:"budgetMin,omitempty" dynamodbav:"budgetMin,omitempty" label:"予算の下限" label_en:"Minimum budget" validate:"gte=0,lte=100000"`
+	BudgetMax int `json:"budgetMax,omitempty" dynamodbav:"budgetMax,omitempty" label:"予算の上限" label_en:"Maximum budget" validate:"gte=0,lte=100000"`
+
+	// Capacity は必要な席数（省略時は参加・未回答のメンバー数）
+	Capacity int `json:"capacity,omitempty" dynamodbav:"capacity,omitempty" label:"人数" label_en:"Party size" validate:"gte=0,lte=500"`
+
+	// Count は取得する件数（省略時は 20）
+	Count int `json:"count,omitempty" dynamodbav:"count,omitempty" label:"取得件数" label_en:"Count" validate:"gte=0,lte=100"`
+}
+
+// RestaurantSearch はイベントに保存したレストラン検索の結果
+// 最新の検索結果のみを保持し、候補の比較やお店の決定に使う
+type RestaurantSearch struct {
+	// Criteria は検索条件（人数・件数の既定値を補完済み）
+	Criteria RestaurantSearchCriteria `json:"criteria" dynamodbav:"criteria"`
+
+	// Restaurants は検索結果の店舗
+	Restaurants []Restaurant `json:"restaurants" dynamodbav:"restaurants"`
+
+	// SearchedBy は検索したユーザーのID
+	SearchedBy string `json:"searchedBy" dynamodbav:"searchedBy"`
+
+	// SearchedAt は検索日時
+	SearchedAt time.Time `json:"searchedAt" dynamodbav:"searchedAt"`
+}
+
+// 推薦の種類（RestaurantSuggestion.RecommendationType の値）
+const (
+	// RecommendationMajority は参加者の多数の好み・予算に合うお店
+	RecommendationMajority = "majority"
+
+	// RecommendationInclusive は全員のアレルギー・食事制限に対応できるお店
+	RecommendationInclusive = "inclusive"
+
+	// RecommendationChallenge は普段選ばないジャンルに挑戦するお店
+	RecommendationChallenge = "challenge"
+)
+
+// RestaurantSuggestion は推薦理由付きの候補店舗
+// フロントエンドの RestaurantSuggestion 型と同じ形式
+type RestaurantSuggestion struct {
+	Restaurant
+
+	// RecommendationType は推薦の種類（Recommendation* の値）
+	RecommendationType string `json:"recommendationType"`
+
+	// RecommendationReason は推薦理由の説明文
+	RecommendationReason string `json:"recommendationReason"`
+
+	// Budget は1人あたりの予算の表示用文字列（PriceRange と同じ値）
+	Budget string `json:"budget"`
+}
+
+// RestaurantRecommendationSummary は推薦の前提となった情報
+type RestaurantRecommendationSummary struct {
+	// TotalRestaurants は推薦の対象にした候補店舗の数
+	TotalRestaurants int `json:"totalRestaurants"`
+
+	// AnalysisBase は推薦に使用した情報の説明
+	AnalysisBase string `json:"analysisBase"`
+
+	// MemberCount は好みを考慮したメンバーの人数
+	MemberCount int `json:"memberCount"`
+}
+
+// RestaurantSuggestionsResponse は GET /events/{eventId}/restaurants/suggestions のレスポンス
+type RestaurantSuggestionsResponse struct {
+	Summary     RestaurantRecommendationSummary `json:"summary"`
+	Restaurants []RestaurantSuggestion          `json:"restaurants"`
+}
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"time"
+)
+
+// EventSeries は定期開催の飲み会（例: 毎月第3金曜の部署飲み）のシリーズ
+// 繰り返しルールから直近の開催回を domain.Event（企画中）として生成し、SeriesID で紐付ける
+type EventSeries struct {
+	// ID はシリーズの一意識別子（DynamoDB パーティションキー）
+	// 形式: "ser_" + ランダム文字列
+	ID string `json:"id" dynamodbav:"id"`
+
+	// OrganizerID はシリーズを作成した幹事のユーザーID
+	OrganizerID string `json:"organizerId" dynamodbav:"organizerId"`
+
+	// Title・Purpose・Notes・Time は各開催回に設定する値
+	// 「以降すべて」の編集で更新され、その後に生成される開催回にも反映される
+	Title   string `json:"title" dynamodbav:"title"`
+	Purpose string `json:"purpose" dynamodbav:"purpose"`
+	Notes   string `json:"notes" dynamodbav:"notes"`
+	Time    string `json:"time" dynamodbav:"time"`
+
+	// Recurrence は繰り返しルール
+	Recurrence RecurrenceRule `json:"recurrence" dynamodbav:"recurrence"`
+
+	// RRule は Recurrence を iCalendar（RFC 5545）の RRULE 形式で表した文字列
+	// 例: "FREQ=MONTHLY;INTERVAL=1;BYDAY=3FR;COUNT=12"（カレンダーアプリとの連携用）
+	RRule string `json:"rrule" dynamodbav:"rrule"`
+
+	// StartDate は繰り返しの起点日（YYYY-MM-DD形式）。最初の開催回はこの日以降で最初にルールに合う日
+	StartDate string `json:"startDate" dynamodbav:"startDate"`
+
+	// EndDate は繰り返しの終了日（YYYY-MM-DD形式、任意）。この日より後の開催回は生成しない
+	EndDate string `json:"endDate,omitempty" dynamodbav:"endDate,omitempty"`
+
+	// Count は開催回数の上限（任意、0 の場合は無制限）
+	Count int `json:"count,omitempty" dynamodbav:"count,omitempty"`
+
+	// GeneratedThrough は生成済みの最後の開催日（YYYY-MM-DD形式、未生成は空）
+	// 削除された開催回を再生成しないよう、これより後の日付だけを生成する
+	GeneratedThrough string `json:"generatedThrough,omitempty" dynamodbav:"generatedThrough,omitempty"`
+
+	// GeneratedCount は生成済みの開催回数（Count の判定に使用）
+	GeneratedCount int `json:"generatedCount" dynamodbav:"generatedCount"`
+
+	// CreatedAt はシリーズ作成日時
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+
+	// UpdatedAt は最終更新日時
+	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+// RecurrenceRule は RRULE 形式を簡略化した繰り返しルール
+//
+// 指定例:
+//
+//	{"frequency": "weekly", "weekday": "WE"}                 // 毎週水曜
+//	{"frequency": "weekly", "interval": 2}                   // 隔週（起点日の曜日）
+//	{"frequency": "monthly", "monthDay": 25}                 // 毎月25日（25日がない月はなし）
+//	{"frequency": "monthly", "week": 3, "weekday": "FR"}     // 毎月第3金曜
+//	{"frequency": "monthly", "week": -1, "weekday": "FR"}    // 毎月最終金曜
+type RecurrenceRule struct {
+	// Frequency は繰り返しの単位
+	// 値: "weekly"（毎週）, "monthly"（毎月）
+	Frequency string `json:"frequency" dynamodbav:"frequency" label:"繰り返しの頻度" label_en:"Frequency" validate:"required,oneof=weekly monthly"`
+
+	// Interval は繰り返しの間隔（任意、デフォルト: 1）。例: weekly で 2 なら隔週
+	Interval int `json:"interval,omitempty" dynamodbav:"interval,omitempty" label:"繰り返しの間隔" label_en:"Interval" validate:"omitempty,gte=1,lte=12"`
+
+	// Weekday は曜日（RRULE の BYDAY と同じ2文字表記）
+	// 値: "MO", "TU", "WE", "TH", "FR", "SA", "SU"（未指定の場合は起点日の曜日）
+	Weekday string `json:"weekday,omitempty" dynamodbav:"weekday,omitempty" label:"曜日" label_en:"Weekday" validate:"omitempty,oneof=MO TU WE TH FR SA SU"`
+
+	// MonthDay は monthly で開催する日（1〜31）
+	MonthDay int `json:"monthDay,omitempty" dynamodbav:"monthDay,omitempty" label:"開催日" label_en:"Day of month" validate:"omitempty,gte=1,lte=31"`
+
+	// Week は monthly で第何週の Weekday に開催するか（1〜5、-1 は最終週）
+	Week int `json:"week,omitempty" dynamodbav:"week,omitempty" label:"第何週" label_en:"Week of month" validate:"omitempty,gte=-1,lte=5"`
+}
+
+// CreateSeriesRequest はシリーズ作成時のリクエスト構造体
+type CreateSeriesRequest struct {
+	// Title は各開催回のタイトル（必須）
+	Title string `json:"title" label:"イベントタイトル" label_en:"Event title" validate:"required,min=1,max=100"`
+
+	// Purpose は各開催回の目的（任意、デフォルト: "other"）
+	Purpose string `json:"purpose,omitempty" label:"イベント目的" label_en:"Event purpose" validate:"omitempty,oneof=welcome farewell year_end social other"`
+
+	// Time は各開催回の開始時刻（任意、HH:MM形式）
+	Time string `json:"time,omitempty" label:"時刻" label_en:"Time" validate:"omitempty,datetime=15:04"`
+
+	// Notes は各開催回の備考（任意）
+	Notes string `json:"notes,omitempty" label:"備考" label_en:"Notes" validate:"omitempty,max=1000"`
+
+	// StartDate は繰り返しの起点日（必須、YYYY-MM-DD形式、過去日禁止）
+	StartDate string `json:"startDate" label:"開始日" label_en:"Start date" validate:"required,datetime=2006-01-02,notpast=2006-01-02"`
+
+	// EndDate は繰り返しの終了日（任意、YYYY-MM-DD形式）
+	EndDate string `json:"endDate,omitempty" label:"終了日" label_en:"End date" validate:"omitempty,datetime=2006-01-02"`
+
+	// Count は開催回数の上限（任意）
+	Count int `json:"count,omitempty" label:"開催回数" label_en:"Count" validate:"omitempty,gte=1,lte=100"`
+
+	// Recurrence は繰り返しルール（必須）
+	Recurrence RecurrenceRule `json:"recurrence"`
+}
+
+// 開催回の編集範囲
+const (
+	// SeriesScopeThis は指定した開催回のみを変更する（以降の一括編集の対象外になる）
+	SeriesScopeThis = "this"
+
+	// SeriesScopeFuture は指定した開催回以降のすべての開催回とシリーズ本体を変更する
+	SeriesScopeFuture = "future"
+)
+
+// UpdateOccurrenceRequest はシリーズの開催回を編集する際のリクエスト構造体
+// 未指定（空）の項目は変更しない
+type UpdateOccurrenceRequest struct {
+	// Scope は編集範囲（"this" / "future"）
+	Scope string `json:"scope" label:"編集範囲" label_en:"Scope" validate:"required,oneof=this future"`
+
+	// Title は新しいタイトル
+	Title string `json:"title,omitempty" label:"イベントタイトル" label_en:"Event title" validate:"omitempty,max=100"`
+
+	// Purpose は新しい目的
+	Purpose string `json:"purpose,omitempty" label:"イベント目的" label_en:"Event purpose" validate:"omitempty,oneof=welcome farewell year_end social other"`
+
+	// Date は新しい開催日（scope が "this" の場合のみ指定可能）
+	Date string `json:"date,omitempty" label:"日付" label_en:"Date" validate:"omitempty,datetime=2006-01-02,notpast=2006-01-02"`
+
+	// Time は新しい開始時刻
+	Time string `json:"time,omitempty" label:"時刻" label_en:"Time" validate:"omitempty,datetime=15:04"`
+
+	// Notes は新しい備考
+	Notes string `json:"notes,omitempty" label:"備考" label_en:"Notes" validate:"omitempty,max=1000"`
+}
+
+// SeriesResponse はシリーズと開催回を返すレスポンスのデータ
+type SeriesResponse struct {
+	// Series はシリーズ本体
+	Series *EventSeries `json:"series"`
+
+	// Events は開催回（開催日の昇順）
+	Events []*Event `json:"events"`
+}
@@ -0,0 +1,147 @@
+package domain
+
+import "time"
+
+// 割り勘の分け方
+const (
+	// SplitEqual は固定額のメンバーを除いた参加者で均等に割る
+	SplitEqual = "equal"
+
+	// SplitWeighted は役職などの区分（Tier）の重みに応じて割る
+	SplitWeighted = "weighted"
+)
+
+// DefaultSettlementRounding は精算で端数処理のルールを指定しなかった場合に使うルール
+// メンバーに総額より多く払わせないよう、端数は幹事が負担する
+const DefaultSettlementRounding = RoundingOrganizer
+
+// Settlement はイベントの精算（割り勘）
+// 各メンバーの金額は端数処理した値で、総額との差額は幹事の負担（OrganizerRemainder）か
+// 集めすぎた額（Surplus）のどちらかになる
+type Settlement struct {
+	// TotalAmount は支払い総額（円）
+	TotalAmount int `json:"totalAmount" dynamodbav:"totalAmount"`
+
+	// Method は分け方（Split* の値）
+	Method string `json:"method" dynamodbav:"method"`
+
+	// Rounding は各メンバーの金額の端数処理のルール（Rounding* の値）
+	Rounding string `json:"rounding" dynamodbav:"rounding"`
+
+	// Tiers は区分と重み（Method が SplitWeighted の場合のみ）
+	Tiers []SettlementTier `json:"tiers,omitempty" dynamodbav:"tiers,omitempty"`
+
+	// Shares は参加メンバーごとの金額と支払い状況（メンバーの順）
+	Shares []MemberShare `json:"shares" dynamodbav:"shares"`
+
+	// OrganizerRemainder はメンバーの金額の合計が総額に足りない分で、幹事が負担する額（0以上）
+	OrganizerRemainder int `json:"organizerRemainder" dynamodbav:"organizerRemainder"`
+
+	// Surplus はメンバーの金額の合計が総額を超えた分で、集めすぎた額（0以上）
+	// 切り上げ・四捨五入の端数処理や固定額の指定で生じ、二次会の費用に回すなど幹事が扱いを決める
+	Surplus int `json:"surplus" dynamodbav:"surplus"`
+
+	// CollectedAmount・UnpaidAmount は支払い済み・未払いの金額の合計
+	// 再計算で金額が上がったメンバーは、支払い済みの額との差額を未払いに含める
+	CollectedAmount int `json:"collectedAmount" dynamodbav:"collectedAmount"`
+	UnpaidAmount    int `json:"unpaidAmount" dynamodbav:"unpaidAmount"`
+
+	// OverpaidAmount は再計算で金額が下がり、支払い済みの額が金額を超えた分の合計（メンバーに返す額）
+	OverpaidAmount int `json:"overpaidAmount" dynamodbav:"overpaidAmount"`
+
+	// UnpaidCount は未払いのメンバーの人数
+	UnpaidCount int `json:"unpaidCount" dynamodbav:"unpaidCount"`
+
+	// UpdatedBy・UpdatedAt は最後に精算の内容を変更したユーザーIDと日時
+	UpdatedBy string    `json:"updatedBy" dynamodbav:"updatedBy"`
+	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+// SettlementTier は割り勘の区分（例: 部長・課長・一般）と重み
+type SettlementTier struct {
+	Name   string `json:"name" dynamodbav:"name" label:"区分名" label_en:"Tier name" validate:"required,max=20"`
+	Weight int    `json:"weight" dynamodbav:"weight" label:"重み" label_en:"Weight" validate:"gte=1,lte=10"`
+}
+
+// MemberShare は参加メンバー1人の金額と支払い状況
+type MemberShare struct {
+	// Name はメンバーの名前（Member.Name）
+	Name string `json:"name" dynamodbav:"name"`
+
+	// Tier はメンバーの区分（SplitWeighted の場合）
+	Tier string `json:"tier,omitempty" dynamodbav:"tier,omitempty"`
+
+	// IsFixed は金額を固定で指定したかどうか
+	IsFixed bool `json:"isFixed" dynamodbav:"isFixed"`
+
+	// Amount は支払う金額（円）
+	Amount int `json:"amount" dynamodbav:"amount"`
+
+	// IsPaid・PaidAt は支払い済み（PaidAmount が Amount 以上）かどうかと、支払い済みにした日時
+	IsPaid bool       `json:"isPaid" dynamodbav:"isPaid"`
+	PaidAt *time.Time `json:"paidAt,omitempty" dynamodbav:"paidAt,omitempty"`
+
+	// PaidAmount は支払い済みの金額（支払い済みにした時点の Amount）
+	// 再計算で Amount が変わっても保ち、差額を未払い・返金する額として扱う
+	PaidAmount int `json:"paidAmount" dynamodbav:"paidAmount"`
+
+	// RemindedAt・ReminderCount は最後に催促した日時と催促した回数
+	RemindedAt    *time.Time `json:"remindedAt,omitempty" dynamodbav:"remindedAt,omitempty"`
+	ReminderCount int        `json:"reminderCount" dynamodbav:"reminderCount"`
+}
+
+// Outstanding は未払いの金額（Amount のうち支払い済みの額を超える分）を返す
+func (s MemberShare) Outstanding() int {
+	return max(s.Amount-s.PaidAmount, 0)
+}
+
+// SettlementRequest は PUT /events/{eventId}/settlement のリクエスト
+// 精算を作成・再計算する（同じ名前のメンバーの支払い状況は引き継ぐ）
+type SettlementRequest struct {
+	// TotalAmount は支払い総額（円）
+	TotalAmount int `json:"totalAmount" label:"総額" label_en:"Total amount" validate:"gte=1,lte=10000000"`
+
+	// Method は分け方（省略時は均等）
+	Method string `json:"method,omitempty" label:"分け方" label_en:"Split method" validate:"omitempty,oneof=equal weighted"`
+
+	// Rounding は各メンバーの金額の端数処理（省略時は organizer: 1円未満を切り捨てて端数は幹事が負担）
+	Rounding string `json:"rounding,omitempty" label:"端数処理" label_en:"Rounding" validate:"omitempty,oneof=organizer none ceil_100 ceil_1000 round_100 round_1000 floor_100 floor_1000"`
+
+	// Tiers は区分と重み（Method が weighted の場合に必須、要素はハンドラーで1件ずつ検証する）
+	Tiers []SettlementTier `json:"tiers,omitempty" label:"区分" label_en:"Tiers" validate:"max=10"`
+
+	// Members はメンバーごとの区分・固定額の指定（指定のない参加メンバーは重み1・固定額なし）
+	Members []SettlementMemberRequest `json:"members,omitempty" label:"メンバー" label_en:"Members" validate:"max=100"`
+}
+
+// SettlementMemberRequest はメンバー1人の区分・固定額の指定
+type SettlementMemberRequest struct {
+	// Name は参加メンバーの名前
+	Name string `json:"name" label:"名前" label_en:"Name" validate:"required,max=50"`
+
+	// Tier は区分の名前（Tiers のいずれか）
+	Tier string `json:"tier,omitempty" label:"区分" label_en:"Tier" validate:"max=20"`
+
+	// FixedAmount は固定で支払う金額（指定した場合は割り勘の対象から外れる）
+	FixedAmount *int `json:"fixedAmount,omitempty" label:"固定額" label_en:"Fixed amount" validate:"omitempty,gte=0,lte=10000000"`
+}
+
+// PaymentRequest は PUT /events/{eventId}/settlement/payments のリクエスト
+type PaymentRequest struct {
+	// Name はメンバーの名前
+	Name string `json:"name" label:"名前" label_en:"Name" validate:"required,max=50"`
+
+	// IsPaid は支払い済みかどうか
+	IsPaid bool `json:"isPaid" label:"支払い済み" label_en:"Paid"`
+}
+
+// PaymentReminder は未払いのメンバーへの催促（POST /events/{eventId}/settlement/reminders のレスポンスの要素）
+// 通知の送信は行わないため、幹事は Message をメール・チャットで送る
+type PaymentReminder struct {
+	Name          string    `json:"name"`
+	Email         string    `json:"email,omitempty"`
+	Amount        int       `json:"amount"`
+	Message       string    `json:"message"`
+	RemindedAt    time.Time `json:"remindedAt"`
+	ReminderCount int       `json:"reminderCount"`
+}
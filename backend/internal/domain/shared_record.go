@@ -0,0 +1,160 @@
+package domain
+
+import "time"
+
+// ModerationPendingReview は通報が一定数に達し、審査待ちで「みんなの記録」から非表示になっている状態
+const ModerationPendingReview = "pending_review"
+
+// RecordShare は「みんなの記録」に公開する内容
+// 公開時にメンバーの名前を伏せ字にしたタイトル・コメントを保存し、以降の一覧ではこの内容だけを使う
+type RecordShare struct {
+	// Title は公開するイベント名（メンバーの名前は伏せ字）
+	Title string `json:"title" dynamodbav:"title"`
+
+	// Note は公開するコメント（メンバーの名前は伏せ字、記録のメモは公開しない）
+	Note string `json:"note" dynamodbav:"note"`
+
+	// DisplayName は公開する幹事の表示名（HidePersonalInfo が true の場合は使わない）
+	DisplayName string `json:"displayName,omitempty" dynamodbav:"displayName,omitempty"`
+
+	// HidePersonalInfo が true の場合は幹事のユーザーID・表示名を公開しない
+	HidePersonalInfo bool `json:"hidePersonalInfo" dynamodbav:"hidePersonalInfo"`
+
+	// SharedAt は公開した日時
+	SharedAt time.Time `json:"sharedAt" dynamodbav:"sharedAt"`
+}
+
+// RecordReport は共有記録への不適切報告
+type RecordReport struct {
+	ReporterID string    `json:"reporterId" dynamodbav:"reporterId"`
+	Reason     string    `json:"reason" dynamodbav:"reason"`
+	Comment    string    `json:"comment,omitempty" dynamodbav:"comment,omitempty"`
+	ReportedAt time.Time `json:"reportedAt" dynamodbav:"reportedAt"`
+}
+
+// ShareRecordRequest は POST /records/private/{recordId}/share のリクエスト
+type ShareRecordRequest struct {
+	// ShareNote は公開するコメント（任意）
+	ShareNote string `json:"shareNote" label:"コメント" label_en:"Share note" validate:"max=500"`
+
+	// DisplayName は公開する幹事の表示名（任意、省略時は「幹事」）
+	DisplayName string `json:"displayName" label:"表示名" label_en:"Display name" validate:"max=50"`
+
+	// HidePersonalInfo は幹事の情報を伏せるかどうか（省略時は true）
+	HidePersonalInfo *bool `json:"hidePersonalInfo,omitempty" label:"個人情報を非表示" label_en:"Hide personal info"`
+}
+
+// ReportRecordRequest は POST /records/shared/{recordId}/report のリクエスト
+type ReportRecordRequest struct {
+	// Reason は報告の理由
+	Reason string `json:"reason" label:"理由" label_en:"Reason" validate:"required,oneof=inappropriate spam personal_info other"`
+
+	// Comment は補足（任意）
+	Comment string `json:"comment" label:"補足" label_en:"Comment" validate:"max=500"`
+}
+
+// SharedRecordQuery は GET /records/shared の絞り込み条件（json タグはクエリパラメータ名）
+type SharedRecordQuery struct {
+	// Area はエリア（会場のエリア・住所に含まれる文字列）
+	Area string `json:"area" label:"エリア" label_en:"Area" validate:"max=50"`
+
+	// Purpose はイベントの目的
+	Purpose string `json:"purpose" label:"イベント目的" label_en:"Event purpose" validate:"omitempty,oneof=welcome farewell year_end social other"`
+
+	// Genre はジャンル（会場のジャンルに含まれる文字列）
+	Genre string `json:"genre" label:"ジャンル" label_en:"Genre" validate:"max=50"`
+
+	// MinPrice・MaxPrice は1人あたりの金額の範囲（円、0は指定なし）
+	MinPrice int `json:"minPrice" label:"最低金額" label_en:"Min price" validate:"gte=0"`
+	MaxPrice int `json:"maxPrice" label:"最高金額" label_en:"Max price" validate:"gte=0"`
+
+	// Liked が true の場合は自分がいいねした記録のみ
+	Liked bool `json:"liked" label:"いいね済み" label_en:"Liked"`
+
+	// Page はページ番号（1始まり）、Limit は1ページの件数
+	Page  int `json:"page" label:"ページ" label_en:"Page" validate:"gte=1"`
+	Limit int `json:"limit" label:"件数" label_en:"Limit" validate:"gte=1,lte=50"`
+}
+
+// SharedRecord は「みんなの記録」に表示する共有記録（GET /records/shared の要素）
+// 幹事・メンバーを特定できる情報（ユーザーID・総額・メモ・会場を決定した人）は含めない
+type SharedRecord struct {
+	// ID は共有記録のID（記録のIDと同じ）
+	ID string `json:"id"`
+
+	// EventLog は記録の内容
+	EventLog SharedEventLog `json:"eventLog"`
+
+	// Event はイベントの概要
+	Event SharedRecordEvent `json:"event"`
+
+	// Organizer は幹事（個人情報を伏せた場合は ID なし・「匿名の幹事」）
+	Organizer SharedRecordOrganizer `json:"organizer"`
+
+	// LikeCount はいいねの数、IsLiked は自分がいいねしているかどうか
+	LikeCount int  `json:"likeCount"`
+	IsLiked   bool `json:"isLiked"`
+
+	// ParticipantCount は参加人数
+	ParticipantCount int `json:"participantCount"`
+
+	// EventDate は開催日
+	EventDate string `json:"eventDate"`
+
+	// SharedAt は公開した日時
+	SharedAt time.Time `json:"sharedAt"`
+}
+
+// SharedEventLog は共有記録に含める記録の内容
+type SharedEventLog struct {
+	ID            string       `json:"id"`
+	Rating        int          `json:"rating"`
+	Notes         string       `json:"notes"`
+	CostPerPerson int          `json:"costPerPerson"`
+	Attendees     int          `json:"attendees"`
+	Venue         *SharedVenue `json:"venue,omitempty"`
+	CreatedAt     time.Time    `json:"createdAt"`
+}
+
+// SharedVenue は共有記録に含める会場（会場を決定した人は含めない）
+type SharedVenue struct {
+	Name           string `json:"name"`
+	Address        string `json:"address"`
+	Phone          string `json:"phone,omitempty"`
+	MapURL         string `json:"mapUrl,omitempty"`
+	Genre          string `json:"genre,omitempty"`
+	Area           string `json:"area,omitempty"`
+	ReservationURL string `json:"reservationUrl,omitempty"`
+}
+
+// SharedRecordEvent は共有記録に含めるイベントの概要
+type SharedRecordEvent struct {
+	Title   string `json:"title"`
+	Purpose string `json:"purpose"`
+}
+
+// SharedRecordOrganizer は共有記録の幹事
+type SharedRecordOrganizer struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// SharedRecordPage は共有記録の1ページ分と、ページ分割の情報
+type SharedRecordPage struct {
+	Records    []SharedRecord
+	Pagination Pagination
+}
+
+// Pagination はページ分割の情報（レスポンスの meta.pagination）
+type Pagination struct {
+	Page       int `json:"page"`
+	Limit      int `json:"limit"`
+	Total      int `json:"total"`
+	TotalPages int `json:"totalPages"`
+}
+
+// RecordLikes は共有記録のいいねの状態（いいね・取り消しのレスポンス）
+type RecordLikes struct {
+	LikeCount int  `json:"likeCount"`
+	IsLiked   bool `json:"isLiked"`
+}
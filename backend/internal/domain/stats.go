@@ -0,0 +1,64 @@
+package domain
+
+// StatsQuery は GET /records/stats の集計期間（json タグはクエリパラメータ名）
+// 開催日（YYYY-MM-DD）が From 以上 To 以下のイベント・記録を集計し、省略した側は制限しない
+type StatsQuery struct {
+	From string `json:"from" label:"開始日" label_en:"From" validate:"omitempty,datetime=2006-01-02"`
+	To   string `json:"to" label:"終了日" label_en:"To" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// OrganizerStats は幹事の個人統計（GET /records/stats のレスポンス）
+// 対象は自分が所有者のイベントと、自分が作成した記録（共同幹事として関わったイベントは含めない）
+type OrganizerStats struct {
+	// Period は集計期間（指定がない側は空）
+	Period StatsQuery `json:"period"`
+
+	// TotalEvents は期間内のイベント数
+	TotalEvents int `json:"totalEvents"`
+
+	// EventsByStatus・EventsByPurpose はステータス・目的ごとのイベント数（0件の値も含む）
+	EventsByStatus  map[string]int `json:"eventsByStatus"`
+	EventsByPurpose map[string]int `json:"eventsByPurpose"`
+
+	// RecordCount は期間内の記録数
+	RecordCount int `json:"recordCount"`
+
+	// AverageRating は記録の評価の平均（小数第1位に四捨五入、記録がない場合は nil）
+	AverageRating *float64 `json:"averageRating"`
+
+	// AverageCostPerPerson は総額を記録した記録の1人あたりの金額の平均（円、該当がない場合は nil）
+	AverageCostPerPerson *int `json:"averageCostPerPerson"`
+
+	// TotalAttendees は記録の参加人数の合計
+	TotalAttendees int `json:"totalAttendees"`
+
+	// ResponseRate はメンバーのうち出欠を回答した割合（0〜1、メンバーがいない場合は nil）
+	ResponseRate *float64 `json:"responseRate"`
+
+	// ResponseRateTrend は開催月ごとの回答率（古い順、開催日が未定のイベントは含めない）
+	ResponseRateTrend []MonthlyResponseRate `json:"responseRateTrend"`
+
+	// TopGenres・TopAreas はよく使う会場のジャンル・エリア（多い順）
+	TopGenres []NameCount `json:"topGenres"`
+	TopAreas  []NameCount `json:"topAreas"`
+}
+
+// MonthlyResponseRate は開催月ごとの回答状況
+type MonthlyResponseRate struct {
+	// Month は開催月（YYYY-MM）
+	Month string `json:"month"`
+
+	// Events はその月のイベント数、Members・Responded はメンバー数と回答済みの人数
+	Events    int `json:"events"`
+	Members   int `json:"members"`
+	Responded int `json:"responded"`
+
+	// Rate は Responded / Members（メンバーがいない場合は nil）
+	Rate *float64 `json:"rate"`
+}
+
+// NameCount は名前ごとの件数
+type NameCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"time"
+)
+
+// EventTemplate は過去のイベントから保存した、繰り返し使える企画のひな形
+// 四半期ごとの送別会のように同じ形式で開催する飲み会を、毎回ゼロから作らずに済むようにする
+//
+// 引き継ぐ項目: タイトル・目的・備考・フォームの質問・メンバー（回答状況は未回答に戻す）
+// 引き継がない項目: 日時・日程調整の投票・幹事ログなど、開催回ごとに異なる情報
+type EventTemplate struct {
+	// ID はテンプレートの一意識別子（DynamoDB パーティションキー）
+	// 形式: "tpl_" + ランダム文字列
+	ID string `json:"id" dynamodbav:"id"`
+
+	// OrganizerID はテンプレートを保存した幹事のユーザーID
+	OrganizerID string `json:"organizerId" dynamodbav:"organizerId"`
+
+	// Name はテンプレート一覧に表示する名前（未指定時は元イベントのタイトル）
+	Name string `json:"name" dynamodbav:"name"`
+
+	// SourceEventID はテンプレートの元になったイベントのID
+	SourceEventID string `json:"sourceEventId" dynamodbav:"sourceEventId"`
+
+	// Title は作成するイベントのタイトル
+	Title string `json:"title" dynamodbav:"title"`
+
+	// Purpose は作成するイベントの目的
+	Purpose string `json:"purpose" dynamodbav:"purpose"`
+
+	// Notes は作成するイベントの備考
+	Notes string `json:"notes" dynamodbav:"notes"`
+
+	// FormQuestions はフォームの質問項目
+	FormQuestions []FormQuestion `json:"formQuestions" dynamodbav:"formQuestions"`
+
+	// Members は招待するメンバー（参加状況・回答内容は含まない）
+	Members []Member `json:"members" dynamodbav:"members"`
+
+	// Budget は元のイベントの予算（未設定の場合は nil）
+	Budget *EventBudget `json:"budget,omitempty" dynamodbav:"budget,omitempty"`
+
+	// CreatedAt はテンプレートの保存日時
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+// DuplicateEventRequest はイベントの複製・テンプレートからの作成時のリクエスト構造体
+// 日時は開催回ごとに異なるため元のイベントからは引き継がず、ここで指定する
+// 全項目任意で、タイトル・予算は未指定の場合に元のイベント（テンプレート）の値を使う
+type DuplicateEventRequest struct {
+	// Title は新しいイベントのタイトル（未指定時は元のタイトル）
+	Title string `json:"title,omitempty" label:"イベントタイトル" label_en:"Event title" validate:"omitempty,max=100"`
+
+	// Date は新しいイベントの開催予定日（YYYY-MM-DD形式、過去日禁止）
+	Date string `json:"date,omitempty" label:"日付" label_en:"Date" validate:"omitempty,datetime=2006-01-02,notpast=2006-01-02"`
+
+	// Time は新しいイベントの開催時刻（HH:MM形式）
+	Time string `json:"time,omitempty" label:"時刻" label_en:"Time" validate:"omitempty,datetime=15:04"`
+
+	// HasScheduling は日程調整機能を使用するかどうか
+	HasScheduling bool `json:"hasScheduling,omitempty"`
+
+	// Budget は新しいイベントの予算（未指定時は元の予算、すべて0の場合は予算なし）
+	Budget *EventBudget `json:"budget,omitempty" label:"予算" label_en:"Budget"`
+}
+
+// CreateTemplateRequest はイベントをテンプレートとして保存する際のリクエスト構造体
+type CreateTemplateRequest struct {
+	// Name はテンプレート名（任意、未指定時は元イベントのタイトル）
+	Name string `json:"name,omitempty" label:"テンプレート名" label_en:"Template name" validate:"omitempty,max=100"`
+}
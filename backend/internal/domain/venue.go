@@ -0,0 +1,77 @@
+package domain
+
+import "time"
+
+// Venue はイベントの会場（決定したお店）
+// 検索結果から選んだ場合は RestaurantID・Provider を持ち、手入力の場合は空
+type Venue struct {
+	// Name は店名
+	Name string `json:"name" dynamodbav:"name"`
+
+	// Address は住所
+	Address string `json:"address" dynamodbav:"address"`
+
+	// Phone は電話番号
+	Phone string `json:"phone,omitempty" dynamodbav:"phone,omitempty"`
+
+	// MapURL は地図のURL
+	MapURL string `json:"mapUrl,omitempty" dynamodbav:"mapUrl,omitempty"`
+
+	// Genre はジャンル（例: "居酒屋"）
+	Genre string `json:"genre,omitempty" dynamodbav:"genre,omitempty"`
+
+	// Area はエリア（例: "新宿"）
+	Area string `json:"area,omitempty" dynamodbav:"area,omitempty"`
+
+	// RestaurantID・Provider は検索元での店舗IDとプロバイダー名（手入力の場合は空）
+	RestaurantID string `json:"restaurantId,omitempty" dynamodbav:"restaurantId,omitempty"`
+	Provider     string `json:"provider,omitempty" dynamodbav:"provider,omitempty"`
+
+	// ReservationURL は予約ページのURL
+	ReservationURL string `json:"reservationUrl,omitempty" dynamodbav:"reservationUrl,omitempty"`
+
+	// SelectedBy は会場を決定したユーザーID
+	SelectedBy string `json:"selectedBy" dynamodbav:"selectedBy"`
+
+	// SelectedAt は会場を決定した日時
+	SelectedAt time.Time `json:"selectedAt" dynamodbav:"selectedAt"`
+}
+
+// SelectVenueRequest は POST /events/{eventId}/restaurants/select のリクエスト
+// RestaurantID を指定すると検索結果の店舗を、省略すると Name・Address などの手入力の内容を会場にする
+type SelectVenueRequest struct {
+	// RestaurantID は検索結果の店舗ID
+	RestaurantID string `json:"restaurantId,omitempty" label:"店舗ID" label_en:"Restaurant ID" validate:"max=100"`
+
+	// Name 以下は手入力の会場（RestaurantID を省略した場合は Name・Address が必須）
+	Name    string `json:"name,omitempty" label:"店名" label_en:"Name" validate:"max=100"`
+	Address string `json:"address,omitempty" label:"住所" label_en:"Address" validate:"max=200"`
+	Phone   string `json:"phone,omitempty" label:"電話番号" label_en:"Phone" validate:"max=20"`
+	MapURL  string `json:"mapUrl,omitempty" label:"地図のURL" label_en:"Map URL" validate:"max=500"`
+	Genre   string `json:"genre,omitempty" label:"ジャンル" label_en:"Genre" validate:"max=50"`
+	Area    string `json:"area,omitempty" label:"エリア" label_en:"Area" validate:"max=50"`
+}
+
+// SamePlace は v と other が同じお店かどうかを返す
+// 検索結果の店舗は検索元の店舗IDで、手入力の会場は店名と住所で比べる
+func (v Venue) SamePlace(other Venue) bool {
+	if v.RestaurantID != "" || other.RestaurantID != "" {
+		return v.Provider == other.Provider && v.RestaurantID == other.RestaurantID
+	}
+	return v.Name == other.Name && v.Address == other.Address
+}
+
+// VenueFromRestaurant は検索結果の店舗から会場を作成する（決定者・日時は呼び出し側で設定）
+func VenueFromRestaurant(restaurant Restaurant) Venue {
+	return Venue{
+		Name:           restaurant.Name,
+		Address:        restaurant.Address,
+		Phone:          restaurant.Phone,
+		MapURL:         restaurant.MapURL,
+		Genre:          restaurant.Genre,
+		Area:           restaurant.Area,
+		RestaurantID:   restaurant.ID,
+		Provider:       restaurant.Provider,
+		ReservationURL: restaurant.ReservationURL,
+	}
+}
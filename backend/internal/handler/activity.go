@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// ActivityHandler はイベントの変更履歴（監査ログ）の参照を処理
+// 監査ログの記録は audit.Recorder がイベントの保存時に行うため、ここでは参照のみを扱う
+type ActivityHandler struct {
+	// events は権限チェック込みのイベント取得を担当
+	events *EventHandler
+
+	// auditRepo は監査ログの取得元
+	auditRepo repository.AuditRepository
+}
+
+// NewActivityHandler は新しいActivityHandlerインスタンスを作成
+func NewActivityHandler(eventHandler *EventHandler, auditRepo repository.AuditRepository) *ActivityHandler {
+	return &ActivityHandler{
+		events:    eventHandler,
+		auditRepo: auditRepo,
+	}
+}
+
+// ListActivity はイベントの変更履歴を新しい順に返す
+// イベントを閲覧できるユーザー（所有者・共同幹事）であれば誰でも参照できる
+func (h *ActivityHandler) ListActivity(ctx context.Context, eventID string, userID string) ([]*domain.AuditEntry, error) {
+	if _, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionView); err != nil {
+		return nil, err
+	}
+
+	entries, err := h.auditRepo.ListAuditEntries(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("変更履歴の取得に失敗しました: %w", err)
+	}
+
+	// リポジトリは記録順で返すため、画面表示用に新しい順へ並べ替える
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	return entries, nil
+}
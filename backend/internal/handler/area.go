@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/luck-tech/kanji-log/backend/internal/area"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+)
+
+// AreaHandler はメンバーの最寄り駅からの集合場所の分析を処理
+type AreaHandler struct {
+	// events は権限チェック込みのイベント取得を担当
+	events *EventHandler
+
+	// stations は駅名から座標を引く駅データ
+	stations *area.Directory
+}
+
+// NewAreaHandler は新しいAreaHandlerインスタンスを作成
+func NewAreaHandler(eventHandler *EventHandler, stations *area.Directory) *AreaHandler {
+	return &AreaHandler{
+		events:   eventHandler,
+		stations: stations,
+	}
+}
+
+// AnalyzeArea はメンバーの最寄り駅から、全員が集まりやすい駅を求める（閲覧権限で利用可能）
+// req.MemberLocations を省略した場合は、フォームで回答された最寄り駅（preferences.nearestStation）を使う
+// 対象は参加と回答したメンバーで、まだ誰も参加と回答していない場合は不参加以外のメンバーとする
+// 分析結果は保存しないため、回答が増えるたびに呼び直せばよい
+func (h *AreaHandler) AnalyzeArea(ctx context.Context, eventID string, userID string, req *domain.AreaAnalysisRequest) (*domain.AreaAnalysis, error) {
+	lang := i18n.FromContext(ctx)
+	if err := h.events.validator.Validate(req, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := req.MemberLocations
+	if len(locations) == 0 {
+		members := membersByStatus(event.Members, "attending")
+		if len(members) == 0 {
+			members = membersExcept(event.Members, "declined")
+		}
+		locations = make([]domain.MemberLocation, 0, len(members))
+		for _, member := range members {
+			locations = append(locations, domain.MemberLocation{
+				Name:    member.Name,
+				Station: member.ParsePreferences().NearestStation,
+			})
+		}
+	}
+	for i := range locations {
+		locations[i].Name = strings.TrimSpace(norm.NFKC.String(locations[i].Name))
+		locations[i].Station = strings.TrimSpace(norm.NFKC.String(locations[i].Station))
+	}
+
+	return h.stations.Analyze(locations)
+}
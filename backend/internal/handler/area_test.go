@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/area"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+func TestAnalyzeArea(t *testing.T) {
+	ctx := context.Background()
+	events, _, event := seedEvent(t, []domain.Member{
+		{Name: "田中", Status: "attending", Preferences: map[string]interface{}{"nearestStation": "池袋駅"}},
+		{Name: "佐藤", Status: "attending", Preferences: map[string]interface{}{"nearestStation": "渋谷"}},
+		{Name: "鈴木", Status: "attending", Preferences: map[string]interface{}{"nearestStation": "東京"}},
+		{Name: "高橋", Status: "attending"},
+		{Name: "伊藤", Status: "declined", Preferences: map[string]interface{}{"nearestStation": "横浜"}},
+	}, nil)
+	stations, err := area.NewDefaultDirectory()
+	if err != nil {
+		t.Fatalf("NewDefaultDirectory() error = %v", err)
+	}
+	h := NewAreaHandler(events, stations)
+
+	tests := []struct {
+		name           string
+		req            *domain.AreaAnalysisRequest
+		wantStation    string
+		wantMembers    int
+		wantUnresolved []string
+	}{
+		{
+			name:           "フォームの回答から参加者のみで分析",
+			req:            &domain.AreaAnalysisRequest{},
+			wantStation:    "市ケ谷",
+			wantMembers:    3,
+			wantUnresolved: []string{"高橋"},
+		},
+		{
+			name: "リクエストの最寄り駅を優先",
+			req: &domain.AreaAnalysisRequest{MemberLocations: []domain.MemberLocation{
+				{Name: "田中", Station: "新宿"},
+				{Name: "佐藤", Station: "新宿駅"},
+			}},
+			wantStation: "新宿",
+			wantMembers: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := h.AnalyzeArea(ctx, event.ID, "owner", tt.req)
+			if err != nil {
+				t.Fatalf("AnalyzeArea() error = %v", err)
+			}
+			if got.CenterArea.Station != tt.wantStation {
+				t.Errorf("CenterArea.Station = %q, %q を期待", got.CenterArea.Station, tt.wantStation)
+			}
+			if len(got.Members) != tt.wantMembers || len(got.UnresolvedMembers) != len(tt.wantUnresolved) {
+				t.Fatalf("Members = %+v, UnresolvedMembers = %+v", got.Members, got.UnresolvedMembers)
+			}
+			for i, name := range tt.wantUnresolved {
+				if got.UnresolvedMembers[i].Name != name {
+					t.Errorf("UnresolvedMembers[%d] = %q, %q を期待", i, got.UnresolvedMembers[i].Name, name)
+				}
+			}
+		})
+	}
+
+	t.Run("最寄り駅のわかるメンバーがいない", func(t *testing.T) {
+		req := &domain.AreaAnalysisRequest{MemberLocations: []domain.MemberLocation{{Name: "田中", Station: "札幌"}}}
+		if _, err := h.AnalyzeArea(ctx, event.ID, "owner", req); !errors.Is(err, area.ErrNoLocations) {
+			t.Errorf("AnalyzeArea() error = %v, area.ErrNoLocations を期待", err)
+		}
+	})
+
+	t.Run("他の幹事のイベント", func(t *testing.T) {
+		if _, err := h.AnalyzeArea(ctx, event.ID, "someone-else", &domain.AreaAnalysisRequest{}); !errors.Is(err, ErrForbidden) {
+			t.Errorf("AnalyzeArea() error = %v, ErrForbidden を期待", err)
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// BudgetHandler はイベントの予算と、メンバーの予算から求める1人あたりの金額の目安を処理
+type BudgetHandler struct {
+	// events はイベントの取得（権限チェック込み）と保存を担当
+	events *EventHandler
+}
+
+// NewBudgetHandler は新しいBudgetHandlerインスタンスを作成
+func NewBudgetHandler(eventHandler *EventHandler) *BudgetHandler {
+	return &BudgetHandler{events: eventHandler}
+}
+
+// GetBudgetPlan はイベントの予算と参加メンバーの予算の範囲から、1人あたりの金額の目安を返す（閲覧権限で利用可能）
+func (h *BudgetHandler) GetBudgetPlan(ctx context.Context, eventID string, userID string) (*domain.BudgetPlan, error) {
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return planBudget(event), nil
+}
+
+// UpdateBudget はイベントの予算を置き換え、新しい予算での目安を返す（編集権限が必要）
+// すべての項目が0（未設定）の場合は予算を削除する
+func (h *BudgetHandler) UpdateBudget(ctx context.Context, eventID string, userID string, req *domain.EventBudget) (*domain.BudgetPlan, error) {
+	lang := i18n.FromContext(ctx)
+	if err := h.events.validator.Validate(req, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+	if err := budgetConflict(req, "", lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	event.Budget = nil
+	if !req.IsZero() {
+		budget := *req
+		event.Budget = &budget
+	}
+
+	if _, err := h.events.eventRepo.UpdateEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("予算の保存に失敗しました: %w", err)
+	}
+	return planBudget(event), nil
+}
+
+// budgetConflict は validate タグでは表せない予算の項目間の矛盾を返す（矛盾がない場合は nil）
+// prefix はエラーのフィールド名の前に付ける親フィールド名（例: イベント作成時の "budget."）
+func budgetConflict(budget *domain.EventBudget, prefix string, lang i18n.Language) error {
+	if budget == nil || budget.TotalCap == 0 || budget.Subsidy <= budget.TotalCap {
+		return nil
+	}
+	message := "会社補助は総額の上限以下にしてください"
+	if lang == i18n.English {
+		message = "Subsidy must not exceed the total cap"
+	}
+	return validation.Errors{{Field: prefix + "subsidy", Code: validation.CodeInvalidCombination, Message: message}}
+}
+
+// planBudget は参加と回答したメンバーの予算の範囲に、会社補助と総額の上限を当てはめて目安を求める
+// メンバーの予算は負担額のため、お店の金額の範囲は1人あたりの会社補助の分だけ高くなる
+func planBudget(event *domain.Event) *domain.BudgetPlan {
+	attending := membersByStatus(event.Members, "attending")
+	budget := domain.EventBudget{}
+	if event.Budget != nil {
+		budget = *event.Budget
+	}
+
+	plan := &domain.BudgetPlan{
+		Budget:            event.Budget,
+		Headcount:         len(attending),
+		Feasible:          true,
+		OverBudgetMembers: make([]domain.OverBudgetMember, 0),
+	}
+
+	preferences := make([]domain.MemberPreferences, 0, len(attending))
+	for _, member := range attending {
+		p := member.ParsePreferences()
+		if p.BudgetRange != nil {
+			plan.AnsweredCount++
+		}
+		preferences = append(preferences, p)
+	}
+	plan.MemberRange = domain.CommonBudgetRange(preferences)
+
+	if plan.Headcount > 0 {
+		plan.SubsidyPerPerson = budget.Subsidy / plan.Headcount
+		if budget.TotalCap > 0 {
+			capPerPerson := budget.TotalCap / plan.Headcount
+			plan.CapPerPerson = &capPerPerson
+		}
+	}
+
+	// 予算を回答したメンバーの範囲が重ならない場合は、どの金額でも誰かの予算を外れる
+	var band *domain.BudgetRange
+	switch {
+	case plan.MemberRange != nil:
+		band = &domain.BudgetRange{Min: plan.MemberRange.Min + plan.SubsidyPerPerson}
+		if plan.MemberRange.Max > 0 {
+			band.Max = plan.MemberRange.Max + plan.SubsidyPerPerson
+		}
+	case plan.AnsweredCount > 0:
+		plan.Feasible = false
+	}
+	if plan.Feasible && plan.CapPerPerson != nil {
+		if band == nil {
+			band = &domain.BudgetRange{}
+		}
+		if band.Max == 0 || *plan.CapPerPerson < band.Max {
+			band.Max = *plan.CapPerPerson
+		}
+		if band.Min > band.Max {
+			plan.Feasible = false
+			band = nil
+		}
+	}
+	plan.PriceBand = band
+
+	if budget.TargetPerPerson > 0 {
+		within := plan.Feasible && (band == nil ||
+			(budget.TargetPerPerson >= band.Min && (band.Max == 0 || budget.TargetPerPerson <= band.Max)))
+		plan.TargetWithinBand = &within
+		plan.OverBudgetMembers = overBudgetMembers(attending, budget.TargetPerPerson-plan.SubsidyPerPerson)
+	}
+	return plan
+}
+
+// overBudgetMembers は負担額 payment が予算の上限を超えるメンバーを超過額の多い順（同額は名前順）に返す
+// 予算を回答していない・上限を決めていないメンバーは含めない
+func overBudgetMembers(attending []domain.Member, payment int) []domain.OverBudgetMember {
+	if payment < 0 {
+		payment = 0
+	}
+	over := make([]domain.OverBudgetMember, 0)
+	for _, member := range attending {
+		budget := member.ParsePreferences().BudgetRange
+		if budget == nil || budget.Max == 0 || payment <= budget.Max {
+			continue
+		}
+		over = append(over, domain.OverBudgetMember{
+			Name:    member.Name,
+			Max:     budget.Max,
+			Payment: payment,
+			Excess:  payment - budget.Max,
+		})
+	}
+	sort.SliceStable(over, func(i, j int) bool {
+		if over[i].Excess != over[j].Excess {
+			return over[i].Excess > over[j].Excess
+		}
+		return over[i].Name < over[j].Name
+	})
+	return over
+}
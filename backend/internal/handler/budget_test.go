@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// budgetMember は予算の範囲を回答したメンバーを作成
+func budgetMember(name string, status string, min int, max int) domain.Member {
+	return domain.Member{Name: name, Status: status, Preferences: map[string]interface{}{
+		"budgetRange": map[string]interface{}{"min": float64(min), "max": float64(max)},
+	}}
+}
+
+func boolPtr(v bool) *bool { return &v }
+
+// newTestBudgetHandler は members のいるイベントと、その BudgetHandler を作成
+func newTestBudgetHandler(t *testing.T, members []domain.Member) (*BudgetHandler, string) {
+	t.Helper()
+	events, _, event := seedEvent(t, members, nil)
+	return NewBudgetHandler(events), event.ID
+}
+
+// 参加3名（うち予算の回答は2名）・未回答1名・不参加1名
+var budgetTestMembers = []domain.Member{
+	budgetMember("田中", "attending", 3000, 5000),
+	budgetMember("佐藤", "attending", 2000, 4000),
+	{Name: "鈴木", Status: "attending"},
+	budgetMember("高橋", "pending", 10000, 20000),
+	budgetMember("伊藤", "declined", 1000, 1500),
+}
+
+func TestUpdateBudget(t *testing.T) {
+	tests := []struct {
+		name           string
+		budget         domain.EventBudget
+		wantBand       *domain.BudgetRange
+		wantFeasible   bool
+		wantWithinBand *bool
+		wantOver       []domain.OverBudgetMember
+	}{
+		{
+			name:         "予算なし",
+			budget:       domain.EventBudget{},
+			wantBand:     &domain.BudgetRange{Min: 3000, Max: 4000},
+			wantFeasible: true,
+			wantOver:     []domain.OverBudgetMember{},
+		},
+		{
+			name:           "会社補助の分だけ高いお店を選べる",
+			budget:         domain.EventBudget{TargetPerPerson: 5000, TotalCap: 15000, Subsidy: 3000},
+			wantBand:       &domain.BudgetRange{Min: 4000, Max: 5000},
+			wantFeasible:   true,
+			wantWithinBand: boolPtr(true),
+			wantOver:       []domain.OverBudgetMember{},
+		},
+		{
+			name:           "目安が予算の上限を超えるメンバー",
+			budget:         domain.EventBudget{TargetPerPerson: 7000, Subsidy: 3000},
+			wantBand:       &domain.BudgetRange{Min: 4000, Max: 5000},
+			wantFeasible:   true,
+			wantWithinBand: boolPtr(false),
+			wantOver: []domain.OverBudgetMember{
+				{Name: "佐藤", Max: 4000, Payment: 6000, Excess: 2000},
+				{Name: "田中", Max: 5000, Payment: 6000, Excess: 1000},
+			},
+		},
+		{
+			name:           "総額の上限では全員の下限に届かない",
+			budget:         domain.EventBudget{TargetPerPerson: 3000, TotalCap: 8999},
+			wantBand:       nil,
+			wantFeasible:   false,
+			wantWithinBand: boolPtr(false),
+			wantOver:       []domain.OverBudgetMember{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, eventID := newTestBudgetHandler(t, budgetTestMembers)
+
+			plan, err := h.UpdateBudget(context.Background(), eventID, "owner", &tt.budget)
+			if err != nil {
+				t.Fatalf("UpdateBudget() error = %v", err)
+			}
+			if plan.Headcount != 3 || plan.AnsweredCount != 2 {
+				t.Errorf("Headcount = %d, AnsweredCount = %d, 3・2 を期待（未回答・不参加は除く）", plan.Headcount, plan.AnsweredCount)
+			}
+			if !reflect.DeepEqual(plan.PriceBand, tt.wantBand) {
+				t.Errorf("PriceBand = %+v, %+v を期待", plan.PriceBand, tt.wantBand)
+			}
+			if plan.Feasible != tt.wantFeasible {
+				t.Errorf("Feasible = %v, %v を期待", plan.Feasible, tt.wantFeasible)
+			}
+			if !reflect.DeepEqual(plan.TargetWithinBand, tt.wantWithinBand) {
+				t.Errorf("TargetWithinBand = %v, %v を期待", plan.TargetWithinBand, tt.wantWithinBand)
+			}
+			if !reflect.DeepEqual(plan.OverBudgetMembers, tt.wantOver) {
+				t.Errorf("OverBudgetMembers = %+v, %+v を期待", plan.OverBudgetMembers, tt.wantOver)
+			}
+
+			saved, err := h.GetBudgetPlan(context.Background(), eventID, "owner")
+			if err != nil {
+				t.Fatalf("GetBudgetPlan() error = %v", err)
+			}
+			if tt.budget.IsZero() != (saved.Budget == nil) {
+				t.Errorf("Budget = %+v, 未設定の場合のみ nil を期待", saved.Budget)
+			}
+		})
+	}
+}
+
+func TestGetBudgetPlanConflictingRanges(t *testing.T) {
+	h, eventID := newTestBudgetHandler(t, []domain.Member{
+		budgetMember("田中", "attending", 5000, 8000),
+		budgetMember("佐藤", "attending", 2000, 4000),
+	})
+
+	plan, err := h.GetBudgetPlan(context.Background(), eventID, "owner")
+	if err != nil {
+		t.Fatalf("GetBudgetPlan() error = %v", err)
+	}
+	if plan.Feasible || plan.MemberRange != nil || plan.PriceBand != nil {
+		t.Errorf("Feasible = %v, MemberRange = %+v, PriceBand = %+v, 予算の範囲が重ならない場合は false・nil を期待",
+			plan.Feasible, plan.MemberRange, plan.PriceBand)
+	}
+}
+
+func TestUpdateBudgetValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		budget    domain.EventBudget
+		wantField string
+	}{
+		{name: "負の目安", budget: domain.EventBudget{TargetPerPerson: -1000}, wantField: "targetPerPerson"},
+		{name: "1人あたりの目安が大きすぎる", budget: domain.EventBudget{TargetPerPerson: 100001}, wantField: "targetPerPerson"},
+		{name: "会社補助が総額の上限を超える", budget: domain.EventBudget{TotalCap: 10000, Subsidy: 20000}, wantField: "subsidy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, eventID := newTestBudgetHandler(t, budgetTestMembers)
+
+			_, err := h.UpdateBudget(context.Background(), eventID, "owner", &tt.budget)
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("UpdateBudget() error = %v, ValidationError を期待", err)
+			}
+			fields, _ := validationErr.Info.Details["fields"].(validation.Errors)
+			if len(fields) != 1 || fields[0].Field != tt.wantField {
+				t.Errorf("fields = %+v, %s を期待", fields, tt.wantField)
+			}
+		})
+	}
+
+	h, eventID := newTestBudgetHandler(t, budgetTestMembers)
+	if _, err := h.UpdateBudget(context.Background(), eventID, "stranger", &domain.EventBudget{Subsidy: 1000}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("他のユーザーの UpdateBudget() error = %v, ErrForbidden を期待", err)
+	}
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// ListCollaborators はイベントの所有者と共同幹事を返す（共同幹事なら誰でも閲覧可能）
+func (h *EventHandler) ListCollaborators(ctx context.Context, eventID string, userID string) (*domain.CollaboratorsResponse, error) {
+	event, err := h.GetEventFor(ctx, eventID, userID, domain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators := event.Collaborators
+	if collaborators == nil {
+		collaborators = []domain.Collaborator{}
+	}
+	return &domain.CollaboratorsResponse{
+		OwnerID:       event.OrganizerID,
+		Role:          event.RoleOf(userID),
+		Collaborators: collaborators,
+	}, nil
+}
+
+// InviteCollaborator は共同幹事の招待を作成する（所有者のみ）
+// 返却する招待コードを招待リンクとして相手に共有し、相手が AcceptInvite で承諾する
+func (h *EventHandler) InviteCollaborator(ctx context.Context, eventID string, userID string, req *domain.InviteCollaboratorRequest) (*domain.InviteCollaboratorResponse, error) {
+	req.Email = strings.TrimSpace(norm.NFKC.String(req.Email))
+	req.Role = strings.TrimSpace(req.Role)
+	if err := h.validator.Validate(req, i18n.FromContext(ctx)); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, i18n.FromContext(ctx))}
+	}
+
+	event, err := h.GetEventFor(ctx, eventID, userID, domain.PermissionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborator := domain.Collaborator{
+		ID:         h.idGen.NewID("col"),
+		Email:      req.Email,
+		Role:       req.Role,
+		Status:     domain.CollaboratorStatusPending,
+		InviteCode: h.idGen.NewID("inv"),
+		InvitedBy:  userID,
+		InvitedAt:  h.clock.Now(),
+	}
+	event.Collaborators = append(event.Collaborators, collaborator)
+
+	if _, err := h.eventRepo.UpdateEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("共同幹事の招待に失敗しました: %w", err)
+	}
+	return &domain.InviteCollaboratorResponse{Collaborator: &collaborator, InviteCode: collaborator.InviteCode}, nil
+}
+
+// AcceptInvite は招待コードを使って共同幹事になる
+// 招待された本人はまだ権限を持たないため、イベントの権限チェックは行わず招待コードで認可する
+func (h *EventHandler) AcceptInvite(ctx context.Context, eventID string, userID string, req *domain.AcceptInviteRequest) (*domain.Collaborator, error) {
+	req.InviteCode = strings.TrimSpace(req.InviteCode)
+	if err := h.validator.Validate(req, i18n.FromContext(ctx)); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, i18n.FromContext(ctx))}
+	}
+	if !h.isValidEventID(eventID) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEventID, eventID)
+	}
+
+	event, err := h.eventRepo.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("イベントの取得に失敗しました: %w", err)
+	}
+
+	index := -1
+	for i, collaborator := range event.Collaborators {
+		// 招待コードの推測に使われないよう、一致判定は比較時間が一定の関数で行う
+		if collaborator.Status == domain.CollaboratorStatusPending &&
+			subtle.ConstantTimeCompare([]byte(collaborator.InviteCode), []byte(req.InviteCode)) == 1 {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, ErrInvalidInviteCode
+	}
+	if event.RoleOf(userID) != "" {
+		return nil, ErrAlreadyCollaborator
+	}
+
+	now := h.clock.Now()
+	collaborator := &event.Collaborators[index]
+	collaborator.UserID = userID
+	collaborator.Status = domain.CollaboratorStatusAccepted
+	collaborator.InviteCode = ""
+	collaborator.AcceptedAt = &now
+
+	if _, err := h.eventRepo.UpdateEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("招待の承諾に失敗しました: %w", err)
+	}
+	accepted := *collaborator
+	return &accepted, nil
+}
+
+// RevokeCollaborator は共同幹事を解除する（招待中の取り消しを含む）
+// 所有者は誰でも解除でき、共同幹事は自分自身のみ解除（イベントから抜ける）できる
+func (h *EventHandler) RevokeCollaborator(ctx context.Context, eventID string, userID string, collaboratorID string) error {
+	event, err := h.GetEventFor(ctx, eventID, userID, domain.PermissionView)
+	if err != nil {
+		return err
+	}
+
+	index := findCollaborator(event.Collaborators, collaboratorID)
+	if index < 0 {
+		return fmt.Errorf("共同幹事が見つかりません: %s: %w", collaboratorID, repository.ErrNotFound)
+	}
+	isSelf := event.Collaborators[index].UserID == userID
+	if !isSelf && !domain.RoleAllows(event.RoleOf(userID), domain.PermissionManage) {
+		return fmt.Errorf("%w: 他の共同幹事は解除できません", ErrInsufficientPermission)
+	}
+
+	event.Collaborators = append(event.Collaborators[:index], event.Collaborators[index+1:]...)
+	if _, err := h.eventRepo.UpdateEvent(ctx, event); err != nil {
+		return fmt.Errorf("共同幹事の解除に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// TransferOwnership は所有者を承諾済みの共同幹事に移譲する（所有者のみ）
+// 元の所有者が退職・異動した後もイベントを引き継げるようにするための操作で、
+// 元の所有者は編集者（editor）として共同幹事に残る（不要なら新しい所有者が解除する）
+func (h *EventHandler) TransferOwnership(ctx context.Context, eventID string, userID string, req *domain.TransferOwnershipRequest) (*domain.Event, error) {
+	req.CollaboratorID = strings.TrimSpace(req.CollaboratorID)
+	if err := h.validator.Validate(req, i18n.FromContext(ctx)); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, i18n.FromContext(ctx))}
+	}
+
+	event, err := h.GetEventFor(ctx, eventID, userID, domain.PermissionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	index := findCollaborator(event.Collaborators, req.CollaboratorID)
+	if index < 0 {
+		return nil, fmt.Errorf("共同幹事が見つかりません: %s: %w", req.CollaboratorID, repository.ErrNotFound)
+	}
+	newOwner := event.Collaborators[index]
+	if newOwner.Status != domain.CollaboratorStatusAccepted {
+		return nil, ErrCollaboratorNotAccepted
+	}
+
+	now := h.clock.Now()
+	previousOwner := domain.Collaborator{
+		ID:         h.idGen.NewID("col"),
+		UserID:     event.OrganizerID,
+		Role:       domain.RoleEditor,
+		Status:     domain.CollaboratorStatusAccepted,
+		InvitedBy:  newOwner.UserID,
+		InvitedAt:  now,
+		AcceptedAt: &now,
+	}
+	event.Collaborators = append(event.Collaborators[:index], event.Collaborators[index+1:]...)
+	event.Collaborators = append(event.Collaborators, previousOwner)
+	event.OrganizerID = newOwner.UserID
+
+	updated, err := h.eventRepo.UpdateEvent(ctx, event)
+	if err != nil {
+		return nil, fmt.Errorf("所有者の移譲に失敗しました: %w", err)
+	}
+	return updated, nil
+}
+
+// findCollaborator は共同幹事IDに一致する要素の位置を返す（見つからない場合は -1）
+func findCollaborator(collaborators []domain.Collaborator, collaboratorID string) int {
+	for i, collaborator := range collaborators {
+		if collaborator.ID == collaboratorID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// inviteAndAccept は owner が role で招待し、userID が承諾した共同幹事を返す
+func inviteAndAccept(t *testing.T, h *EventHandler, eventID string, role string, userID string) *domain.Collaborator {
+	t.Helper()
+	ctx := context.Background()
+
+	invite, err := h.InviteCollaborator(ctx, eventID, "owner", &domain.InviteCollaboratorRequest{Role: role})
+	if err != nil {
+		t.Fatalf("InviteCollaborator() error = %v", err)
+	}
+	accepted, err := h.AcceptInvite(ctx, eventID, userID, &domain.AcceptInviteRequest{InviteCode: invite.InviteCode})
+	if err != nil {
+		t.Fatalf("AcceptInvite() error = %v", err)
+	}
+	return accepted
+}
+
+func TestGetEventForRoles(t *testing.T) {
+	ctx := context.Background()
+	h, _, seeded := seedEvent(t, nil, nil)
+	eventID := seeded.ID
+
+	inviteAndAccept(t, h, eventID, domain.RoleEditor, "editor-user")
+	inviteAndAccept(t, h, eventID, domain.RoleViewer, "viewer-user")
+	if _, err := h.InviteCollaborator(ctx, eventID, "owner", &domain.InviteCollaboratorRequest{Role: domain.RoleEditor, Email: "pending@example.com"}); err != nil {
+		t.Fatalf("InviteCollaborator() error = %v", err)
+	}
+
+	tests := []struct {
+		userID     string
+		permission domain.Permission
+		wantErr    error
+	}{
+		{userID: "owner", permission: domain.PermissionManage},
+		{userID: "editor-user", permission: domain.PermissionEdit},
+		{userID: "editor-user", permission: domain.PermissionManage, wantErr: ErrInsufficientPermission},
+		{userID: "viewer-user", permission: domain.PermissionView},
+		{userID: "viewer-user", permission: domain.PermissionEdit, wantErr: ErrInsufficientPermission},
+		{userID: "someone-else", permission: domain.PermissionView, wantErr: ErrForbidden},
+		{userID: "", permission: domain.PermissionView, wantErr: ErrForbidden},
+	}
+	for _, tt := range tests {
+		_, err := h.GetEventFor(ctx, eventID, tt.userID, tt.permission)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("GetEventFor(%q, %d) error = %v, %v を期待", tt.userID, tt.permission, err, tt.wantErr)
+		}
+	}
+
+	// 招待コードはイベントのレスポンスに含めない
+	event, _ := h.GetEvent(ctx, eventID, "owner")
+	body, _ := json.Marshal(event)
+	if strings.Contains(string(body), "inv_") {
+		t.Errorf("イベントのJSONに招待コードが含まれています: %s", body)
+	}
+}
+
+func TestAcceptInvite(t *testing.T) {
+	ctx := context.Background()
+	h, _, seeded := seedEvent(t, nil, nil)
+	eventID := seeded.ID
+
+	invite, err := h.InviteCollaborator(ctx, eventID, "owner", &domain.InviteCollaboratorRequest{Role: domain.RoleViewer})
+	if err != nil {
+		t.Fatalf("InviteCollaborator() error = %v", err)
+	}
+	if invite.Collaborator.Status != domain.CollaboratorStatusPending || invite.InviteCode == "" {
+		t.Fatalf("未承諾の招待を期待: %+v", invite)
+	}
+
+	tests := []struct {
+		name    string
+		userID  string
+		code    string
+		wantErr error
+	}{
+		{name: "招待コードが一致しない", userID: "guest", code: "inv_ffffffffffffffffffffffffffffffff", wantErr: ErrInvalidInviteCode},
+		{name: "所有者は承諾できない", userID: "owner", code: invite.InviteCode, wantErr: ErrAlreadyCollaborator},
+		{name: "承諾", userID: "guest", code: invite.InviteCode},
+		{name: "使用済みの招待コード", userID: "another", code: invite.InviteCode, wantErr: ErrInvalidInviteCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.AcceptInvite(ctx, eventID, tt.userID, &domain.AcceptInviteRequest{InviteCode: tt.code})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AcceptInvite() error = %v, %v を期待", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := h.InviteCollaborator(ctx, eventID, "guest", &domain.InviteCollaboratorRequest{Role: domain.RoleViewer}); !errors.Is(err, ErrInsufficientPermission) {
+		t.Errorf("閲覧者による招待 error = %v, ErrInsufficientPermission を期待", err)
+	}
+}
+
+func TestRevokeCollaborator(t *testing.T) {
+	ctx := context.Background()
+	h, _, seeded := seedEvent(t, nil, nil)
+	eventID := seeded.ID
+
+	editor := inviteAndAccept(t, h, eventID, domain.RoleEditor, "editor-user")
+	viewer := inviteAndAccept(t, h, eventID, domain.RoleViewer, "viewer-user")
+
+	if err := h.RevokeCollaborator(ctx, eventID, "editor-user", viewer.ID); !errors.Is(err, ErrInsufficientPermission) {
+		t.Errorf("編集者による他の共同幹事の解除 error = %v, ErrInsufficientPermission を期待", err)
+	}
+	if err := h.RevokeCollaborator(ctx, eventID, "viewer-user", viewer.ID); err != nil {
+		t.Errorf("自分自身の解除 error = %v", err)
+	}
+	if err := h.RevokeCollaborator(ctx, eventID, "owner", editor.ID); err != nil {
+		t.Errorf("所有者による解除 error = %v", err)
+	}
+	if _, err := h.GetEvent(ctx, eventID, "editor-user"); !errors.Is(err, ErrForbidden) {
+		t.Errorf("解除後の GetEvent() error = %v, ErrForbidden を期待", err)
+	}
+
+	list, err := h.ListCollaborators(ctx, eventID, "owner")
+	if err != nil || len(list.Collaborators) != 0 || list.Role != domain.RoleOwner {
+		t.Errorf("ListCollaborators() = %+v, error = %v, 共同幹事なしを期待", list, err)
+	}
+}
+
+func TestTransferOwnership(t *testing.T) {
+	ctx := context.Background()
+	h, _, seeded := seedEvent(t, nil, nil)
+	eventID := seeded.ID
+
+	successor := inviteAndAccept(t, h, eventID, domain.RoleEditor, "successor")
+	pending, _ := h.InviteCollaborator(ctx, eventID, "owner", &domain.InviteCollaboratorRequest{Role: domain.RoleEditor})
+
+	errorTests := []struct {
+		name           string
+		userID         string
+		collaboratorID string
+		wantErr        error
+	}{
+		{name: "未承諾の共同幹事", userID: "owner", collaboratorID: pending.Collaborator.ID, wantErr: ErrCollaboratorNotAccepted},
+		{name: "存在しない共同幹事", userID: "owner", collaboratorID: "col_ffffffffffffffffffffffffffffffff", wantErr: repository.ErrNotFound},
+		{name: "編集者は移譲できない", userID: "successor", collaboratorID: successor.ID, wantErr: ErrInsufficientPermission},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.TransferOwnership(ctx, eventID, tt.userID, &domain.TransferOwnershipRequest{CollaboratorID: tt.collaboratorID})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("TransferOwnership() error = %v, %v を期待", err, tt.wantErr)
+			}
+		})
+	}
+
+	event, err := h.TransferOwnership(ctx, eventID, "owner", &domain.TransferOwnershipRequest{CollaboratorID: successor.ID})
+	if err != nil {
+		t.Fatalf("TransferOwnership() error = %v", err)
+	}
+	if event.OrganizerID != "successor" {
+		t.Errorf("OrganizerID = %q, successor を期待", event.OrganizerID)
+	}
+	if role := event.RoleOf("owner"); role != domain.RoleEditor {
+		t.Errorf("元の所有者のロール = %q, editor を期待", role)
+	}
+	if _, err := h.GetEventFor(ctx, eventID, "owner", domain.PermissionManage); !errors.Is(err, ErrInsufficientPermission) {
+		t.Errorf("元の所有者の管理操作 error = %v, ErrInsufficientPermission を期待", err)
+	}
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// ハンドラーが返す業務エラー
+// 呼び出し側は errors.Is で判定し、HTTPステータスに変換する
+var (
+	// ErrInvalidEventID はイベントIDの形式が不正であることを表す
+	ErrInvalidEventID = errors.New("無効なイベントIDです")
+
+	// ErrInvalidTemplateID はテンプレートIDの形式が不正であることを表す
+	ErrInvalidTemplateID = errors.New("無効なテンプレートIDです")
+
+	// ErrInvalidSeriesID はシリーズIDの形式が不正であることを表す
+	ErrInvalidSeriesID = errors.New("無効なシリーズIDです")
+
+	// ErrInvalidRecordID は開催記録IDの形式が不正であることを表す
+	ErrInvalidRecordID = errors.New("無効な記録IDです")
+
+	// ErrNotInSeries は指定したイベントがシリーズの開催回ではないことを表す
+	ErrNotInSeries = errors.New("指定したイベントはこのシリーズの開催回ではありません")
+
+	// ErrForbidden は操作対象のリソースにアクセスする権限がないことを表す
+	ErrForbidden = errors.New("このイベントにアクセスする権限がありません")
+
+	// ErrInsufficientPermission は共同幹事のロールでは許可されていない操作であることを表す
+	// （閲覧者による編集など。イベントの存在は相手に知られているため ErrForbidden と区別する）
+	ErrInsufficientPermission = errors.New("この操作を行う権限がありません")
+
+	// ErrInvalidInviteCode は招待コードが一致しない、または使用済みであることを表す
+	ErrInvalidInviteCode = errors.New("招待コードが無効です")
+
+	// ErrAlreadyCollaborator は招待を承諾しようとしたユーザーが既に所有者・共同幹事であることを表す
+	ErrAlreadyCollaborator = errors.New("既にこのイベントの幹事です")
+
+	// ErrNoRestaurantSearch はお店の推薦に使う検索結果がまだないことを表す
+	ErrNoRestaurantSearch = errors.New("レストランがまだ検索されていません")
+
+	// ErrNoVenue は予約に必要な会場がまだ決定されていないことを表す
+	ErrNoVenue = errors.New("会場がまだ決定されていません")
+
+	// ErrReservationExists は予約完了が既に報告済みであることを表す（変更は更新で行う）
+	ErrReservationExists = errors.New("予約完了は既に報告済みです")
+
+	// ErrNoReservation は更新対象の予約がまだ報告されていないことを表す
+	ErrNoReservation = errors.New("予約完了がまだ報告されていません")
+
+	// ErrEventNotCompleted は完了していないイベントの記録を作成しようとしたことを表す
+	ErrEventNotCompleted = errors.New("完了したイベントのみ記録できます")
+
+	// ErrRecordExists は同じイベントの記録を既に作成済みであることを表す（変更は更新で行う）
+	ErrRecordExists = errors.New("このイベントの記録は作成済みです")
+
+	// ErrRecordUnderReview は通報による審査待ちの記録を操作しようとしたことを表す
+	ErrRecordUnderReview = errors.New("この記録は審査中のため共有できません")
+
+	// ErrOwnRecord は自分の共有記録にいいね・不適切報告しようとしたことを表す
+	ErrOwnRecord = errors.New("自分の記録にはいいね・不適切報告できません")
+
+	// ErrNoAttendees は精算の対象になる参加メンバーがいないことを表す
+	ErrNoAttendees = errors.New("参加と回答したメンバーがいません")
+
+	// ErrNoSettlement は支払い状況の更新・催促の対象の精算がまだ作成されていないことを表す
+	ErrNoSettlement = errors.New("精算がまだ作成されていません")
+
+	// ErrCollaboratorNotAccepted は未承諾の共同幹事を所有者にしようとしたことを表す
+	ErrCollaboratorNotAccepted = errors.New("招待を承諾していない共同幹事には所有者を移譲できません")
+)
+
+// ValidationError はリクエストの入力値エラー
+// Info はAPIのエラー情報（VALIDATION_ERROR・フィールド別のエラー）に変換済みで、
+// 呼び出し側は errors.As で取り出して400レスポンスにそのまま使う
+type ValidationError struct {
+	Info *domain.ErrorInfo
+}
+
+// Error はエラーメッセージを返す
+func (e *ValidationError) Error() string {
+	return e.Info.Message
+}
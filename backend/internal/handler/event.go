@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
-	"time"
 
-	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
 
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
 	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
 	"github.com/luck-tech/kanji-log/backend/internal/repository"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
 )
 
 // EventHandler はイベント関連のビジネスロジックを処理
@@ -19,32 +22,45 @@ import (
 type EventHandler struct {
 	// eventRepo はイベントデータの永続化を担当
 	eventRepo repository.EventRepository
+
+	// clock は過去日チェックなどで使用する現在時刻の取得元
+	clock clock.Clock
+
+	// idGen はイベントIDの生成元
+	idGen idgen.Generator
+
+	// validator はリクエスト構造体の validate タグを検証する
+	validator *validation.Validator
 }
 
 // NewEventHandler は新しいEventHandlerインスタンスを作成
-func NewEventHandler(eventRepo repository.EventRepository) *EventHandler {
+// opts を省略した場合はシステム時刻とUUIDによるID生成を使用する
+func NewEventHandler(eventRepo repository.EventRepository, opts ...Option) *EventHandler {
+	o := newOptions(opts)
 	return &EventHandler{
 		eventRepo: eventRepo,
+		clock:     o.clock,
+		idGen:     o.idGen,
+		validator: newValidator(o.clock),
 	}
 }
 
 // CreateEvent はイベント作成のビジネスロジックを処理
 // リクエスト検証 → ドメインオブジェクト生成 → 永続化 → レスポンス生成
 func (h *EventHandler) CreateEvent(ctx context.Context, req *domain.CreateEventRequest, organizerID string) (*domain.CreateEventResponse, error) {
-	// 1. 入力値バリデーション
-	if err := h.validateCreateEventRequest(req); err != nil {
+	// 1. 入力値の正規化とバリデーション
+	// 正規化後の値がそのまま保存される（前後の空白は除去済み）
+	h.normalizeCreateEventRequest(req)
+	if err := h.validateCreateEventRequest(ctx, req); err != nil {
 		return &domain.CreateEventResponse{
 			Success: false,
-			Error: &domain.ErrorInfo{
-				Code:    "VALIDATION_ERROR",
-				Message: err.Error(),
-			},
+			Error:   newValidationErrorInfo(err, i18n.FromContext(ctx)),
 		}, nil
 	}
 
 	// 2. 一意なイベントIDを生成
 	// 形式: "evt_" + UUID（ハイフンなし）
-	eventID := fmt.Sprintf("evt_%s", strings.ReplaceAll(uuid.New().String(), "-", ""))
+	eventID := h.idGen.NewID("evt")
 
 	// 3. ドメインオブジェクトを構築
 	event := &domain.Event{
@@ -58,13 +74,10 @@ func (h *EventHandler) CreateEvent(ctx context.Context, req *domain.CreateEventR
 		Members:       []domain.Member{}, // 空配列で初期化
 		Notes:         req.Notes,
 		HasScheduling: req.HasScheduling,
+		Budget:        req.Budget,
 		// CreatedAt, UpdatedAtはリポジトリ層で設定
 	}
 
-	// デバッグログ: 生成されたイベント構造体を出力
-	fmt.Printf("DEBUG: Generated Event: ID=%s, Title=%s, OrganizerID=%s\n", 
-		event.ID, event.Title, event.OrganizerID)
-
 	// 4. データベースに保存
 	createdEvent, err := h.eventRepo.CreateEvent(ctx, event)
 	if err != nil {
@@ -87,48 +100,37 @@ func (h *EventHandler) CreateEvent(ctx context.Context, req *domain.CreateEventR
 	}, nil
 }
 
-// validateCreateEventRequest はイベント作成リクエストのバリデーション
-func (h *EventHandler) validateCreateEventRequest(req *domain.CreateEventRequest) error {
-	// タイトルの必須チェック
-	if strings.TrimSpace(req.Title) == "" {
-		return fmt.Errorf("イベントタイトルは必須です")
-	}
-
-	// タイトルの長さチェック
-	if len(req.Title) > 100 {
-		return fmt.Errorf("イベントタイトルは100文字以内で入力してください")
-	}
-
-	// 目的の有効性チェック
-	if req.Purpose != "" && !h.isValidPurpose(req.Purpose) {
-		return fmt.Errorf("無効なイベント目的です: %s", req.Purpose)
-	}
-
-	// 日付形式チェック（YYYY-MM-DD）
-	if req.Date != "" {
-		if err := h.validateDateFormat(req.Date); err != nil {
-			return fmt.Errorf("日付の形式が正しくありません: %w", err)
-		}
-
-		// 過去日チェック
-		if err := h.validateNotPastDate(req.Date); err != nil {
-			return fmt.Errorf("過去の日付は指定できません: %w", err)
-		}
-	}
-
-	// 時刻形式チェック（HH:MM）
-	if req.Time != "" {
-		if err := h.validateTimeFormat(req.Time); err != nil {
-			return fmt.Errorf("時刻の形式が正しくありません: %w", err)
-		}
+// normalizeCreateEventRequest はイベント作成リクエストの入力値を正規化
+// スマートフォンの日本語入力では全角数字・全角空白が混入しやすいため、
+// バリデーション前にNFKC正規化で半角に揃え、前後の空白を除去する
+//
+// 例:
+//   "　新人歓迎会　" → "新人歓迎会"（全角空白の除去）
+//   "２０２５－０９－３０" → "2025-09-30"（全角数字・記号の半角化）
+//   "１９：００" → "19:00"
+//
+// 備考は利用者が自由に書く文章のため、NFKC（①→1 などの変換）は適用せず前後の空白除去のみ行う
+func (h *EventHandler) normalizeCreateEventRequest(req *domain.CreateEventRequest) {
+	req.Title = strings.TrimSpace(norm.NFKC.String(req.Title))
+	req.Purpose = strings.TrimSpace(norm.NFKC.String(req.Purpose))
+	req.Date = strings.TrimSpace(norm.NFKC.String(req.Date))
+	req.Time = strings.TrimSpace(norm.NFKC.String(req.Time))
+	req.Notes = strings.TrimSpace(req.Notes)
+	if req.Budget != nil && req.Budget.IsZero() {
+		req.Budget = nil
 	}
+}
 
-	// 備考の長さチェック
-	if len(req.Notes) > 1000 {
-		return fmt.Errorf("備考は1000文字以内で入力してください")
+// validateCreateEventRequest はイベント作成リクエストのバリデーション
+// domain.CreateEventRequest の validate タグに従い、全フィールドのエラーをまとめて返す
+// 文字数はバイト数ではなくUnicodeの文字数（rune数）で数える
+// エラーメッセージは ctx に格納された言語（i18n.WithLanguage）で生成する
+func (h *EventHandler) validateCreateEventRequest(ctx context.Context, req *domain.CreateEventRequest) error {
+	lang := i18n.FromContext(ctx)
+	if err := h.validator.Validate(req, lang); err != nil {
+		return err
 	}
-
-	return nil
+	return budgetConflict(req.Budget, "budget.", lang)
 }
 
 // getDefaultPurpose は目的が未設定の場合にデフォルト値を返す
@@ -139,73 +141,21 @@ func (h *EventHandler) getDefaultPurpose(purpose string) string {
 	return purpose
 }
 
-// isValidPurpose は指定された目的が有効かチェック
-func (h *EventHandler) isValidPurpose(purpose string) bool {
-	for _, validPurpose := range domain.ValidEventPurposes {
-		if purpose == validPurpose {
-			return true
-		}
-	}
-	return false
-}
-
-// validateDateFormat は日付形式（YYYY-MM-DD）をチェック
-func (h *EventHandler) validateDateFormat(date string) error {
-	// 正規表現による形式チェック
-	dateRegex := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
-	if !dateRegex.MatchString(date) {
-		return fmt.Errorf("日付はYYYY-MM-DD形式で入力してください")
-	}
-
-	// time.Parseによる有効性チェック
-	_, err := time.Parse("2006-01-02", date)
-	if err != nil {
-		return fmt.Errorf("無効な日付です: %s", date)
-	}
-
-	return nil
-}
-
-// validateNotPastDate は過去日でないことをチェック
-func (h *EventHandler) validateNotPastDate(date string) error {
-	inputDate, err := time.Parse("2006-01-02", date)
-	if err != nil {
-		return err
-	}
-
-	// 今日の日付と比較（時刻は無視）
-	today := time.Now().UTC().Truncate(24 * time.Hour)
-	inputDateTruncated := inputDate.Truncate(24 * time.Hour)
-
-	if inputDateTruncated.Before(today) {
-		return fmt.Errorf("過去の日付は指定できません: %s", date)
-	}
-
-	return nil
-}
-
-// validateTimeFormat は時刻形式（HH:MM）をチェック
-func (h *EventHandler) validateTimeFormat(timeStr string) error {
-	// 正規表現による形式チェック
-	timeRegex := regexp.MustCompile(`^\d{2}:\d{2}$`)
-	if !timeRegex.MatchString(timeStr) {
-		return fmt.Errorf("時刻はHH:MM形式で入力してください")
-	}
-
-	// time.Parseによる有効性チェック
-	_, err := time.Parse("15:04", timeStr)
-	if err != nil {
-		return fmt.Errorf("無効な時刻です: %s", timeStr)
-	}
-
-	return nil
-}
-
 // GetEvent はイベント詳細取得のビジネスロジックを処理
+// 所有者と承諾済みの共同幹事（ロールを問わない）が取得できる
 func (h *EventHandler) GetEvent(ctx context.Context, eventID string, organizerID string) (*domain.Event, error) {
+	return h.GetEventFor(ctx, eventID, organizerID, domain.PermissionView)
+}
+
+// GetEventFor は permission の操作を行う権限を確認してイベントを取得する
+// イベントを扱うハンドラーはすべてこのメソッドでイベントを取得する
+//
+//   - ロールを持たないユーザー: ErrForbidden（APIでは存在を明かさず404）
+//   - ロールはあるが操作が許可されていない: ErrInsufficientPermission（APIでは403）
+func (h *EventHandler) GetEventFor(ctx context.Context, eventID string, userID string, permission domain.Permission) (*domain.Event, error) {
 	// 1. イベントIDの形式チェック
 	if !h.isValidEventID(eventID) {
-		return nil, fmt.Errorf("無効なイベントIDです: %s", eventID)
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEventID, eventID)
 	}
 
 	// 2. データベースからイベントを取得
@@ -214,9 +164,13 @@ func (h *EventHandler) GetEvent(ctx context.Context, eventID string, organizerID
 		return nil, fmt.Errorf("イベントの取得に失敗しました: %w", err)
 	}
 
-	// 3. 権限チェック：イベントの作成者のみアクセス可能
-	if event.OrganizerID != organizerID {
-		return nil, fmt.Errorf("このイベントにアクセスする権限がありません")
+	// 3. 権限チェック：所有者・共同幹事のロールで判定
+	role := event.RoleOf(userID)
+	if role == "" {
+		return nil, ErrForbidden
+	}
+	if !domain.RoleAllows(role, permission) {
+		return nil, fmt.Errorf("%w: role=%s", ErrInsufficientPermission, role)
 	}
 
 	return event, nil
@@ -0,0 +1,470 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// testNow はテスト全体で使用する固定時刻（2025-09-10 09:00 UTC）
+var testNow = time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC)
+
+// newTestEventHandler は固定時刻・連番IDとインメモリリポジトリを使用したEventHandlerを作成
+func newTestEventHandler(t *testing.T) (*EventHandler, *repository.MemoryEventRepository) {
+	t.Helper()
+	fixed := clock.NewFixedClock(testNow)
+	repo := repository.NewMemoryEventRepository(repository.WithClock(fixed))
+	h := NewEventHandler(repo,
+		WithClock(fixed),
+		WithIDGenerator(idgen.NewSequenceGenerator()),
+	)
+	return h, repo
+}
+
+// seedEvent は newTestEventHandler の EventHandler で owner のイベントを作成し、
+// members と mutate による変更（nil の場合は変更なし）を保存したイベントを返す
+func seedEvent(t *testing.T, members []domain.Member, mutate func(*domain.Event)) (*EventHandler, *repository.MemoryEventRepository, *domain.Event) {
+	t.Helper()
+	ctx := context.Background()
+	h, repo := newTestEventHandler(t)
+
+	created, err := h.CreateEvent(ctx, &domain.CreateEventRequest{Title: "新人歓迎会"}, "owner")
+	if err != nil {
+		t.Fatalf("テスト用イベントの作成に失敗: %v", err)
+	}
+	if !created.Success {
+		t.Fatalf("テスト用イベントの作成に失敗: %+v", created.Error)
+	}
+	event := created.Data
+	if members == nil && mutate == nil {
+		return h, repo, event
+	}
+
+	if members != nil {
+		event.Members = members
+	}
+	if mutate != nil {
+		mutate(event)
+	}
+	if _, err := repo.UpdateEvent(ctx, event); err != nil {
+		t.Fatalf("テスト用イベントの更新に失敗: %v", err)
+	}
+	return h, repo, event
+}
+
+func TestValidateCreateEventRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     domain.CreateEventRequest
+		wantErr string
+	}{
+		{
+			name: "タイトルのみの最小リクエスト",
+			req:  domain.CreateEventRequest{Title: "新人歓迎会"},
+		},
+		{
+			name: "全項目を指定",
+			req: domain.CreateEventRequest{
+				Title:         "新人歓迎会",
+				Purpose:       "welcome",
+				Date:          "2025-09-30",
+				Time:          "19:00",
+				Notes:         "みんなで楽しく歓迎しましょう！",
+				HasScheduling: true,
+			},
+		},
+		{
+			name:    "タイトルが空",
+			req:     domain.CreateEventRequest{Title: ""},
+			wantErr: "イベントタイトルは必須です",
+		},
+		{
+			name:    "タイトルが空白のみ",
+			req:     domain.CreateEventRequest{Title: "   "},
+			wantErr: "イベントタイトルは必須です",
+		},
+		{
+			name: "タイトルが100文字（境界値）",
+			req:  domain.CreateEventRequest{Title: strings.Repeat("a", 100)},
+		},
+		{
+			name:    "タイトルが101文字",
+			req:     domain.CreateEventRequest{Title: strings.Repeat("a", 101)},
+			wantErr: "イベントタイトルは100文字以内で入力してください",
+		},
+		{
+			name: "日本語タイトルが100文字（境界値）",
+			req:  domain.CreateEventRequest{Title: strings.Repeat("歓", 100)},
+		},
+		{
+			name:    "日本語タイトルが101文字",
+			req:     domain.CreateEventRequest{Title: strings.Repeat("歓", 101)},
+			wantErr: "イベントタイトルは100文字以内で入力してください",
+		},
+		{
+			name: "絵文字を含むタイトルが100文字（境界値）",
+			req:  domain.CreateEventRequest{Title: strings.Repeat("🍺", 100)},
+		},
+		{
+			name:    "無効な目的",
+			req:     domain.CreateEventRequest{Title: "飲み会", Purpose: "party"},
+			wantErr: "無効なイベント目的です: party",
+		},
+		{
+			name: "当日の日付",
+			req:  domain.CreateEventRequest{Title: "飲み会", Date: "2025-09-10"},
+		},
+		{
+			name:    "過去の日付",
+			req:     domain.CreateEventRequest{Title: "飲み会", Date: "2025-09-09"},
+			wantErr: "過去の日付は指定できません",
+		},
+		{
+			name:    "存在しない日付（2月30日）",
+			req:     domain.CreateEventRequest{Title: "飲み会", Date: "2024-02-30"},
+			wantErr: "日付の形式が正しくありません",
+		},
+		{
+			name:    "存在しない月",
+			req:     domain.CreateEventRequest{Title: "飲み会", Date: "2025-13-01"},
+			wantErr: "日付の形式が正しくありません",
+		},
+		{
+			name:    "スラッシュ区切りの日付",
+			req:     domain.CreateEventRequest{Title: "飲み会", Date: "2025/09/30"},
+			wantErr: "日付の形式が正しくありません",
+		},
+		{
+			name:    "ゼロ埋めされていない時刻",
+			req:     domain.CreateEventRequest{Title: "飲み会", Time: "9:00"},
+			wantErr: "時刻の形式が正しくありません",
+		},
+		{
+			name:    "24時以降の時刻",
+			req:     domain.CreateEventRequest{Title: "飲み会", Time: "25:00"},
+			wantErr: "時刻の形式が正しくありません",
+		},
+		{
+			name:    "60分以降の時刻",
+			req:     domain.CreateEventRequest{Title: "飲み会", Time: "19:60"},
+			wantErr: "時刻の形式が正しくありません",
+		},
+		{
+			name:    "秒付きの時刻",
+			req:     domain.CreateEventRequest{Title: "飲み会", Time: "19:00:00"},
+			wantErr: "時刻の形式が正しくありません",
+		},
+		{
+			name: "備考が1000文字（境界値）",
+			req:  domain.CreateEventRequest{Title: "飲み会", Notes: strings.Repeat("a", 1000)},
+		},
+		{
+			name: "日本語の備考が1000文字（境界値）",
+			req:  domain.CreateEventRequest{Title: "飲み会", Notes: strings.Repeat("あ", 1000)},
+		},
+		{
+			name:    "日本語の備考が1001文字",
+			req:     domain.CreateEventRequest{Title: "飲み会", Notes: strings.Repeat("あ", 1001)},
+			wantErr: "備考は1000文字以内で入力してください",
+		},
+		{
+			name:    "備考が1001文字",
+			req:     domain.CreateEventRequest{Title: "飲み会", Notes: strings.Repeat("a", 1001)},
+			wantErr: "備考は1000文字以内で入力してください",
+		},
+		{
+			name: "予算を指定",
+			req: domain.CreateEventRequest{Title: "飲み会",
+				Budget: &domain.EventBudget{TargetPerPerson: 5000, TotalCap: 60000, Subsidy: 20000}},
+		},
+		{
+			name:    "負の1人あたりの目安",
+			req:     domain.CreateEventRequest{Title: "飲み会", Budget: &domain.EventBudget{TargetPerPerson: -1}},
+			wantErr: "1人あたりの目安は1以上で入力してください",
+		},
+		{
+			name:    "会社補助が総額の上限を超える",
+			req:     domain.CreateEventRequest{Title: "飲み会", Budget: &domain.EventBudget{TotalCap: 30000, Subsidy: 30001}},
+			wantErr: "会社補助は総額の上限以下にしてください",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _ := newTestEventHandler(t)
+			err := h.validateCreateEventRequest(context.Background(), &tt.req)
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("エラーを期待しませんでしたが、エラーが返されました: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("エラー %q を期待しましたが、nilが返されました", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("エラーメッセージ = %q, %q を含むことを期待", err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateEvent(t *testing.T) {
+	h, repo := newTestEventHandler(t)
+	ctx := context.Background()
+
+	resp, err := h.CreateEvent(ctx, &domain.CreateEventRequest{
+		Title: "新人歓迎会",
+		Date:  "2025-09-30",
+		Time:  "19:00",
+	}, "organizer-1")
+	if err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("CreateEvent() success = false, error = %+v", resp.Error)
+	}
+
+	got := resp.Data
+	if got.ID != "evt_00000000000000000000000000000001" {
+		t.Errorf("ID = %q", got.ID)
+	}
+	if got.Purpose != "other" {
+		t.Errorf("Purpose = %q, デフォルト値 other を期待", got.Purpose)
+	}
+	if got.Status != "planning" {
+		t.Errorf("Status = %q, planning を期待", got.Status)
+	}
+	if got.OrganizerID != "organizer-1" {
+		t.Errorf("OrganizerID = %q", got.OrganizerID)
+	}
+	if !got.CreatedAt.Equal(testNow) || !got.UpdatedAt.Equal(testNow) {
+		t.Errorf("CreatedAt = %v, UpdatedAt = %v, %v を期待", got.CreatedAt, got.UpdatedAt, testNow)
+	}
+
+	stored, err := repo.GetEvent(ctx, got.ID)
+	if err != nil {
+		t.Fatalf("保存されたイベントの取得に失敗: %v", err)
+	}
+	if stored.Title != "新人歓迎会" {
+		t.Errorf("保存されたTitle = %q", stored.Title)
+	}
+}
+
+func TestCreateEventNormalizesInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       domain.CreateEventRequest
+		wantTitle string
+		wantDate  string
+		wantTime  string
+		wantNotes string
+	}{
+		{
+			name:      "前後の全角空白を除去",
+			req:       domain.CreateEventRequest{Title: "　新人歓迎会　"},
+			wantTitle: "新人歓迎会",
+		},
+		{
+			name:      "全角数字の日付と時刻を半角化",
+			req:       domain.CreateEventRequest{Title: "送別会", Date: "２０２５－０９－３０", Time: "１９：００"},
+			wantTitle: "送別会",
+			wantDate:  "2025-09-30",
+			wantTime:  "19:00",
+		},
+		{
+			name:      "全角英数字のタイトルを半角化",
+			req:       domain.CreateEventRequest{Title: "Ｑ３ 打ち上げ"},
+			wantTitle: "Q3 打ち上げ",
+		},
+		{
+			name:      "備考は前後の空白のみ除去",
+			req:       domain.CreateEventRequest{Title: "飲み会", Notes: "\n　①乾杯 ②自己紹介　\n"},
+			wantTitle: "飲み会",
+			wantNotes: "①乾杯 ②自己紹介",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _ := newTestEventHandler(t)
+			resp, err := h.CreateEvent(context.Background(), &tt.req, "organizer-1")
+			if err != nil {
+				t.Fatalf("CreateEvent() error = %v", err)
+			}
+			if !resp.Success {
+				t.Fatalf("CreateEvent() success = false, error = %+v", resp.Error)
+			}
+			got := resp.Data
+			if got.Title != tt.wantTitle || got.Date != tt.wantDate || got.Time != tt.wantTime || got.Notes != tt.wantNotes {
+				t.Errorf("正規化結果 = {Title:%q Date:%q Time:%q Notes:%q}, {Title:%q Date:%q Time:%q Notes:%q} を期待",
+					got.Title, got.Date, got.Time, got.Notes, tt.wantTitle, tt.wantDate, tt.wantTime, tt.wantNotes)
+			}
+		})
+	}
+}
+
+func TestCreateEventRejectsWhitespaceOnlyTitle(t *testing.T) {
+	h, _ := newTestEventHandler(t)
+	resp, err := h.CreateEvent(context.Background(), &domain.CreateEventRequest{Title: "　　　"}, "organizer-1")
+	if err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+	if resp.Success || resp.Error.Code != "VALIDATION_ERROR" {
+		t.Errorf("全角空白のみのタイトルで VALIDATION_ERROR を期待: %+v", resp)
+	}
+}
+
+func TestCreateEventValidationError(t *testing.T) {
+	h, repo := newTestEventHandler(t)
+	ctx := context.Background()
+
+	resp, err := h.CreateEvent(ctx, &domain.CreateEventRequest{Title: "", Purpose: "party", Time: "25:00"}, "organizer-1")
+	if err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+	if resp.Success {
+		t.Fatal("CreateEvent() success = true, バリデーションエラーを期待")
+	}
+	if resp.Error.Code != "VALIDATION_ERROR" {
+		t.Errorf("Error.Code = %q, VALIDATION_ERROR を期待", resp.Error.Code)
+	}
+
+	// 最初のエラーで止まらず、全フィールドのエラーが details.fields に含まれること
+	fields, ok := resp.Error.Details["fields"].(validation.Errors)
+	if !ok {
+		t.Fatalf("details.fields = %#v, validation.Errors を期待", resp.Error.Details["fields"])
+	}
+	var gotFields []string
+	for _, fe := range fields {
+		gotFields = append(gotFields, fe.Field)
+	}
+	if want := []string{"title", "purpose", "time"}; strings.Join(gotFields, ",") != strings.Join(want, ",") {
+		t.Errorf("エラーのフィールド = %v, %v を期待", gotFields, want)
+	}
+	if resp.Error.Message != "入力内容に3件の誤りがあります" {
+		t.Errorf("Error.Message = %q", resp.Error.Message)
+	}
+
+	events, _ := repo.ListEventsByOrganizer(ctx, "organizer-1", map[string]interface{}{})
+	if len(events) != 0 {
+		t.Errorf("バリデーションエラー時にイベントが保存されました: %d件", len(events))
+	}
+}
+
+func TestCreateEventValidationErrorLanguage(t *testing.T) {
+	tests := []struct {
+		name        string
+		lang        i18n.Language
+		wantMessage string
+	}{
+		{name: "日本語", lang: i18n.Japanese, wantMessage: "イベントタイトルは100文字以内で入力してください"},
+		{name: "英語", lang: i18n.English, wantMessage: "Event title must be at most 100 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _ := newTestEventHandler(t)
+			ctx := i18n.WithLanguage(context.Background(), tt.lang)
+
+			resp, err := h.CreateEvent(ctx, &domain.CreateEventRequest{Title: strings.Repeat("歓", 101)}, "organizer-1")
+			if err != nil {
+				t.Fatalf("CreateEvent() error = %v", err)
+			}
+			if resp.Error == nil || resp.Error.Message != tt.wantMessage {
+				t.Fatalf("Error = %+v, message %q を期待", resp.Error, tt.wantMessage)
+			}
+
+			fields := resp.Error.Details["fields"].(validation.Errors)
+			want := validation.FieldError{
+				Field:   "title",
+				Code:    validation.CodeTooLong,
+				Message: tt.wantMessage,
+				Params:  map[string]interface{}{"max": int64(100)},
+			}
+			if len(fields) != 1 || !reflect.DeepEqual(fields[0], want) {
+				t.Errorf("details.fields = %+v, %+v を期待", fields, want)
+			}
+		})
+	}
+}
+
+func TestGetEvent(t *testing.T) {
+	h, _ := newTestEventHandler(t)
+	ctx := context.Background()
+
+	created, err := h.CreateEvent(ctx, &domain.CreateEventRequest{Title: "送別会"}, "owner")
+	if err != nil || !created.Success {
+		t.Fatalf("テスト用イベントの作成に失敗: %v, %+v", err, created.Error)
+	}
+	eventID := created.Data.ID
+
+	tests := []struct {
+		name        string
+		eventID     string
+		organizerID string
+		wantErr     error
+	}{
+		{name: "作成者は取得できる", eventID: eventID, organizerID: "owner"},
+		{name: "他の幹事は取得できない", eventID: eventID, organizerID: "someone-else", wantErr: ErrForbidden},
+		{name: "幹事IDが空の場合は取得できない", eventID: eventID, organizerID: "", wantErr: ErrForbidden},
+		{name: "形式が不正なID", eventID: "evt_123", organizerID: "owner", wantErr: ErrInvalidEventID},
+		{name: "大文字を含むID", eventID: strings.ToUpper(eventID), organizerID: "owner", wantErr: ErrInvalidEventID},
+		{name: "存在しないID", eventID: "evt_ffffffffffffffffffffffffffffffff", organizerID: "owner", wantErr: repository.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := h.GetEvent(ctx, tt.eventID, tt.organizerID)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("GetEvent() error = %v", err)
+				}
+				if event.ID != tt.eventID {
+					t.Errorf("GetEvent() ID = %q, %q を期待", event.ID, tt.eventID)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetEvent() error = %v, %v を期待", err, tt.wantErr)
+			}
+			if event != nil {
+				t.Errorf("エラー時にイベントが返されました: %+v", event)
+			}
+		})
+	}
+}
+
+func TestListEventsByOrganizer(t *testing.T) {
+	h, _ := newTestEventHandler(t)
+	ctx := context.Background()
+
+	for _, organizerID := range []string{"owner", "owner", "someone-else"} {
+		if _, err := h.CreateEvent(ctx, &domain.CreateEventRequest{Title: "飲み会"}, organizerID); err != nil {
+			t.Fatalf("テスト用イベントの作成に失敗: %v", err)
+		}
+	}
+
+	events, err := h.ListEventsByOrganizer(ctx, "owner", map[string]interface{}{"status": "planning"})
+	if err != nil {
+		t.Fatalf("ListEventsByOrganizer() error = %v", err)
+	}
+	if len(events) != 2 {
+		t.Errorf("件数 = %d, 2件を期待", len(events))
+	}
+
+	if _, err := h.ListEventsByOrganizer(ctx, "owner", map[string]interface{}{"status": "unknown"}); err == nil {
+		t.Error("無効なステータスでエラーを期待しましたが、nilが返されました")
+	}
+}
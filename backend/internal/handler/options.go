@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+)
+
+// Option はハンドラーの依存関係（時刻・ID生成）を差し替えるための関数オプション
+// 本番ではデフォルト値が使われ、テストでは固定値を注入する
+//
+// 使用例:
+//
+//	h := handler.NewEventHandler(repo,
+//		handler.WithClock(clock.NewFixedClock(now)),
+//		handler.WithIDGenerator(idgen.NewSequenceGenerator()),
+//	)
+type Option func(*options)
+
+// options はOptionで設定される依存関係の集合
+type options struct {
+	// clock は現在時刻の取得元
+	clock clock.Clock
+
+	// idGen はリソースIDの生成元
+	idGen idgen.Generator
+}
+
+// WithClock は現在時刻の取得元を差し替える
+func WithClock(c clock.Clock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
+
+// WithIDGenerator はID生成元を差し替える
+func WithIDGenerator(g idgen.Generator) Option {
+	return func(o *options) {
+		o.idGen = g
+	}
+}
+
+// newOptions はデフォルト値（システム時刻・UUID）にOptionを適用した結果を返す
+func newOptions(opts []Option) options {
+	o := options{
+		clock: clock.SystemClock{},
+		idGen: idgen.UUIDGenerator{},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// recordIDPattern は開催記録IDの形式（"rec_" + 32文字の16進数）
+var recordIDPattern = regexp.MustCompile(`^rec_[a-f0-9]{32}$`)
+
+// RecordHandler は開催記録（幹事ログ）と「みんなの記録」のビジネスロジックを処理
+// 記録は作成した幹事だけが閲覧・編集できる（共同幹事も自分の記録を別に作成する）
+type RecordHandler struct {
+	// events は記録の対象のイベントの取得（権限チェック込み）を担当
+	events *EventHandler
+
+	// recordRepo は記録の永続化を担当
+	recordRepo repository.RecordRepository
+
+	// idGen は記録IDの生成元
+	idGen idgen.Generator
+
+	// clock は共有・不適切報告の日時の取得元
+	clock clock.Clock
+}
+
+// NewRecordHandler は新しいRecordHandlerインスタンスを作成
+func NewRecordHandler(eventHandler *EventHandler, recordRepo repository.RecordRepository, opts ...Option) *RecordHandler {
+	o := newOptions(opts)
+	return &RecordHandler{
+		events:     eventHandler,
+		recordRepo: recordRepo,
+		idGen:      o.idGen,
+		clock:      o.clock,
+	}
+}
+
+// CreateRecord は完了したイベントの記録を作成する（イベントの編集権限が必要）
+// 1人の幹事が作成できる記録はイベントごとに1件で、2件目は ErrRecordExists を返す
+// 参加人数・会場を省略した場合は、参加と回答したメンバーの人数・決定した会場を記録する
+func (h *RecordHandler) CreateRecord(ctx context.Context, organizerID string, req *domain.CreateRecordRequest) (*domain.EventRecord, error) {
+	lang := i18n.FromContext(ctx)
+	req.EventID = strings.TrimSpace(req.EventID)
+	req.Notes = strings.TrimSpace(req.Notes)
+	normalizeRecordVenue(req.Venue)
+	if err := h.events.validator.Validate(req, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+
+	event, err := h.events.GetEventFor(ctx, req.EventID, organizerID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status != "completed" {
+		return nil, ErrEventNotCompleted
+	}
+
+	existing, err := h.recordRepo.ListRecordsByOrganizer(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("記録一覧の取得に失敗しました: %w", err)
+	}
+	for _, record := range existing {
+		if record.EventID == event.ID {
+			return nil, ErrRecordExists
+		}
+	}
+
+	attendees := req.Attendees
+	if attendees == 0 {
+		attendees = len(membersByStatus(event.Members, "attending"))
+	}
+	rounding := req.Rounding
+	if rounding == "" {
+		rounding = domain.DefaultRounding
+	}
+	venue := event.Venue
+	if req.Venue != nil {
+		venue = venueFromRecordRequest(req.Venue)
+	}
+
+	record := &domain.EventRecord{
+		ID:          h.idGen.NewID("rec"),
+		EventID:     event.ID,
+		OrganizerID: organizerID,
+		Event: domain.RecordEvent{
+			Title:   event.Title,
+			Purpose: event.Purpose,
+			Date:    event.Date,
+		},
+		Venue:     venue,
+		Rating:    req.Rating,
+		Notes:     req.Notes,
+		TotalCost: req.TotalCost,
+		Attendees: attendees,
+		Rounding:  rounding,
+	}
+	if err := applyCost(record, lang); err != nil {
+		return nil, err
+	}
+
+	created, err := h.recordRepo.CreateRecord(ctx, record)
+	if err != nil {
+		return nil, fmt.Errorf("記録の保存に失敗しました: %w", err)
+	}
+	return created, nil
+}
+
+// ListRecords は幹事の記録を新しい順に返す
+func (h *RecordHandler) ListRecords(ctx context.Context, organizerID string) ([]*domain.EventRecord, error) {
+	records, err := h.recordRepo.ListRecordsByOrganizer(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("記録一覧の取得に失敗しました: %w", err)
+	}
+	return records, nil
+}
+
+// GetRecord は幹事の記録を1件返す
+func (h *RecordHandler) GetRecord(ctx context.Context, recordID string, organizerID string) (*domain.EventRecord, error) {
+	return h.getOwnedRecord(ctx, recordID, organizerID)
+}
+
+// UpdateRecord は記録の内容を置き換え、1人あたりの金額を計算し直す
+// 参加人数・端数処理・会場を省略した場合は記録済みの値を使う
+func (h *RecordHandler) UpdateRecord(ctx context.Context, recordID string, organizerID string, req *domain.UpdateRecordRequest) (*domain.EventRecord, error) {
+	lang := i18n.FromContext(ctx)
+	req.Notes = strings.TrimSpace(req.Notes)
+	normalizeRecordVenue(req.Venue)
+	if err := h.events.validator.Validate(req, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+
+	record, err := h.getOwnedRecord(ctx, recordID, organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	record.Rating = req.Rating
+	record.Notes = req.Notes
+	record.TotalCost = req.TotalCost
+	if req.Attendees != 0 {
+		record.Attendees = req.Attendees
+	}
+	if req.Rounding != "" {
+		record.Rounding = req.Rounding
+	}
+	if req.Venue != nil {
+		record.Venue = venueFromRecordRequest(req.Venue)
+	}
+	if err := applyCost(record, lang); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.recordRepo.UpdateRecord(ctx, record)
+	if err != nil {
+		return nil, fmt.Errorf("記録の更新に失敗しました: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteRecord は記録を削除する（対象のイベントには影響しない）
+func (h *RecordHandler) DeleteRecord(ctx context.Context, recordID string, organizerID string) error {
+	if _, err := h.getOwnedRecord(ctx, recordID, organizerID); err != nil {
+		return err
+	}
+	if err := h.recordRepo.DeleteRecord(ctx, recordID); err != nil {
+		return fmt.Errorf("記録の削除に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// getOwnedRecord はIDの形式と作成者を確認して記録を取得する
+func (h *RecordHandler) getOwnedRecord(ctx context.Context, recordID string, organizerID string) (*domain.EventRecord, error) {
+	if !recordIDPattern.MatchString(recordID) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRecordID, recordID)
+	}
+
+	record, err := h.recordRepo.GetRecord(ctx, recordID)
+	if err != nil {
+		return nil, fmt.Errorf("記録の取得に失敗しました: %w", err)
+	}
+	if record.OrganizerID != organizerID {
+		return nil, ErrForbidden
+	}
+	return record, nil
+}
+
+// applyCost は総額・参加人数・端数処理のルールから1人あたりの金額を設定する
+// 総額があるのに参加人数が0の場合は割り算できないため入力エラーとする
+func applyCost(record *domain.EventRecord, lang i18n.Language) error {
+	if record.TotalCost > 0 && record.Attendees == 0 {
+		return combinationError("attendees",
+			"総額を入力する場合は参加人数を入力してください",
+			"Attendees is required when total cost is given", lang)
+	}
+	record.CostPerPerson = domain.PerPerson(record.TotalCost, record.Attendees, record.Rounding)
+	return nil
+}
+
+// normalizeRecordVenue は手入力の会場の全角英数字・前後の空白を正規化する
+func normalizeRecordVenue(venue *domain.RecordVenueRequest) {
+	if venue == nil {
+		return
+	}
+	for _, field := range []*string{&venue.Name, &venue.Address, &venue.Genre, &venue.Area} {
+		*field = strings.TrimSpace(norm.NFKC.String(*field))
+	}
+}
+
+// venueFromRecordRequest は手入力の会場を記録用の Venue に変換する
+func venueFromRecordRequest(venue *domain.RecordVenueRequest) *domain.Venue {
+	return &domain.Venue{
+		Name:    venue.Name,
+		Address: venue.Address,
+		Genre:   venue.Genre,
+		Area:    venue.Area,
+	}
+}
@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// newTestRecordHandler は参加3名・不参加1名のメンバーがいて会場を決定済みのイベントと、その RecordHandler を作成
+// completed が true の場合はイベントを完了済みにする
+func newTestRecordHandler(t *testing.T, completed bool) (*RecordHandler, string) {
+	t.Helper()
+	events, _, event := seedEvent(t, []domain.Member{
+		{Name: "田中", Status: "attending"},
+		{Name: "佐藤", Status: "attending"},
+		{Name: "鈴木", Status: "attending"},
+		{Name: "伊藤", Status: "declined"},
+	}, func(event *domain.Event) {
+		event.Title = "歓送迎会"
+		event.Purpose = "welcome"
+		event.Date = "2099-04-10"
+		event.Time = "19:00"
+		event.Venue = &domain.Venue{Name: "炭火焼鳥 鳥心 新宿店", Genre: "居酒屋", Area: "新宿", SelectedBy: "owner", SelectedAt: testNow}
+		if completed {
+			event.Status = "completed"
+		}
+	})
+
+	fixed := clock.NewFixedClock(testNow)
+	records := repository.NewMemoryRecordRepository(repository.WithClock(fixed))
+	return NewRecordHandler(events, records, WithClock(fixed), WithIDGenerator(idgen.NewSequenceGenerator())), event.ID
+}
+
+func TestCreateRecordCostPerPerson(t *testing.T) {
+	tests := []struct {
+		name      string
+		totalCost int
+		attendees int
+		rounding  string
+		want      int
+	}{
+		{name: "省略時は1円単位に切り上げ", totalCost: 10000, rounding: "", want: 3334},
+		{name: "1円単位で割り切れる", totalCost: 12000, rounding: domain.RoundingNone, want: 4000},
+		{name: "100円単位に切り上げ", totalCost: 10000, rounding: domain.RoundingCeil100, want: 3400},
+		{name: "1,000円単位に切り上げ", totalCost: 10000, rounding: domain.RoundingCeil1000, want: 4000},
+		{name: "100円単位に四捨五入（切り捨て側）", totalCost: 10000, rounding: domain.RoundingRound100, want: 3300},
+		{name: "100円単位に四捨五入（切り上げ側）", totalCost: 10500, rounding: domain.RoundingRound100, want: 3500},
+		{name: "1,000円単位に四捨五入", totalCost: 10000, rounding: domain.RoundingRound1000, want: 3000},
+		{name: "100円単位に切り捨て", totalCost: 10000, rounding: domain.RoundingFloor100, want: 3300},
+		{name: "1,000円単位に切り捨て", totalCost: 10000, rounding: domain.RoundingFloor1000, want: 3000},
+		{name: "参加人数を指定", totalCost: 10000, attendees: 4, rounding: domain.RoundingCeil100, want: 2500},
+		{name: "総額なし", totalCost: 0, rounding: domain.RoundingCeil1000, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, eventID := newTestRecordHandler(t, true)
+			record, err := h.CreateRecord(context.Background(), "owner", &domain.CreateRecordRequest{
+				EventID: eventID, Rating: 4, TotalCost: tt.totalCost, Attendees: tt.attendees, Rounding: tt.rounding,
+			})
+			if err != nil {
+				t.Fatalf("CreateRecord() error = %v", err)
+			}
+			if record.CostPerPerson != tt.want {
+				t.Errorf("CostPerPerson = %d, %d を期待", record.CostPerPerson, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateRecord(t *testing.T) {
+	ctx := context.Background()
+	h, eventID := newTestRecordHandler(t, true)
+
+	record, err := h.CreateRecord(ctx, "owner", &domain.CreateRecordRequest{
+		EventID: eventID, Rating: 5, Notes: "  焼き鳥がおいしかった  ", TotalCost: 15000,
+	})
+	if err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+	if !recordIDPattern.MatchString(record.ID) || record.OrganizerID != "owner" || record.EventID != eventID {
+		t.Errorf("Record = %+v", record)
+	}
+	if record.Event != (domain.RecordEvent{Title: "歓送迎会", Purpose: "welcome", Date: "2099-04-10"}) {
+		t.Errorf("Event = %+v, イベントの概要を期待", record.Event)
+	}
+	if record.Attendees != 3 || record.Rounding != domain.RoundingNone || record.CostPerPerson != 5000 {
+		t.Errorf("Attendees = %d, Rounding = %q, CostPerPerson = %d, 参加者3名・1円単位・5000円を期待", record.Attendees, record.Rounding, record.CostPerPerson)
+	}
+	if record.Venue == nil || record.Venue.Name != "炭火焼鳥 鳥心 新宿店" {
+		t.Errorf("Venue = %+v, イベントで決定した会場を期待", record.Venue)
+	}
+	if record.Notes != "焼き鳥がおいしかった" {
+		t.Errorf("Notes = %q, 前後の空白の除去を期待", record.Notes)
+	}
+
+	if _, err := h.CreateRecord(ctx, "owner", &domain.CreateRecordRequest{EventID: eventID, Rating: 3}); !errors.Is(err, ErrRecordExists) {
+		t.Errorf("2件目の CreateRecord() error = %v, ErrRecordExists を期待", err)
+	}
+
+	records, err := h.ListRecords(ctx, "owner")
+	if err != nil || len(records) != 1 || records[0].ID != record.ID {
+		t.Errorf("ListRecords() = %v, %v, 作成した1件を期待", records, err)
+	}
+	if others, _ := h.ListRecords(ctx, "someone-else"); len(others) != 0 {
+		t.Errorf("他の幹事の ListRecords() = %v, 空を期待", others)
+	}
+}
+
+func TestCreateRecordErrors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("完了していないイベント", func(t *testing.T) {
+		h, eventID := newTestRecordHandler(t, false)
+		if _, err := h.CreateRecord(ctx, "owner", &domain.CreateRecordRequest{EventID: eventID, Rating: 4}); !errors.Is(err, ErrEventNotCompleted) {
+			t.Errorf("CreateRecord() error = %v, ErrEventNotCompleted を期待", err)
+		}
+	})
+
+	t.Run("他の幹事のイベント", func(t *testing.T) {
+		h, eventID := newTestRecordHandler(t, true)
+		if _, err := h.CreateRecord(ctx, "someone-else", &domain.CreateRecordRequest{EventID: eventID, Rating: 4}); !errors.Is(err, ErrForbidden) {
+			t.Errorf("CreateRecord() error = %v, ErrForbidden を期待", err)
+		}
+	})
+
+	tests := []struct {
+		name      string
+		req       domain.CreateRecordRequest
+		wantField string
+	}{
+		{name: "評価なし", req: domain.CreateRecordRequest{}, wantField: "rating"},
+		{name: "評価が範囲外", req: domain.CreateRecordRequest{Rating: 6}, wantField: "rating"},
+		{name: "未知の端数処理", req: domain.CreateRecordRequest{Rating: 4, Rounding: "ceil_10"}, wantField: "rounding"},
+		{name: "会場名なし", req: domain.CreateRecordRequest{Rating: 4, Venue: &domain.RecordVenueRequest{Address: "新宿"}}, wantField: "venue.name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, eventID := newTestRecordHandler(t, true)
+			tt.req.EventID = eventID
+			_, err := h.CreateRecord(ctx, "owner", &tt.req)
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("CreateRecord() error = %v, ValidationError を期待", err)
+			}
+			fields, _ := validationErr.Info.Details["fields"].(validation.Errors)
+			if len(fields) != 1 || fields[0].Field != tt.wantField {
+				t.Errorf("fields = %+v, %s を期待", fields, tt.wantField)
+			}
+		})
+	}
+
+	t.Run("総額があり参加者がいない", func(t *testing.T) {
+		events, _, event := seedEvent(t, nil, func(event *domain.Event) {
+			event.Status = "completed"
+		})
+		h := NewRecordHandler(events, repository.NewMemoryRecordRepository())
+
+		_, err := h.CreateRecord(ctx, "owner", &domain.CreateRecordRequest{EventID: event.ID, Rating: 4, TotalCost: 10000})
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("CreateRecord() error = %v, ValidationError を期待", err)
+		}
+		fields, _ := validationErr.Info.Details["fields"].(validation.Errors)
+		if len(fields) != 1 || fields[0].Field != "attendees" {
+			t.Errorf("fields = %+v, attendees を期待", fields)
+		}
+	})
+}
+
+func TestUpdateAndDeleteRecord(t *testing.T) {
+	ctx := context.Background()
+	h, eventID := newTestRecordHandler(t, true)
+	created, err := h.CreateRecord(ctx, "owner", &domain.CreateRecordRequest{
+		EventID: eventID, Rating: 3, TotalCost: 12000, Rounding: domain.RoundingCeil1000,
+	})
+	if err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+
+	updated, err := h.UpdateRecord(ctx, created.ID, "owner", &domain.UpdateRecordRequest{
+		Rating: 4, TotalCost: 14000, Venue: &domain.RecordVenueRequest{Name: "２次会　カラオケ"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateRecord() error = %v", err)
+	}
+	if updated.Rating != 4 || updated.Attendees != 3 || updated.Rounding != domain.RoundingCeil1000 || updated.CostPerPerson != 5000 {
+		t.Errorf("Record = %+v, 記録済みの参加人数・端数処理での再計算（5000円）を期待", updated)
+	}
+	if updated.Venue == nil || updated.Venue.Name != "2次会 カラオケ" {
+		t.Errorf("Venue = %+v, 正規化した手入力の会場を期待", updated.Venue)
+	}
+
+	if _, err := h.GetRecord(ctx, created.ID, "someone-else"); !errors.Is(err, ErrForbidden) {
+		t.Errorf("他の幹事の GetRecord() error = %v, ErrForbidden を期待", err)
+	}
+	if _, err := h.GetRecord(ctx, "rec_invalid", "owner"); !errors.Is(err, ErrInvalidRecordID) {
+		t.Errorf("不正なIDの GetRecord() error = %v, ErrInvalidRecordID を期待", err)
+	}
+	if err := h.DeleteRecord(ctx, created.ID, "someone-else"); !errors.Is(err, ErrForbidden) {
+		t.Errorf("他の幹事の DeleteRecord() error = %v, ErrForbidden を期待", err)
+	}
+
+	if err := h.DeleteRecord(ctx, created.ID, "owner"); err != nil {
+		t.Fatalf("DeleteRecord() error = %v", err)
+	}
+	if _, err := h.GetRecord(ctx, created.ID, "owner"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("削除後の GetRecord() error = %v, ErrNotFound を期待", err)
+	}
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+)
+
+// ReservationHandler はお店の予約に関するビジネスロジックを処理
+// 予約そのものは幹事がお店の電話・予約サイトで行い、ここでは伝える内容の整理と結果の記録を扱う
+type ReservationHandler struct {
+	// events はイベントの取得（権限チェック込み）と保存を担当
+	events *EventHandler
+
+	// clock は報告・更新日時の取得元
+	clock clock.Clock
+}
+
+// NewReservationHandler は新しいReservationHandlerインスタンスを作成
+func NewReservationHandler(eventHandler *EventHandler, opts ...Option) *ReservationHandler {
+	o := newOptions(opts)
+	return &ReservationHandler{
+		events: eventHandler,
+		clock:  o.clock,
+	}
+}
+
+// GetReservationInfo はお店に伝える内容（人数・アレルギー・予算・日時）を集計して返す（閲覧権限で利用可能）
+// 集計の対象は参加と回答したメンバーで、未回答の人数は別に返す
+func (h *ReservationHandler) GetReservationInfo(ctx context.Context, eventID string, userID string) (*domain.ReservationInfo, error) {
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+
+	attending := membersByStatus(event.Members, "attending")
+	preferences := make([]domain.MemberPreferences, 0, len(attending))
+	drinkers := 0
+	for _, member := range attending {
+		p := member.ParsePreferences()
+		preferences = append(preferences, p)
+		if p.AlcoholPreference == domain.AlcoholYes || p.AlcoholPreference == domain.AlcoholSometimes {
+			drinkers++
+		}
+	}
+
+	return &domain.ReservationInfo{
+		Restaurant: event.Venue,
+		Event: domain.ReservationEvent{
+			Title:       event.Title,
+			Date:        event.Date,
+			Time:        event.Time,
+			MemberCount: len(event.Members),
+		},
+		Headcount:    len(attending),
+		PendingCount: len(membersByStatus(event.Members, "pending")),
+		DrinkerCount: drinkers,
+		Allergies: countRestrictions(preferences, func(p domain.MemberPreferences) []string {
+			return p.Allergies
+		}),
+		DietaryRestrictions: countRestrictions(preferences, func(p domain.MemberPreferences) []string {
+			return p.DietaryRestrictions
+		}),
+		BudgetPerPerson: domain.CommonBudgetRange(preferences),
+		Reservation:     event.Reservation,
+	}, nil
+}
+
+// ReportReservation は予約完了を記録する（編集権限が必要）
+// 会場の決定後にのみ報告でき、企画中のイベントは確定（confirmed）に進める
+func (h *ReservationHandler) ReportReservation(ctx context.Context, eventID string, userID string, req *domain.ReservationRequest) (*domain.Reservation, error) {
+	if err := h.validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if event.Venue == nil {
+		return nil, ErrNoVenue
+	}
+	if event.Reservation != nil {
+		return nil, ErrReservationExists
+	}
+
+	now := h.clock.Now()
+	event.Reservation = &domain.Reservation{
+		VenueName:       event.Venue.Name,
+		ReservationID:   req.ReservationID,
+		ContactPerson:   req.ContactPerson,
+		SpecialRequests: req.SpecialRequests,
+		IsConfirmed:     true,
+		ConfirmedAt:     &now,
+		ReportedBy:      userID,
+		UpdatedBy:       userID,
+		UpdatedAt:       now,
+	}
+	if event.Status == "planning" {
+		event.Status = "confirmed"
+	}
+	if _, err := h.events.eventRepo.UpdateEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("予約の保存に失敗しました: %w", err)
+	}
+	return event.Reservation, nil
+}
+
+// UpdateReservation は報告済みの予約番号・予約者名・要望を置き換える（編集権限が必要）
+func (h *ReservationHandler) UpdateReservation(ctx context.Context, eventID string, userID string, req *domain.ReservationRequest) (*domain.Reservation, error) {
+	if err := h.validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if event.Reservation == nil {
+		return nil, ErrNoReservation
+	}
+
+	event.Reservation.ReservationID = req.ReservationID
+	event.Reservation.ContactPerson = req.ContactPerson
+	event.Reservation.SpecialRequests = req.SpecialRequests
+	event.Reservation.UpdatedBy = userID
+	event.Reservation.UpdatedAt = h.clock.Now()
+	if _, err := h.events.eventRepo.UpdateEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("予約の保存に失敗しました: %w", err)
+	}
+	return event.Reservation, nil
+}
+
+// validate は予約のリクエストを正規化して検証する
+func (h *ReservationHandler) validate(ctx context.Context, req *domain.ReservationRequest) error {
+	lang := i18n.FromContext(ctx)
+	req.ReservationID = strings.TrimSpace(norm.NFKC.String(req.ReservationID))
+	req.ContactPerson = strings.TrimSpace(norm.NFKC.String(req.ContactPerson))
+	req.SpecialRequests = strings.TrimSpace(req.SpecialRequests)
+	if err := h.events.validator.Validate(req, lang); err != nil {
+		return &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+	return nil
+}
+
+// countRestrictions はアレルギー・食事制限ごとの人数を回答順に数える
+// 同じメンバーが同じ項目を重複して回答した場合は1人として数える
+func countRestrictions(preferences []domain.MemberPreferences, items func(domain.MemberPreferences) []string) []domain.RestrictionCount {
+	counts := make([]domain.RestrictionCount, 0)
+	index := make(map[string]int)
+	for _, p := range preferences {
+		seen := make(map[string]bool)
+		for _, item := range items(p) {
+			if seen[item] {
+				continue
+			}
+			seen[item] = true
+			if i, ok := index[item]; ok {
+				counts[i].Count++
+				continue
+			}
+			index[item] = len(counts)
+			counts = append(counts, domain.RestrictionCount{Name: item, Count: 1})
+		}
+	}
+	return counts
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// newTestReservationHandler は参加3名・未回答1名・不参加1名のメンバーがいるイベントと、その ReservationHandler を作成
+// withVenue が true の場合は会場を決定済みにする
+func newTestReservationHandler(t *testing.T, withVenue bool) (*ReservationHandler, *EventHandler, string) {
+	t.Helper()
+	members := []domain.Member{
+		{Name: "田中", Status: "attending", Preferences: map[string]interface{}{
+			"allergies": []interface{}{"えび", "かに"}, "alcoholPreference": "yes",
+			"budgetRange": map[string]interface{}{"min": float64(3000), "max": float64(5000)},
+		}},
+		{Name: "佐藤", Status: "attending", Preferences: map[string]interface{}{
+			"allergies": "えび", "dietaryRestrictions": []interface{}{"ベジタリアン"}, "alcoholPreference": "no",
+			"budgetRange": map[string]interface{}{"min": float64(2000), "max": float64(4000)},
+		}},
+		{Name: "鈴木", Status: "attending", Preferences: map[string]interface{}{"allergies": "なし", "alcoholPreference": "sometimes"}},
+		{Name: "高橋", Status: "pending"},
+		{Name: "伊藤", Status: "declined", Preferences: map[string]interface{}{"allergies": "そば"}},
+	}
+	events, _, event := seedEvent(t, members, func(event *domain.Event) {
+		event.Date = "2099-04-10"
+		event.Time = "19:00"
+		if withVenue {
+			event.Venue = &domain.Venue{Name: "炭火焼鳥 鳥心 新宿店", Address: "東京都新宿区新宿3-1-1", SelectedBy: "owner", SelectedAt: testNow}
+		}
+	})
+	return NewReservationHandler(events, WithClock(clock.NewFixedClock(testNow))), events, event.ID
+}
+
+func TestGetReservationInfo(t *testing.T) {
+	h, _, eventID := newTestReservationHandler(t, true)
+
+	info, err := h.GetReservationInfo(context.Background(), eventID, "owner")
+	if err != nil {
+		t.Fatalf("GetReservationInfo() error = %v", err)
+	}
+
+	if info.Restaurant == nil || info.Restaurant.Name != "炭火焼鳥 鳥心 新宿店" {
+		t.Errorf("Restaurant = %+v, 決定済みの会場を期待", info.Restaurant)
+	}
+	wantEvent := domain.ReservationEvent{Title: "新人歓迎会", Date: "2099-04-10", Time: "19:00", MemberCount: 5}
+	if info.Event != wantEvent {
+		t.Errorf("Event = %+v, %+v を期待", info.Event, wantEvent)
+	}
+	if info.Headcount != 3 || info.PendingCount != 1 || info.DrinkerCount != 2 {
+		t.Errorf("Headcount = %d, PendingCount = %d, DrinkerCount = %d, 3・1・2 を期待", info.Headcount, info.PendingCount, info.DrinkerCount)
+	}
+	wantAllergies := []domain.RestrictionCount{{Name: "えび", Count: 2}, {Name: "かに", Count: 1}}
+	if !reflect.DeepEqual(info.Allergies, wantAllergies) {
+		t.Errorf("Allergies = %+v, %+v を期待（不参加者・「なし」は除く）", info.Allergies, wantAllergies)
+	}
+	if len(info.DietaryRestrictions) != 1 || info.DietaryRestrictions[0].Name != "ベジタリアン" {
+		t.Errorf("DietaryRestrictions = %+v", info.DietaryRestrictions)
+	}
+	if info.BudgetPerPerson == nil || *info.BudgetPerPerson != (domain.BudgetRange{Min: 3000, Max: 4000}) {
+		t.Errorf("BudgetPerPerson = %+v, 3000〜4000円を期待", info.BudgetPerPerson)
+	}
+	if info.Reservation != nil {
+		t.Errorf("Reservation = %+v, 未報告のため nil を期待", info.Reservation)
+	}
+}
+
+func TestReportAndUpdateReservation(t *testing.T) {
+	ctx := context.Background()
+	h, events, eventID := newTestReservationHandler(t, true)
+
+	if _, err := h.UpdateReservation(ctx, eventID, "owner", &domain.ReservationRequest{ContactPerson: "田中"}); !errors.Is(err, ErrNoReservation) {
+		t.Fatalf("報告前の UpdateReservation() error = %v, ErrNoReservation を期待", err)
+	}
+
+	reported, err := h.ReportReservation(ctx, eventID, "owner", &domain.ReservationRequest{
+		ReservationID: " R-1234 ", ContactPerson: "田中", SpecialRequests: "えび・かにを除いたコースでお願いします",
+	})
+	if err != nil {
+		t.Fatalf("ReportReservation() error = %v", err)
+	}
+	if reported.ReservationID != "R-1234" || !reported.IsConfirmed || reported.ConfirmedAt == nil || reported.ReportedBy != "owner" {
+		t.Errorf("Reservation = %+v", reported)
+	}
+	event, _ := events.GetEvent(ctx, eventID, "owner")
+	if event.Status != "confirmed" || event.Reservation == nil {
+		t.Errorf("Status = %q, Reservation = %+v, 確定・予約の保存を期待", event.Status, event.Reservation)
+	}
+
+	if _, err := h.ReportReservation(ctx, eventID, "owner", &domain.ReservationRequest{ContactPerson: "田中"}); !errors.Is(err, ErrReservationExists) {
+		t.Errorf("2回目の ReportReservation() error = %v, ErrReservationExists を期待", err)
+	}
+
+	updated, err := h.UpdateReservation(ctx, eventID, "owner", &domain.ReservationRequest{ContactPerson: "佐藤"})
+	if err != nil {
+		t.Fatalf("UpdateReservation() error = %v", err)
+	}
+	if updated.ContactPerson != "佐藤" || updated.ReservationID != "" || updated.SpecialRequests != "" {
+		t.Errorf("Reservation = %+v, 内容の置き換えを期待", updated)
+	}
+	if !updated.IsConfirmed || updated.ReportedBy != "owner" {
+		t.Errorf("Reservation = %+v, 報告時の確定情報の維持を期待", updated)
+	}
+
+	info, _ := h.GetReservationInfo(ctx, eventID, "owner")
+	if info.Reservation == nil || info.Reservation.ContactPerson != "佐藤" {
+		t.Errorf("info.Reservation = %+v, 更新後の予約を期待", info.Reservation)
+	}
+}
+
+func TestSelectVenueResetsReservation(t *testing.T) {
+	ctx := context.Background()
+	h, events, eventID := newTestReservationHandler(t, true)
+	restaurants := NewRestaurantHandler(events, nil, WithClock(clock.NewFixedClock(testNow)))
+
+	reported, err := h.ReportReservation(ctx, eventID, "owner", &domain.ReservationRequest{ReservationID: "R-1234", ContactPerson: "田中"})
+	if err != nil {
+		t.Fatalf("ReportReservation() error = %v", err)
+	}
+	if reported.VenueName != "炭火焼鳥 鳥心 新宿店" {
+		t.Errorf("VenueName = %q, 報告時の会場の店名を期待", reported.VenueName)
+	}
+
+	// 同じお店を選び直しても予約は残る
+	if _, err := restaurants.SelectVenue(ctx, eventID, "owner", &domain.SelectVenueRequest{Name: "炭火焼鳥 鳥心 新宿店", Address: "東京都新宿区新宿3-1-1", Phone: "03-0000-0000"}); err != nil {
+		t.Fatalf("SelectVenue() error = %v", err)
+	}
+	if info, _ := h.GetReservationInfo(ctx, eventID, "owner"); info.Reservation == nil || info.Reservation.ReservationID != "R-1234" {
+		t.Errorf("Reservation = %+v, 同じお店では予約の維持を期待", info.Reservation)
+	}
+
+	if _, err := restaurants.SelectVenue(ctx, eventID, "owner", &domain.SelectVenueRequest{Name: "居酒屋 まる", Address: "東京都中野区中野5-1-1"}); err != nil {
+		t.Fatalf("SelectVenue() error = %v", err)
+	}
+	info, _ := h.GetReservationInfo(ctx, eventID, "owner")
+	if info.Restaurant == nil || info.Restaurant.Name != "居酒屋 まる" || info.Reservation != nil {
+		t.Errorf("Restaurant = %+v, Reservation = %+v, 新しい会場・予約の取り消しを期待", info.Restaurant, info.Reservation)
+	}
+	if event, _ := events.GetEvent(ctx, eventID, "owner"); event.Status != "planning" {
+		t.Errorf("Status = %q, 企画中に戻ることを期待", event.Status)
+	}
+	again, err := h.ReportReservation(ctx, eventID, "owner", &domain.ReservationRequest{ContactPerson: "田中"})
+	if err != nil || again.VenueName != "居酒屋 まる" {
+		t.Errorf("新しい会場の ReportReservation() = %+v, %v, 新しい会場での報告を期待", again, err)
+	}
+}
+
+func TestReportReservationErrors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("会場が未決定", func(t *testing.T) {
+		h, _, eventID := newTestReservationHandler(t, false)
+		if _, err := h.ReportReservation(ctx, eventID, "owner", &domain.ReservationRequest{ContactPerson: "田中"}); !errors.Is(err, ErrNoVenue) {
+			t.Errorf("ReportReservation() error = %v, ErrNoVenue を期待", err)
+		}
+	})
+
+	t.Run("他の幹事のイベント", func(t *testing.T) {
+		h, _, eventID := newTestReservationHandler(t, true)
+		if _, err := h.ReportReservation(ctx, eventID, "someone-else", &domain.ReservationRequest{ContactPerson: "田中"}); !errors.Is(err, ErrForbidden) {
+			t.Errorf("ReportReservation() error = %v, ErrForbidden を期待", err)
+		}
+	})
+
+	t.Run("予約者名なし", func(t *testing.T) {
+		h, _, eventID := newTestReservationHandler(t, true)
+		_, err := h.ReportReservation(ctx, eventID, "owner", &domain.ReservationRequest{ContactPerson: "  "})
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("ReportReservation() error = %v, ValidationError を期待", err)
+		}
+		fields, _ := validationErr.Info.Details["fields"].(validation.Errors)
+		if len(fields) != 1 || fields[0].Field != "contactPerson" {
+			t.Errorf("fields = %+v, contactPerson を期待", fields)
+		}
+	})
+}
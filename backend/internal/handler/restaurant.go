@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/recommend"
+	"github.com/luck-tech/kanji-log/backend/internal/restaurant"
+)
+
+// RestaurantHandler はイベントのお店探しに関するビジネスロジックを処理
+type RestaurantHandler struct {
+	// events はイベントの取得（権限チェック込み）と保存を担当
+	events *EventHandler
+
+	// provider は店舗の検索元（本番はホットペッパー、ローカル・テストは Fake）
+	provider restaurant.Provider
+
+	// clock は検索日時の取得元
+	clock clock.Clock
+}
+
+// NewRestaurantHandler は新しいRestaurantHandlerインスタンスを作成
+func NewRestaurantHandler(eventHandler *EventHandler, provider restaurant.Provider, opts ...Option) *RestaurantHandler {
+	o := newOptions(opts)
+	return &RestaurantHandler{
+		events:   eventHandler,
+		provider: provider,
+		clock:    o.clock,
+	}
+}
+
+// SearchRestaurants は条件に一致する店舗を検索し、結果をイベントに保存する（編集権限が必要）
+// 人数を省略した場合は、不参加と回答したメンバーを除いた人数で検索する
+// 保存するのは最新の検索結果のみで、再検索すると前回の結果は置き換えられる
+func (h *RestaurantHandler) SearchRestaurants(ctx context.Context, eventID string, userID string, criteria *domain.RestaurantSearchCriteria) (*domain.RestaurantSearch, error) {
+	lang := i18n.FromContext(ctx)
+	criteria.Area = strings.TrimSpace(norm.NFKC.String(criteria.Area))
+	criteria.Genre = strings.TrimSpace(norm.NFKC.String(criteria.Genre))
+	criteria.Keyword = strings.TrimSpace(norm.NFKC.String(criteria.Keyword))
+	if err := h.events.validator.Validate(criteria, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+	if criteria.BudgetMax > 0 && criteria.BudgetMin > criteria.BudgetMax {
+		return nil, combinationError("budgetMax",
+			"予算の上限は下限以上にしてください",
+			"Maximum budget must be greater than or equal to minimum budget", lang)
+	}
+
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	if criteria.Capacity == 0 {
+		criteria.Capacity = expectedHeadcount(event.Members)
+	}
+	if criteria.Count == 0 {
+		criteria.Count = restaurant.DefaultCount
+	}
+
+	restaurants, err := h.provider.Search(ctx, *criteria)
+	if err != nil {
+		return nil, fmt.Errorf("レストランの検索に失敗しました: %w", err)
+	}
+
+	search := &domain.RestaurantSearch{
+		Criteria:    *criteria,
+		Restaurants: restaurants,
+		SearchedBy:  userID,
+		SearchedAt:  h.clock.Now(),
+	}
+	event.RestaurantSearch = search
+	if _, err := h.events.eventRepo.UpdateEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("検索結果の保存に失敗しました: %w", err)
+	}
+	return search, nil
+}
+
+// SuggestRestaurants は保存済みの検索結果から、メンバーの好みに合うお店を推薦の種類ごとに返す（閲覧権限で利用可能）
+// 参加と回答したメンバーの好みを使い、まだ誰も参加と回答していない場合は不参加以外のメンバーで判断する
+func (h *RestaurantHandler) SuggestRestaurants(ctx context.Context, eventID string, userID string) (*domain.RestaurantSuggestionsResponse, error) {
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	if event.RestaurantSearch == nil {
+		return nil, ErrNoRestaurantSearch
+	}
+
+	members := membersByStatus(event.Members, "attending")
+	if len(members) == 0 {
+		members = membersExcept(event.Members, "declined")
+	}
+	preferences := make([]domain.MemberPreferences, 0, len(members))
+	for _, member := range members {
+		preferences = append(preferences, member.ParsePreferences())
+	}
+
+	lang := i18n.FromContext(ctx)
+	analysisBase := "メンバーの好み、アレルギー情報、予算"
+	if lang == i18n.English {
+		analysisBase = "Member preferences, allergies and budgets"
+	}
+	return &domain.RestaurantSuggestionsResponse{
+		Summary: domain.RestaurantRecommendationSummary{
+			TotalRestaurants: len(event.RestaurantSearch.Restaurants),
+			AnalysisBase:     analysisBase,
+			MemberCount:      len(preferences),
+		},
+		Restaurants: recommend.Recommend(preferences, event.RestaurantSearch.Restaurants, lang),
+	}, nil
+}
+
+// SelectVenue はイベントの会場を決定する（編集権限が必要）
+// req.RestaurantID を指定した場合は保存済みの検索結果から、なければ検索元から店舗情報を取得する
+// 指定しない場合は手入力の店名・住所などを会場にする。決定済みの会場は置き換える
+// 別のお店に変更した場合、報告済みの予約は元のお店のものになるため取り消し、確定済みのイベントは企画中に戻す
+func (h *RestaurantHandler) SelectVenue(ctx context.Context, eventID string, userID string, req *domain.SelectVenueRequest) (*domain.Venue, error) {
+	lang := i18n.FromContext(ctx)
+	for _, field := range []*string{&req.RestaurantID, &req.Name, &req.Address, &req.Phone, &req.MapURL, &req.Genre, &req.Area} {
+		*field = strings.TrimSpace(norm.NFKC.String(*field))
+	}
+	if err := h.events.validator.Validate(req, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+	if req.RestaurantID == "" && req.Name == "" {
+		return nil, combinationError("name",
+			"店舗IDを指定しない場合は店名を入力してください",
+			"Name is required when no restaurant ID is given", lang)
+	}
+	if req.RestaurantID == "" && req.Address == "" {
+		return nil, combinationError("address",
+			"店舗IDを指定しない場合は住所を入力してください",
+			"Address is required when no restaurant ID is given", lang)
+	}
+
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	var venue domain.Venue
+	if req.RestaurantID != "" {
+		selected, err := h.findRestaurant(ctx, event, req.RestaurantID)
+		if err != nil {
+			return nil, err
+		}
+		venue = domain.VenueFromRestaurant(*selected)
+	} else {
+		venue = domain.Venue{
+			Name:    req.Name,
+			Address: req.Address,
+			Phone:   req.Phone,
+			MapURL:  req.MapURL,
+			Genre:   req.Genre,
+			Area:    req.Area,
+		}
+	}
+	venue.SelectedBy = userID
+	venue.SelectedAt = h.clock.Now()
+
+	if event.Reservation != nil && (event.Venue == nil || !event.Venue.SamePlace(venue)) {
+		event.Reservation = nil
+		if event.Status == "confirmed" {
+			event.Status = "planning"
+		}
+	}
+	event.Venue = &venue
+	if _, err := h.events.eventRepo.UpdateEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("会場の保存に失敗しました: %w", err)
+	}
+	return &venue, nil
+}
+
+// findRestaurant は店舗IDから店舗を探す
+// 保存済みの検索結果にあればそれを使い、外部サービスへの問い合わせを省く
+func (h *RestaurantHandler) findRestaurant(ctx context.Context, event *domain.Event, restaurantID string) (*domain.Restaurant, error) {
+	if event.RestaurantSearch != nil {
+		for _, r := range event.RestaurantSearch.Restaurants {
+			if r.ID == restaurantID {
+				return &r, nil
+			}
+		}
+	}
+	found, err := h.provider.Get(ctx, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("店舗の取得に失敗しました: %w", err)
+	}
+	return found, nil
+}
+
+// membersByStatus は参加状況が status のメンバーを返す
+func membersByStatus(members []domain.Member, status string) []domain.Member {
+	matched := make([]domain.Member, 0, len(members))
+	for _, member := range members {
+		if member.Status == status {
+			matched = append(matched, member)
+		}
+	}
+	return matched
+}
+
+// membersExcept は参加状況が status 以外のメンバーを返す
+func membersExcept(members []domain.Member, status string) []domain.Member {
+	matched := make([]domain.Member, 0, len(members))
+	for _, member := range members {
+		if member.Status != status {
+			matched = append(matched, member)
+		}
+	}
+	return matched
+}
+
+// expectedHeadcount は参加予定の人数（参加・未回答のメンバー数）を返す
+func expectedHeadcount(members []domain.Member) int {
+	return len(membersExcept(members, "declined"))
+}
@@ -0,0 +1,271 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/restaurant"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// unavailableProvider は常に外部サービスのエラーを返す restaurant.Provider
+type unavailableProvider struct {
+	restaurant.Provider
+}
+
+func (unavailableProvider) Search(ctx context.Context, criteria domain.RestaurantSearchCriteria) ([]domain.Restaurant, error) {
+	return nil, restaurant.ErrUnavailable
+}
+
+// newTestRestaurantHandler は同梱の店舗データで検索する RestaurantHandler を作成
+// 参加2名・不参加1名・未回答1名のメンバーがいるイベントのIDも返す
+func newTestRestaurantHandler(t *testing.T) (*RestaurantHandler, *EventHandler, string) {
+	t.Helper()
+	fake, err := restaurant.NewFixtureFake()
+	if err != nil {
+		t.Fatalf("NewFixtureFake() error = %v", err)
+	}
+
+	events, _, event := seedEvent(t, []domain.Member{
+		{Name: "田中", Status: "attending"},
+		{Name: "佐藤", Status: "attending"},
+		{Name: "鈴木", Status: "declined"},
+		{Name: "高橋", Status: "pending"},
+	}, nil)
+	return NewRestaurantHandler(events, fake, WithClock(clock.NewFixedClock(testNow))), events, event.ID
+}
+
+func TestSearchRestaurants(t *testing.T) {
+	ctx := context.Background()
+	h, events, eventID := newTestRestaurantHandler(t)
+
+	search, err := h.SearchRestaurants(ctx, eventID, "owner", &domain.RestaurantSearchCriteria{Area: " 渋谷 ", Genre: "居酒屋"})
+	if err != nil {
+		t.Fatalf("SearchRestaurants() error = %v", err)
+	}
+	if search.Criteria.Area != "渋谷" || search.Criteria.Capacity != 3 || search.Criteria.Count != restaurant.DefaultCount {
+		t.Errorf("Criteria = %+v, 渋谷・不参加を除く3名・既定件数を期待", search.Criteria)
+	}
+	if len(search.Restaurants) != 1 || search.Restaurants[0].ID != "fake_shibuya_003" {
+		t.Errorf("Restaurants = %+v, fake_shibuya_003 のみを期待", search.Restaurants)
+	}
+	if search.SearchedBy != "owner" || !search.SearchedAt.Equal(testNow) {
+		t.Errorf("検索者・検索日時が期待と異なります: %s / %s", search.SearchedBy, search.SearchedAt)
+	}
+
+	// 検索結果はイベントに保存され、再検索で置き換えられる
+	if _, err := h.SearchRestaurants(ctx, eventID, "owner", &domain.RestaurantSearchCriteria{Area: "池袋"}); err != nil {
+		t.Fatalf("SearchRestaurants() error = %v", err)
+	}
+	event, err := events.GetEvent(ctx, eventID, "owner")
+	if err != nil {
+		t.Fatalf("GetEvent() error = %v", err)
+	}
+	if event.RestaurantSearch == nil || event.RestaurantSearch.Criteria.Area != "池袋" || len(event.RestaurantSearch.Restaurants) != 2 {
+		t.Errorf("保存された検索結果が期待と異なります: %+v", event.RestaurantSearch)
+	}
+}
+
+func TestSearchRestaurantsErrors(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		userID   string
+		criteria domain.RestaurantSearchCriteria
+		wantErr  error
+	}{
+		{name: "他の幹事のイベント", userID: "someone-else", criteria: domain.RestaurantSearchCriteria{Area: "新宿"}, wantErr: ErrForbidden},
+		{name: "外部サービスの障害", userID: "owner", criteria: domain.RestaurantSearchCriteria{Area: "新宿"}, wantErr: restaurant.ErrUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, events, eventID := newTestRestaurantHandler(t)
+			if errors.Is(tt.wantErr, restaurant.ErrUnavailable) {
+				h = NewRestaurantHandler(events, unavailableProvider{})
+			}
+			if _, err := h.SearchRestaurants(ctx, eventID, tt.userID, &tt.criteria); !errors.Is(err, tt.wantErr) {
+				t.Errorf("SearchRestaurants() error = %v, %v を期待", err, tt.wantErr)
+			}
+		})
+	}
+
+	validationTests := []struct {
+		name      string
+		criteria  domain.RestaurantSearchCriteria
+		wantField string
+	}{
+		{name: "エリア未指定", criteria: domain.RestaurantSearchCriteria{Genre: "居酒屋"}, wantField: "area"},
+		{name: "予算の上限が下限未満", criteria: domain.RestaurantSearchCriteria{Area: "新宿", BudgetMin: 5000, BudgetMax: 3000}, wantField: "budgetMax"},
+		{name: "取得件数が上限超過", criteria: domain.RestaurantSearchCriteria{Area: "新宿", Count: 101}, wantField: "count"},
+	}
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _, eventID := newTestRestaurantHandler(t)
+			_, err := h.SearchRestaurants(ctx, eventID, "owner", &tt.criteria)
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("SearchRestaurants() error = %v, ValidationError を期待", err)
+			}
+			fields, _ := validationErr.Info.Details["fields"].(validation.Errors)
+			if len(fields) != 1 || fields[0].Field != tt.wantField {
+				t.Errorf("fields = %+v, %s を期待", fields, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestSuggestRestaurants(t *testing.T) {
+	ctx := context.Background()
+	events, repo, seeded := seedEvent(t, nil, nil)
+	fake, err := restaurant.NewFixtureFake()
+	if err != nil {
+		t.Fatalf("NewFixtureFake() error = %v", err)
+	}
+	h := NewRestaurantHandler(events, fake)
+	eventID := seeded.ID
+
+	if _, err := h.SuggestRestaurants(ctx, eventID, "owner"); !errors.Is(err, ErrNoRestaurantSearch) {
+		t.Fatalf("検索前の SuggestRestaurants() error = %v, ErrNoRestaurantSearch を期待", err)
+	}
+
+	// フォームの回答と同じJSON形式の好み（不参加のメンバーは考慮しない）
+	var members []domain.Member
+	if err := json.Unmarshal([]byte(`[
+		{"name": "田中", "status": "attending", "preferences": {"favoriteGenres": ["居酒屋"], "budgetRange": {"min": 2000, "max": 3000}, "alcoholPreference": "yes"}},
+		{"name": "佐藤", "status": "attending", "preferences": {"allergies": ["えび"], "favoriteGenres": ["居酒屋"], "budgetRange": {"min": 2000, "max": 4000}, "alcoholPreference": "yes"}},
+		{"name": "鈴木", "status": "declined", "preferences": {"favoriteGenres": ["アジア・エスニック料理"]}},
+		{"name": "高橋", "status": "attending", "preferences": {"dietaryRestrictions": ["ベジタリアン"], "favoriteGenres": ["和食"], "budgetRange": {"min": "3000", "max": "6000"}, "alcoholPreference": "no"}}
+	]`), &members); err != nil {
+		t.Fatal(err)
+	}
+	event, _ := repo.GetEvent(ctx, eventID)
+	event.Members = members
+	if _, err := repo.UpdateEvent(ctx, event); err != nil {
+		t.Fatalf("UpdateEvent() error = %v", err)
+	}
+	if _, err := h.SearchRestaurants(ctx, eventID, "owner", &domain.RestaurantSearchCriteria{Area: "渋谷", Capacity: 1}); err != nil {
+		t.Fatalf("SearchRestaurants() error = %v", err)
+	}
+
+	got, err := h.SuggestRestaurants(ctx, eventID, "owner")
+	if err != nil {
+		t.Fatalf("SuggestRestaurants() error = %v", err)
+	}
+	if got.Summary.MemberCount != 3 || got.Summary.TotalRestaurants != 3 {
+		t.Errorf("Summary = %+v, 参加3名・候補3件を期待", got.Summary)
+	}
+
+	want := []struct {
+		recommendationType string
+		id                 string
+	}{
+		{recommendationType: domain.RecommendationMajority, id: "fake_shibuya_003"},
+		{recommendationType: domain.RecommendationInclusive, id: "fake_shibuya_001"},
+		{recommendationType: domain.RecommendationChallenge, id: "fake_shibuya_002"},
+	}
+	if len(got.Restaurants) != len(want) {
+		t.Fatalf("Restaurants = %+v, 3件を期待", got.Restaurants)
+	}
+	for i, w := range want {
+		if s := got.Restaurants[i]; s.RecommendationType != w.recommendationType || s.ID != w.id {
+			t.Errorf("Restaurants[%d] = %s/%s, %s/%s を期待", i, s.RecommendationType, s.ID, w.recommendationType, w.id)
+		}
+	}
+
+	if _, err := h.SuggestRestaurants(ctx, eventID, "someone-else"); !errors.Is(err, ErrForbidden) {
+		t.Errorf("他の幹事の SuggestRestaurants() error = %v, ErrForbidden を期待", err)
+	}
+}
+
+func TestSelectVenue(t *testing.T) {
+	ctx := context.Background()
+	h, events, eventID := newTestRestaurantHandler(t)
+
+	tests := []struct {
+		name        string
+		req         domain.SelectVenueRequest
+		wantName    string
+		wantID      string
+		wantAddress string
+	}{
+		{
+			name:        "検索元の店舗ID",
+			req:         domain.SelectVenueRequest{RestaurantID: "fake_shinjuku_001"},
+			wantName:    "炭火焼鳥 鳥心 新宿店",
+			wantID:      "fake_shinjuku_001",
+			wantAddress: "東京都新宿区新宿3-1-1",
+		},
+		{
+			name:        "手入力",
+			req:         domain.SelectVenueRequest{Name: " 居酒屋 まる ", Address: "東京都中野区中野5-1-1", Genre: "居酒屋"},
+			wantName:    "居酒屋 まる",
+			wantAddress: "東京都中野区中野5-1-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			venue, err := h.SelectVenue(ctx, eventID, "owner", &tt.req)
+			if err != nil {
+				t.Fatalf("SelectVenue() error = %v", err)
+			}
+			if venue.Name != tt.wantName || venue.RestaurantID != tt.wantID || venue.Address != tt.wantAddress {
+				t.Errorf("Venue = %+v, %s（%q）を期待", venue, tt.wantName, tt.wantID)
+			}
+			if venue.SelectedBy != "owner" || !venue.SelectedAt.Equal(testNow) {
+				t.Errorf("決定者・決定日時が期待と異なります: %s / %s", venue.SelectedBy, venue.SelectedAt)
+			}
+
+			// 決定した会場はイベントに保存され、再決定で置き換えられる
+			event, err := events.GetEvent(ctx, eventID, "owner")
+			if err != nil {
+				t.Fatalf("GetEvent() error = %v", err)
+			}
+			if event.Venue == nil || event.Venue.Name != tt.wantName {
+				t.Errorf("保存された会場が期待と異なります: %+v", event.Venue)
+			}
+		})
+	}
+}
+
+func TestSelectVenueErrors(t *testing.T) {
+	ctx := context.Background()
+	h, _, eventID := newTestRestaurantHandler(t)
+
+	tests := []struct {
+		name      string
+		userID    string
+		req       domain.SelectVenueRequest
+		wantErr   error
+		wantField string
+	}{
+		{name: "存在しない店舗ID", userID: "owner", req: domain.SelectVenueRequest{RestaurantID: "unknown"}, wantErr: restaurant.ErrNotFound},
+		{name: "他の幹事のイベント", userID: "someone-else", req: domain.SelectVenueRequest{RestaurantID: "fake_shinjuku_001"}, wantErr: ErrForbidden},
+		{name: "店名なし", userID: "owner", req: domain.SelectVenueRequest{Address: "東京都新宿区"}, wantField: "name"},
+		{name: "住所なし", userID: "owner", req: domain.SelectVenueRequest{Name: "居酒屋 まる"}, wantField: "address"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.SelectVenue(ctx, eventID, tt.userID, &tt.req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("SelectVenue() error = %v, %v を期待", err, tt.wantErr)
+				}
+				return
+			}
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("SelectVenue() error = %v, ValidationError を期待", err)
+			}
+			fields, _ := validationErr.Info.Details["fields"].(validation.Errors)
+			if len(fields) != 1 || fields[0].Field != tt.wantField {
+				t.Errorf("fields = %+v, %s を期待", fields, tt.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/recurrence"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// SeriesHorizonDays は開催回を先行して生成する期間（今日から何日先まで）
+// 無期限のシリーズでもイベントが際限なく増えないよう、この期間を超える開催回は
+// GenerateUpcoming（定期実行や画面表示時）で順次生成する
+const SeriesHorizonDays = 90
+
+// seriesIDPattern はシリーズIDの形式（"ser_" + 32文字の16進数）
+var seriesIDPattern = regexp.MustCompile(`^ser_[a-f0-9]{32}$`)
+
+// SeriesHandler は定期開催シリーズのビジネスロジックを処理
+// 開催回の取得（権限チェック込み）は EventHandler に委譲する
+type SeriesHandler struct {
+	// events は開催回の取得・イベントIDの生成・入力値の検証を担当
+	events *EventHandler
+
+	// seriesRepo はシリーズの永続化を担当
+	seriesRepo repository.SeriesRepository
+
+	// clock は生成期間の基準となる現在時刻の取得元
+	clock clock.Clock
+
+	// idGen はシリーズIDの生成元（開催回のイベントIDはシリーズIDと開催日から決める）
+	idGen idgen.Generator
+}
+
+// NewSeriesHandler は新しいSeriesHandlerインスタンスを作成
+// opts を省略した場合はシステム時刻とUUIDによるID生成を使用する
+func NewSeriesHandler(eventHandler *EventHandler, seriesRepo repository.SeriesRepository, opts ...Option) *SeriesHandler {
+	o := newOptions(opts)
+	return &SeriesHandler{
+		events:     eventHandler,
+		seriesRepo: seriesRepo,
+		clock:      o.clock,
+		idGen:      o.idGen,
+	}
+}
+
+// CreateSeries はシリーズを作成し、生成期間内の開催回を企画中のイベントとして作成する
+func (h *SeriesHandler) CreateSeries(ctx context.Context, req *domain.CreateSeriesRequest, organizerID string) (*domain.SeriesResponse, error) {
+	lang := i18n.FromContext(ctx)
+
+	req.Title = strings.TrimSpace(norm.NFKC.String(req.Title))
+	req.Purpose = strings.TrimSpace(norm.NFKC.String(req.Purpose))
+	req.Time = strings.TrimSpace(norm.NFKC.String(req.Time))
+	req.StartDate = strings.TrimSpace(norm.NFKC.String(req.StartDate))
+	req.EndDate = strings.TrimSpace(norm.NFKC.String(req.EndDate))
+	req.Notes = strings.TrimSpace(req.Notes)
+	req.Recurrence.Weekday = strings.ToUpper(strings.TrimSpace(req.Recurrence.Weekday))
+	if err := h.events.validator.Validate(req, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+
+	// 形式は validate タグで検証済み
+	start, _ := time.Parse(recurrence.DateLayout, req.StartDate)
+	if req.EndDate != "" && req.EndDate < req.StartDate {
+		return nil, combinationError("endDate", "終了日は開始日以降の日付を指定してください", "End date must be on or after the start date", lang)
+	}
+	rule, err := recurrence.Normalize(req.Recurrence, start)
+	if err != nil {
+		return nil, combinationError("recurrence", err.Error(), "Invalid combination of recurrence fields", lang)
+	}
+
+	series := &domain.EventSeries{
+		ID:          h.idGen.NewID("ser"),
+		OrganizerID: organizerID,
+		Title:       req.Title,
+		Purpose:     h.events.getDefaultPurpose(req.Purpose),
+		Notes:       req.Notes,
+		Time:        req.Time,
+		Recurrence:  rule,
+		RRule:       recurrence.RRule(rule, req.EndDate, req.Count),
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		Count:       req.Count,
+	}
+	if _, err := h.seriesRepo.CreateSeries(ctx, series); err != nil {
+		return nil, fmt.Errorf("シリーズの作成に失敗しました: %w", err)
+	}
+
+	if err := h.generate(ctx, series); err != nil {
+		return nil, err
+	}
+	return h.seriesResponse(ctx, series, organizerID)
+}
+
+// GetSeries はシリーズと開催回（開催日の昇順）を返す（閲覧権限で利用可能）
+// シリーズの所有者以外には、閲覧できる開催回のみを返す
+func (h *SeriesHandler) GetSeries(ctx context.Context, seriesID string, userID string) (*domain.SeriesResponse, error) {
+	series, _, err := h.getSeriesFor(ctx, seriesID, userID, domain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return h.seriesResponse(ctx, series, userID)
+}
+
+// GenerateUpcoming は生成期間内でまだ作成していない開催回を作成する（編集権限が必要）
+// 何度呼び出しても同じ開催日のイベントが重複して作成されることはない
+func (h *SeriesHandler) GenerateUpcoming(ctx context.Context, seriesID string, userID string) (*domain.SeriesResponse, error) {
+	series, _, err := h.getSeriesFor(ctx, seriesID, userID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.generate(ctx, series); err != nil {
+		return nil, err
+	}
+	return h.seriesResponse(ctx, series, userID)
+}
+
+// UpdateOccurrence はシリーズの開催回を編集する
+//
+//   - scope "this": 指定した開催回のみ変更し、以降の一括編集の対象外（SeriesException）にする
+//   - scope "future": シリーズ本体と、指定した開催回以降の企画中・確定済みの開催回を変更する
+//     個別に編集した開催回は上書きしない（指定した開催回自体は常に変更する）
+//
+// 開催日の変更は繰り返しルールと矛盾するため "this" でのみ受け付ける。
+// 変更するすべての開催回の編集権限が必要で、1件でも足りない場合は何も変更しない
+func (h *SeriesHandler) UpdateOccurrence(ctx context.Context, seriesID string, eventID string, userID string, req *domain.UpdateOccurrenceRequest) (*domain.SeriesResponse, error) {
+	lang := i18n.FromContext(ctx)
+
+	req.Scope = strings.TrimSpace(req.Scope)
+	req.Title = strings.TrimSpace(norm.NFKC.String(req.Title))
+	req.Purpose = strings.TrimSpace(norm.NFKC.String(req.Purpose))
+	req.Date = strings.TrimSpace(norm.NFKC.String(req.Date))
+	req.Time = strings.TrimSpace(norm.NFKC.String(req.Time))
+	req.Notes = strings.TrimSpace(req.Notes)
+	if err := h.events.validator.Validate(req, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+	if req.Scope == domain.SeriesScopeFuture && req.Date != "" {
+		return nil, combinationError("date", "開催日は「この回のみ」の編集でのみ変更できます", "Date can only be changed for a single occurrence", lang)
+	}
+
+	series, occurrences, err := h.getSeriesFor(ctx, seriesID, userID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	target, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if target.SeriesID != series.ID {
+		return nil, fmt.Errorf("%w: %s", ErrNotInSeries, eventID)
+	}
+
+	if req.Scope == domain.SeriesScopeThis {
+		applyOccurrenceUpdate(target, req)
+		if req.Date != "" {
+			target.Date = req.Date
+		}
+		target.SeriesException = true
+		if _, err := h.events.eventRepo.UpdateEvent(ctx, target); err != nil {
+			return nil, fmt.Errorf("開催回の更新に失敗しました: %w", err)
+		}
+		return h.seriesResponse(ctx, series, userID)
+	}
+
+	// 対象の開催回を先にすべて確認し、編集できない開催回があれば途中まで変更しない
+	targets := make([]*domain.Event, 0, len(occurrences))
+	for _, event := range occurrences {
+		if event.ID != target.ID {
+			if event.Date < target.Date || event.SeriesException || event.Status == "completed" {
+				continue
+			}
+		}
+		if role := event.RoleOf(userID); !domain.RoleAllows(role, domain.PermissionEdit) {
+			return nil, fmt.Errorf("%w: %s: role=%s", ErrInsufficientPermission, event.ID, role)
+		}
+		targets = append(targets, event)
+	}
+
+	// 以降に生成する開催回にも反映されるよう、シリーズ本体を先に更新する
+	if req.Title != "" {
+		series.Title = req.Title
+	}
+	if req.Purpose != "" {
+		series.Purpose = req.Purpose
+	}
+	if req.Time != "" {
+		series.Time = req.Time
+	}
+	if req.Notes != "" {
+		series.Notes = req.Notes
+	}
+	if _, err := h.seriesRepo.UpdateSeries(ctx, series); err != nil {
+		return nil, fmt.Errorf("シリーズの更新に失敗しました: %w", err)
+	}
+
+	for _, event := range targets {
+		applyOccurrenceUpdate(event, req)
+		if _, err := h.events.eventRepo.UpdateEvent(ctx, event); err != nil {
+			return nil, fmt.Errorf("開催回の更新に失敗しました: %s: %w", event.ID, err)
+		}
+	}
+	return h.seriesResponse(ctx, series, userID)
+}
+
+// applyOccurrenceUpdate は開催日以外の指定された項目を開催回に反映する
+func applyOccurrenceUpdate(event *domain.Event, req *domain.UpdateOccurrenceRequest) {
+	if req.Title != "" {
+		event.Title = req.Title
+	}
+	if req.Purpose != "" {
+		event.Purpose = req.Purpose
+	}
+	if req.Time != "" {
+		event.Time = req.Time
+	}
+	if req.Notes != "" {
+		event.Notes = req.Notes
+	}
+}
+
+// generate は生成済みの最終日の翌日から生成期間の末日までの開催回を作成し、
+// シリーズの生成状況（GeneratedThrough・GeneratedCount）を更新する
+//
+// 開催日はシリーズの開始日を起点に計算する（隔週の週がずれないようにするため）。
+// 過去の開催日は作成しないが、開催回数（Count）には数える
+//
+// 開催回のイベントIDはシリーズと開催日から決まるため、途中で失敗した生成の再実行や
+// 同時に実行された生成で同じ開催日を作成しようとしても、作成済みの開催回として扱い重複しない
+func (h *SeriesHandler) generate(ctx context.Context, series *domain.EventSeries) error {
+	start, err := time.Parse(recurrence.DateLayout, series.StartDate)
+	if err != nil {
+		return fmt.Errorf("シリーズの開始日が不正です: %s: %w", series.StartDate, err)
+	}
+
+	today := h.clock.Now().UTC().Format(recurrence.DateLayout)
+	until := h.clock.Now().UTC().AddDate(0, 0, SeriesHorizonDays)
+	if series.EndDate != "" {
+		if end, err := time.Parse(recurrence.DateLayout, series.EndDate); err == nil && end.Before(until) {
+			until = end
+		}
+	}
+
+	generatedThrough := series.GeneratedThrough
+	var created int
+	for _, date := range recurrence.Dates(series.Recurrence, start, until, series.Count) {
+		day := date.Format(recurrence.DateLayout)
+		if day <= series.GeneratedThrough {
+			continue
+		}
+		series.GeneratedThrough = day
+		if day < today {
+			continue
+		}
+
+		event := &domain.Event{
+			ID:          occurrenceID(series.ID, day),
+			Title:       series.Title,
+			Purpose:     series.Purpose,
+			Status:      "planning",
+			Date:        day,
+			Time:        series.Time,
+			OrganizerID: series.OrganizerID,
+			Members:     []domain.Member{},
+			Notes:       series.Notes,
+			SeriesID:    series.ID,
+		}
+		if _, err := h.events.eventRepo.CreateEvent(ctx, event); err != nil && !errors.Is(err, repository.ErrAlreadyExists) {
+			return fmt.Errorf("開催回の作成に失敗しました: %s: %w", day, err)
+		}
+		created++
+	}
+
+	if series.GeneratedThrough == generatedThrough {
+		return nil
+	}
+	series.GeneratedCount += created
+	if _, err := h.seriesRepo.UpdateSeries(ctx, series); err != nil {
+		return fmt.Errorf("シリーズの生成状況の更新に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// occurrenceID はシリーズIDと開催日から開催回のイベントIDを決める
+// 形式は他のイベントと同じ "evt_" + 32文字の16進数
+func occurrenceID(seriesID string, day string) string {
+	sum := sha256.Sum256([]byte(seriesID + "#" + day))
+	return "evt_" + hex.EncodeToString(sum[:16])
+}
+
+// listOccurrences はシリーズの開催回を開催日・開始時刻の昇順で返す
+// 所有者を移譲した開催回も含めるため、シリーズIDで取得する
+func (h *SeriesHandler) listOccurrences(ctx context.Context, series *domain.EventSeries) ([]*domain.Event, error) {
+	events, err := h.events.eventRepo.ListEventsBySeries(ctx, series.ID)
+	if err != nil {
+		return nil, fmt.Errorf("開催回の取得に失敗しました: %w", err)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Date != events[j].Date {
+			return events[i].Date < events[j].Date
+		}
+		return events[i].Time < events[j].Time
+	})
+	return events, nil
+}
+
+// seriesResponse はシリーズと userID が閲覧できる開催回をレスポンスの形にまとめる
+// シリーズの所有者にはすべての開催回を返す
+func (h *SeriesHandler) seriesResponse(ctx context.Context, series *domain.EventSeries, userID string) (*domain.SeriesResponse, error) {
+	occurrences, err := h.listOccurrences(ctx, series)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*domain.Event, 0, len(occurrences))
+	for _, event := range occurrences {
+		if series.OrganizerID == userID || domain.RoleAllows(event.RoleOf(userID), domain.PermissionView) {
+			events = append(events, event)
+		}
+	}
+	return &domain.SeriesResponse{Series: series, Events: events}, nil
+}
+
+// getSeriesFor はIDの形式と権限を確認してシリーズと開催回を取得する
+// シリーズの所有者はすべての操作ができ、それ以外のユーザーは開催回のいずれかで
+// permission を許可されたロール（共同幹事・移譲先の所有者）を持つ場合に操作できる
+func (h *SeriesHandler) getSeriesFor(ctx context.Context, seriesID string, userID string, permission domain.Permission) (*domain.EventSeries, []*domain.Event, error) {
+	if !seriesIDPattern.MatchString(seriesID) {
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidSeriesID, seriesID)
+	}
+
+	series, err := h.seriesRepo.GetSeries(ctx, seriesID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("シリーズの取得に失敗しました: %w", err)
+	}
+	occurrences, err := h.listOccurrences(ctx, series)
+	if err != nil {
+		return nil, nil, err
+	}
+	if series.OrganizerID == userID {
+		return series, occurrences, nil
+	}
+
+	var roles []string
+	for _, event := range occurrences {
+		role := event.RoleOf(userID)
+		if domain.RoleAllows(role, permission) {
+			return series, occurrences, nil
+		}
+		if role != "" {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) == 0 {
+		return nil, nil, ErrForbidden
+	}
+	return nil, nil, fmt.Errorf("%w: role=%s", ErrInsufficientPermission, strings.Join(roles, ","))
+}
+
+// combinationError は validate タグでは表せない項目間の矛盾を、
+// 他の入力値エラーと同じ形式（VALIDATION_ERROR + details.fields）で返す
+func combinationError(field string, messageJA string, messageEN string, lang i18n.Language) *ValidationError {
+	message := messageJA
+	if lang == i18n.English {
+		message = messageEN
+	}
+	err := validation.Errors{{Field: field, Code: validation.CodeInvalidCombination, Message: message}}
+	return &ValidationError{Info: newValidationErrorInfo(err, lang)}
+}
@@ -0,0 +1,401 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// newTestSeriesHandler は時刻・ID生成を共有した EventHandler と SeriesHandler を作成
+// 時刻を進めて追加生成を検証できるよう、固定時計も返す
+func newTestSeriesHandler(t *testing.T) (*SeriesHandler, *EventHandler, *clock.FixedClock) {
+	t.Helper()
+	fixed := clock.NewFixedClock(testNow)
+	ids := idgen.NewSequenceGenerator()
+	events := NewEventHandler(repository.NewMemoryEventRepository(repository.WithClock(fixed)),
+		WithClock(fixed),
+		WithIDGenerator(ids),
+	)
+	series := NewSeriesHandler(events, repository.NewMemorySeriesRepository(repository.WithClock(fixed)),
+		WithClock(fixed),
+		WithIDGenerator(ids),
+	)
+	return series, events, fixed
+}
+
+// occurrenceDates は開催回の開催日を返す
+func occurrenceDates(events []*domain.Event) []string {
+	dates := make([]string, 0, len(events))
+	for _, event := range events {
+		dates = append(dates, event.Date)
+	}
+	return dates
+}
+
+func TestCreateSeries(t *testing.T) {
+	ctx := context.Background()
+	h, _, fixed := newTestSeriesHandler(t)
+
+	// 2025-09-10 から90日先（12/09）までの第3金曜
+	got, err := h.CreateSeries(ctx, &domain.CreateSeriesRequest{
+		Title:      "部署飲み",
+		Time:       "19:00",
+		StartDate:  "2025-09-10",
+		Recurrence: domain.RecurrenceRule{Frequency: "monthly", Week: 3, Weekday: "fr"},
+	}, "owner")
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+
+	if got.Series.RRule != "FREQ=MONTHLY;INTERVAL=1;BYDAY=3FR" || got.Series.Purpose != "other" {
+		t.Errorf("シリーズが期待と異なります: %+v", got.Series)
+	}
+	if dates := occurrenceDates(got.Events); len(dates) != 3 || dates[0] != "2025-09-19" || dates[2] != "2025-11-21" {
+		t.Fatalf("開催日 = %v, 9/19・10/17・11/21 を期待", dates)
+	}
+	for _, event := range got.Events {
+		if event.SeriesID != got.Series.ID || event.Status != "planning" || event.Time != "19:00" || event.OrganizerID != "owner" {
+			t.Errorf("開催回が期待と異なります: %+v", event)
+		}
+	}
+	if got.Series.GeneratedThrough != "2025-11-21" || got.Series.GeneratedCount != 3 {
+		t.Errorf("生成状況 = %q/%d, 2025-11-21/3 を期待", got.Series.GeneratedThrough, got.Series.GeneratedCount)
+	}
+
+	// 同じ日に再実行しても重複して作成しない
+	again, err := h.GenerateUpcoming(ctx, got.Series.ID, "owner")
+	if err != nil || len(again.Events) != 3 {
+		t.Fatalf("GenerateUpcoming() = %d件, error = %v, 3件のままを期待", len(again.Events), err)
+	}
+
+	// 生成状況の保存前に失敗した生成の再実行や、同時に実行された生成でも重複して作成しない
+	stale, err := h.seriesRepo.GetSeries(ctx, got.Series.ID)
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	stale.GeneratedThrough, stale.GeneratedCount = "", 0
+	if err := h.generate(ctx, stale); err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	retried, _ := h.GetSeries(ctx, got.Series.ID, "owner")
+	if len(retried.Events) != 3 || retried.Series.GeneratedThrough != "2025-11-21" || retried.Series.GeneratedCount != 3 {
+		t.Fatalf("再実行後 = %d件, 生成状況 = %q/%d, 3件・2025-11-21/3 のままを期待",
+			len(retried.Events), retried.Series.GeneratedThrough, retried.Series.GeneratedCount)
+	}
+
+	// 1か月後には12月の開催回が追加される
+	fixed.Advance(31 * 24 * time.Hour)
+	later, err := h.GenerateUpcoming(ctx, got.Series.ID, "owner")
+	if err != nil {
+		t.Fatalf("GenerateUpcoming() error = %v", err)
+	}
+	if dates := occurrenceDates(later.Events); len(dates) != 4 || dates[3] != "2025-12-19" {
+		t.Errorf("開催日 = %v, 2025-12-19 の追加を期待", dates)
+	}
+}
+
+func TestCreateSeriesCount(t *testing.T) {
+	ctx := context.Background()
+	h, _, fixed := newTestSeriesHandler(t)
+
+	got, err := h.CreateSeries(ctx, &domain.CreateSeriesRequest{
+		Title:      "週次ランチ",
+		StartDate:  "2025-09-10",
+		Count:      2,
+		Recurrence: domain.RecurrenceRule{Frequency: "weekly", Interval: 2},
+	}, "owner")
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	if dates := occurrenceDates(got.Events); len(dates) != 2 || dates[1] != "2025-09-24" {
+		t.Fatalf("開催日 = %v, 隔週水曜の2回を期待", dates)
+	}
+
+	fixed.Advance(60 * 24 * time.Hour)
+	later, err := h.GenerateUpcoming(ctx, got.Series.ID, "owner")
+	if err != nil || len(later.Events) != 2 {
+		t.Errorf("GenerateUpcoming() = %d件, error = %v, 開催回数の上限で2件のままを期待", len(later.Events), err)
+	}
+}
+
+func TestCreateSeriesValidation(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		req       domain.CreateSeriesRequest
+		wantField string
+		wantCode  string
+	}{
+		{
+			name:      "繰り返しルール未指定",
+			req:       domain.CreateSeriesRequest{Title: "部署飲み", StartDate: "2025-09-10"},
+			wantField: "recurrence.frequency",
+			wantCode:  validation.CodeRequired,
+		},
+		{
+			name:      "過去の開始日",
+			req:       domain.CreateSeriesRequest{Title: "部署飲み", StartDate: "2025-09-01", Recurrence: domain.RecurrenceRule{Frequency: "weekly"}},
+			wantField: "startDate",
+			wantCode:  validation.CodePastDate,
+		},
+		{
+			name:      "終了日が開始日より前",
+			req:       domain.CreateSeriesRequest{Title: "部署飲み", StartDate: "2025-09-30", EndDate: "2025-09-20", Recurrence: domain.RecurrenceRule{Frequency: "weekly"}},
+			wantField: "endDate",
+			wantCode:  validation.CodeInvalidCombination,
+		},
+		{
+			name:      "毎週に日付を指定",
+			req:       domain.CreateSeriesRequest{Title: "部署飲み", StartDate: "2025-09-10", Recurrence: domain.RecurrenceRule{Frequency: "weekly", MonthDay: 10}},
+			wantField: "recurrence",
+			wantCode:  validation.CodeInvalidCombination,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _, _ := newTestSeriesHandler(t)
+
+			_, err := h.CreateSeries(ctx, &tt.req, "owner")
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("CreateSeries() error = %v, ValidationError を期待", err)
+			}
+			fields, _ := validationErr.Info.Details["fields"].(validation.Errors)
+			if len(fields) != 1 || fields[0].Field != tt.wantField || fields[0].Code != tt.wantCode {
+				t.Errorf("fields = %+v, %s/%s を期待", fields, tt.wantField, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestUpdateOccurrence(t *testing.T) {
+	ctx := context.Background()
+	h, events, fixed := newTestSeriesHandler(t)
+
+	created, err := h.CreateSeries(ctx, &domain.CreateSeriesRequest{
+		Title:      "部署飲み",
+		Time:       "19:00",
+		StartDate:  "2025-09-10",
+		Recurrence: domain.RecurrenceRule{Frequency: "monthly", Week: 3, Weekday: "FR"},
+	}, "owner")
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	seriesID := created.Series.ID
+	sep, oct, nov := created.Events[0], created.Events[1], created.Events[2]
+
+	// 10月だけ日付とタイトルを変更
+	if _, err := h.UpdateOccurrence(ctx, seriesID, oct.ID, "owner", &domain.UpdateOccurrenceRequest{
+		Scope: domain.SeriesScopeThis, Title: "忘年会前の特別回", Date: "2025-10-24",
+	}); err != nil {
+		t.Fatalf("UpdateOccurrence(this) error = %v", err)
+	}
+
+	// 9月以降すべての開始時刻とタイトルを変更（個別に編集した10月は対象外）
+	got, err := h.UpdateOccurrence(ctx, seriesID, sep.ID, "owner", &domain.UpdateOccurrenceRequest{
+		Scope: domain.SeriesScopeFuture, Title: "定例会", Time: "18:30",
+	})
+	if err != nil {
+		t.Fatalf("UpdateOccurrence(future) error = %v", err)
+	}
+
+	byID := make(map[string]*domain.Event)
+	for _, event := range got.Events {
+		byID[event.ID] = event
+	}
+	if e := byID[sep.ID]; e.Title != "定例会" || e.Time != "18:30" {
+		t.Errorf("9月の開催回が更新されていません: %+v", e)
+	}
+	if e := byID[nov.ID]; e.Title != "定例会" || e.Time != "18:30" {
+		t.Errorf("11月の開催回が更新されていません: %+v", e)
+	}
+	if e := byID[oct.ID]; e.Title != "忘年会前の特別回" || e.Time != "19:00" || e.Date != "2025-10-24" || !e.SeriesException {
+		t.Errorf("個別に編集した10月の開催回が上書きされました: %+v", e)
+	}
+
+	// シリーズ本体の変更は以降に生成する開催回にも反映される
+	fixed.Advance(31 * 24 * time.Hour)
+	later, err := h.GenerateUpcoming(ctx, seriesID, "owner")
+	if err != nil {
+		t.Fatalf("GenerateUpcoming() error = %v", err)
+	}
+	if dec := later.Events[len(later.Events)-1]; dec.Date != "2025-12-19" || dec.Title != "定例会" || dec.Time != "18:30" {
+		t.Errorf("12月の開催回にシリーズの変更が反映されていません: %+v", dec)
+	}
+
+	single, err := events.CreateEvent(ctx, &domain.CreateEventRequest{Title: "単発の飲み会"}, "owner")
+	if err != nil || !single.Success {
+		t.Fatalf("テスト用イベントの作成に失敗: %v", err)
+	}
+
+	errorTests := []struct {
+		name        string
+		seriesID    string
+		eventID     string
+		organizerID string
+		wantErr     error
+	}{
+		{name: "他の幹事のシリーズ", seriesID: seriesID, eventID: nov.ID, organizerID: "someone-else", wantErr: ErrForbidden},
+		{name: "形式が不正なシリーズID", seriesID: "ser_1", eventID: nov.ID, organizerID: "owner", wantErr: ErrInvalidSeriesID},
+		{name: "存在しないシリーズ", seriesID: "ser_ffffffffffffffffffffffffffffffff", eventID: nov.ID, organizerID: "owner", wantErr: repository.ErrNotFound},
+		{name: "シリーズ外のイベント", seriesID: seriesID, eventID: single.Data.ID, organizerID: "owner", wantErr: ErrNotInSeries},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.UpdateOccurrence(ctx, tt.seriesID, tt.eventID, tt.organizerID, &domain.UpdateOccurrenceRequest{Scope: domain.SeriesScopeThis, Title: "変更"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateOccurrence() error = %v, %v を期待", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("以降すべての編集で開催日は変更できない", func(t *testing.T) {
+		_, err := h.UpdateOccurrence(ctx, seriesID, nov.ID, "owner", &domain.UpdateOccurrenceRequest{Scope: domain.SeriesScopeFuture, Date: "2025-11-28"})
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("UpdateOccurrence() error = %v, ValidationError を期待", err)
+		}
+	})
+}
+
+func TestSeriesPermissions(t *testing.T) {
+	ctx := context.Background()
+	h, events, _ := newTestSeriesHandler(t)
+
+	created, err := h.CreateSeries(ctx, &domain.CreateSeriesRequest{
+		Title:      "部署飲み",
+		Time:       "19:00",
+		StartDate:  "2025-09-10",
+		Recurrence: domain.RecurrenceRule{Frequency: "monthly", Week: 3, Weekday: "FR"},
+	}, "owner")
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	seriesID := created.Series.ID
+	sep, oct, nov := created.Events[0], created.Events[1], created.Events[2]
+
+	// 11月の開催回を移譲し（元の所有者は編集者として残る）、10月には閲覧者を招待
+	successor := inviteAndAccept(t, events, nov.ID, domain.RoleEditor, "successor")
+	if _, err := events.TransferOwnership(ctx, nov.ID, "owner", &domain.TransferOwnershipRequest{CollaboratorID: successor.ID}); err != nil {
+		t.Fatalf("TransferOwnership() error = %v", err)
+	}
+	inviteAndAccept(t, events, oct.ID, domain.RoleViewer, "viewer-user")
+
+	t.Run("移譲した開催回もシリーズに含まれ一括編集の対象になる", func(t *testing.T) {
+		got, err := h.UpdateOccurrence(ctx, seriesID, sep.ID, "owner", &domain.UpdateOccurrenceRequest{Scope: domain.SeriesScopeFuture, Time: "18:30"})
+		if err != nil {
+			t.Fatalf("UpdateOccurrence(future) error = %v", err)
+		}
+		if len(got.Events) != 3 {
+			t.Fatalf("Events = %v, 3件を期待", occurrenceDates(got.Events))
+		}
+		for _, event := range got.Events {
+			if event.Time != "18:30" {
+				t.Errorf("%s の開催回が更新されていません: %+v", event.Date, event)
+			}
+		}
+	})
+
+	t.Run("所有者以外には権限のある開催回のみを返す", func(t *testing.T) {
+		tests := []struct {
+			userID    string
+			wantDates []string
+		}{
+			{userID: "successor", wantDates: []string{nov.Date}},
+			{userID: "viewer-user", wantDates: []string{oct.Date}},
+		}
+		for _, tt := range tests {
+			got, err := h.GetSeries(ctx, seriesID, tt.userID)
+			if err != nil {
+				t.Fatalf("%s の GetSeries() error = %v", tt.userID, err)
+			}
+			if dates := occurrenceDates(got.Events); !reflect.DeepEqual(dates, tt.wantDates) {
+				t.Errorf("%s の開催日 = %v, %v を期待", tt.userID, dates, tt.wantDates)
+			}
+		}
+	})
+
+	t.Run("編集権限のある開催回は編集できる", func(t *testing.T) {
+		if _, err := h.UpdateOccurrence(ctx, seriesID, nov.ID, "successor", &domain.UpdateOccurrenceRequest{Scope: domain.SeriesScopeFuture, Title: "引き継ぎ後の定例会"}); err != nil {
+			t.Errorf("移譲先の UpdateOccurrence() error = %v", err)
+		}
+		if _, err := h.GenerateUpcoming(ctx, seriesID, "successor"); err != nil {
+			t.Errorf("移譲先の GenerateUpcoming() error = %v", err)
+		}
+	})
+
+	errorTests := []struct {
+		name    string
+		userID  string
+		call    func(userID string) error
+		wantErr error
+	}{
+		{
+			name:   "閲覧者は開催回を生成できない",
+			userID: "viewer-user",
+			call: func(userID string) error {
+				_, err := h.GenerateUpcoming(ctx, seriesID, userID)
+				return err
+			},
+			wantErr: ErrInsufficientPermission,
+		},
+		{
+			name:   "他の開催回からの一括編集",
+			userID: "successor",
+			call: func(userID string) error {
+				_, err := h.UpdateOccurrence(ctx, seriesID, sep.ID, userID, &domain.UpdateOccurrenceRequest{Scope: domain.SeriesScopeFuture, Title: "変更"})
+				return err
+			},
+			wantErr: ErrForbidden,
+		},
+		{
+			name:   "開催回に権限のないユーザー",
+			userID: "someone-else",
+			call: func(userID string) error {
+				_, err := h.GetSeries(ctx, seriesID, userID)
+				return err
+			},
+			wantErr: ErrForbidden,
+		},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(tt.userID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, %v を期待", err, tt.wantErr)
+			}
+		})
+	}
+
+	// 移譲先が元の所有者を解除すると、11月を含む一括編集は何も変更せずに失敗する
+	stored, err := events.GetEvent(ctx, nov.ID, "successor")
+	if err != nil {
+		t.Fatalf("GetEvent() error = %v", err)
+	}
+	for _, collaborator := range stored.Collaborators {
+		if collaborator.UserID == "owner" {
+			if err := events.RevokeCollaborator(ctx, nov.ID, "successor", collaborator.ID); err != nil {
+				t.Fatalf("RevokeCollaborator() error = %v", err)
+			}
+		}
+	}
+	if _, err := h.UpdateOccurrence(ctx, seriesID, sep.ID, "owner", &domain.UpdateOccurrenceRequest{Scope: domain.SeriesScopeFuture, Title: "変更"}); !errors.Is(err, ErrInsufficientPermission) {
+		t.Errorf("UpdateOccurrence(future) error = %v, ErrInsufficientPermission を期待", err)
+	}
+	got, err := h.GetSeries(ctx, seriesID, "owner")
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	if got.Series.Title == "変更" || got.Events[0].Title == "変更" {
+		t.Errorf("失敗した一括編集でシリーズ・9月の開催回が変更されました: %+v, %+v", got.Series, got.Events[0])
+	}
+}
@@ -0,0 +1,381 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// SettlementHandler は開催後の精算（割り勘・集金）のビジネスロジックを処理
+// 精算の対象は参加と回答したメンバーで、支払いそのものはアプリの外で行い、ここでは状況を記録する
+type SettlementHandler struct {
+	// events はイベントの取得（権限チェック込み）と保存を担当
+	events *EventHandler
+
+	// clock は支払い・催促・更新日時の取得元
+	clock clock.Clock
+}
+
+// NewSettlementHandler は新しいSettlementHandlerインスタンスを作成
+func NewSettlementHandler(eventHandler *EventHandler, opts ...Option) *SettlementHandler {
+	o := newOptions(opts)
+	return &SettlementHandler{
+		events: eventHandler,
+		clock:  o.clock,
+	}
+}
+
+// GetSettlement はイベントの精算を返す（閲覧権限で利用可能、未作成の場合は ErrNoSettlement）
+func (h *SettlementHandler) GetSettlement(ctx context.Context, eventID string, userID string) (*domain.Settlement, error) {
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	if event.Settlement == nil {
+		return nil, ErrNoSettlement
+	}
+	return event.Settlement, nil
+}
+
+// SaveSettlement は総額と分け方から参加メンバーごとの金額を計算して保存する（編集権限が必要）
+// 固定額のメンバーを除いた残りを重み（均等の場合は全員1）で割る
+// 既定の端数処理（organizer）では1円未満を切り捨て、足りない分は幹事の負担（OrganizerRemainder）とする。
+// 切り上げ・四捨五入で総額を超えた分は集めすぎた額（Surplus）として返す
+// 再計算しても、同じ名前のメンバーの支払い済みの額・催促の状況は引き継ぐ
+// 金額が上がったメンバーは差額を払うまで未払いとする
+func (h *SettlementHandler) SaveSettlement(ctx context.Context, eventID string, userID string, req *domain.SettlementRequest) (*domain.Settlement, error) {
+	lang := i18n.FromContext(ctx)
+	for i := range req.Tiers {
+		req.Tiers[i].Name = strings.TrimSpace(norm.NFKC.String(req.Tiers[i].Name))
+	}
+	for i := range req.Members {
+		req.Members[i].Name = strings.TrimSpace(norm.NFKC.String(req.Members[i].Name))
+		req.Members[i].Tier = strings.TrimSpace(norm.NFKC.String(req.Members[i].Tier))
+	}
+	if err := h.validateSettlementRequest(req, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+	if req.Method == "" {
+		req.Method = domain.SplitEqual
+	}
+	if req.Rounding == "" {
+		req.Rounding = domain.DefaultSettlementRounding
+	}
+
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	attendees := attendeeNames(event.Members)
+	if len(attendees) == 0 {
+		return nil, ErrNoAttendees
+	}
+
+	shares, err := splitBill(req, attendees, lang)
+	if err != nil {
+		return nil, err
+	}
+	if event.Settlement != nil {
+		carryOverPayments(shares, event.Settlement.Shares)
+	}
+
+	settlement := &domain.Settlement{
+		TotalAmount: req.TotalAmount,
+		Method:      req.Method,
+		Rounding:    req.Rounding,
+		Shares:      shares,
+		UpdatedBy:   userID,
+		UpdatedAt:   h.clock.Now(),
+	}
+	if req.Method == domain.SplitWeighted {
+		settlement.Tiers = req.Tiers
+	}
+	summarizeSettlement(settlement)
+
+	event.Settlement = settlement
+	if _, err := h.events.eventRepo.UpdateEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("精算の保存に失敗しました: %w", err)
+	}
+	return settlement, nil
+}
+
+// UpdatePayment はメンバーの支払い済み・未払いを切り替える（編集権限が必要）
+func (h *SettlementHandler) UpdatePayment(ctx context.Context, eventID string, userID string, req *domain.PaymentRequest) (*domain.Settlement, error) {
+	lang := i18n.FromContext(ctx)
+	req.Name = strings.TrimSpace(norm.NFKC.String(req.Name))
+	if err := h.events.validator.Validate(req, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	settlement := event.Settlement
+	if settlement == nil {
+		return nil, ErrNoSettlement
+	}
+
+	share := findShare(settlement.Shares, req.Name)
+	if share == nil {
+		return nil, combinationError("name",
+			"精算の対象のメンバーではありません",
+			"Member is not part of the settlement", lang)
+	}
+	share.IsPaid = req.IsPaid
+	share.PaidAt = nil
+	share.PaidAmount = 0
+	if req.IsPaid {
+		now := h.clock.Now()
+		share.PaidAt = &now
+		share.PaidAmount = share.Amount
+	}
+	settlement.UpdatedBy = userID
+	settlement.UpdatedAt = h.clock.Now()
+	summarizeSettlement(settlement)
+
+	if _, err := h.events.eventRepo.UpdateEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("支払い状況の保存に失敗しました: %w", err)
+	}
+	return settlement, nil
+}
+
+// RemindUnpaid は未払いのメンバーへの催促を記録し、送る文面を返す（編集権限が必要）
+// 催促する金額は未払いの額（再計算で金額が上がったメンバーは差額）
+// 通知は送信しないため、幹事が返却した文面をメール・チャットで送る
+func (h *SettlementHandler) RemindUnpaid(ctx context.Context, eventID string, userID string) ([]domain.PaymentReminder, error) {
+	lang := i18n.FromContext(ctx)
+	event, err := h.events.GetEventFor(ctx, eventID, userID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if event.Settlement == nil {
+		return nil, ErrNoSettlement
+	}
+
+	emails := make(map[string]string, len(event.Members))
+	for _, member := range event.Members {
+		if member.Email != "" {
+			emails[member.Name] = member.Email
+		}
+	}
+
+	now := h.clock.Now()
+	reminders := make([]domain.PaymentReminder, 0)
+	for i := range event.Settlement.Shares {
+		share := &event.Settlement.Shares[i]
+		if share.Outstanding() == 0 {
+			continue
+		}
+		share.RemindedAt = &now
+		share.ReminderCount++
+		reminders = append(reminders, domain.PaymentReminder{
+			Name:          share.Name,
+			Email:         emails[share.Name],
+			Amount:        share.Outstanding(),
+			Message:       reminderMessage(event.Title, share, lang),
+			RemindedAt:    now,
+			ReminderCount: share.ReminderCount,
+		})
+	}
+	if len(reminders) == 0 {
+		return reminders, nil
+	}
+
+	if _, err := h.events.eventRepo.UpdateEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("催促の記録に失敗しました: %w", err)
+	}
+	return reminders, nil
+}
+
+// validateSettlementRequest はリクエストと区分・メンバーの各要素を検証する
+// バリデーターはスライスの要素を検証しないため、要素のエラーは "tiers[0].name" のように添字付きのフィールド名で返す
+func (h *SettlementHandler) validateSettlementRequest(req *domain.SettlementRequest, lang i18n.Language) error {
+	var errs validation.Errors
+	collect := func(prefix string, v interface{}) {
+		err := h.events.validator.Validate(v, lang)
+		var fieldErrors validation.Errors
+		if !errors.As(err, &fieldErrors) {
+			return
+		}
+		for _, fe := range fieldErrors {
+			if prefix != "" {
+				fe.Field = prefix + "." + fe.Field
+			}
+			errs = append(errs, fe)
+		}
+	}
+
+	collect("", req)
+	for i := range req.Tiers {
+		collect(fmt.Sprintf("tiers[%d]", i), &req.Tiers[i])
+	}
+	for i := range req.Members {
+		collect(fmt.Sprintf("members[%d]", i), &req.Members[i])
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// splitBill はリクエストの分け方で参加メンバーごとの金額を計算する
+func splitBill(req *domain.SettlementRequest, attendees []string, lang i18n.Language) ([]domain.MemberShare, error) {
+	weights := make(map[string]int, len(req.Tiers))
+	for _, tier := range req.Tiers {
+		if _, exists := weights[tier.Name]; exists {
+			return nil, combinationError("tiers",
+				"区分名が重複しています",
+				"Tier names must be unique", lang)
+		}
+		weights[tier.Name] = tier.Weight
+	}
+	if req.Method == domain.SplitWeighted && len(weights) == 0 {
+		return nil, combinationError("tiers",
+			"役職などで重みをつける場合は区分を入力してください",
+			"Tiers are required for the weighted split", lang)
+	}
+
+	isAttendee := make(map[string]bool, len(attendees))
+	for _, name := range attendees {
+		isAttendee[name] = true
+	}
+	overrides := make(map[string]domain.SettlementMemberRequest, len(req.Members))
+	fixedTotal := 0
+	for _, member := range req.Members {
+		if !isAttendee[member.Name] {
+			return nil, combinationError("members",
+				fmt.Sprintf("%sさんは参加と回答したメンバーではありません", member.Name),
+				fmt.Sprintf("%s is not an attending member", member.Name), lang)
+		}
+		if _, exists := overrides[member.Name]; exists {
+			return nil, combinationError("members",
+				fmt.Sprintf("%sさんが重複しています", member.Name),
+				fmt.Sprintf("%s is listed more than once", member.Name), lang)
+		}
+		if _, exists := weights[member.Tier]; req.Method == domain.SplitWeighted && member.Tier != "" && !exists {
+			return nil, combinationError("members",
+				fmt.Sprintf("区分「%s」がありません", member.Tier),
+				fmt.Sprintf("Tier %q is not defined", member.Tier), lang)
+		}
+		overrides[member.Name] = member
+		if member.FixedAmount != nil {
+			fixedTotal += *member.FixedAmount
+		}
+	}
+	if fixedTotal > req.TotalAmount {
+		return nil, combinationError("members",
+			"固定額の合計が総額を超えています",
+			"Fixed amounts exceed the total amount", lang)
+	}
+
+	// 固定額のメンバー以外で、総額から固定額を引いた残りを重みに応じて割る
+	shares := make([]domain.MemberShare, 0, len(attendees))
+	totalWeight := 0
+	for _, name := range attendees {
+		member := overrides[name]
+		share := domain.MemberShare{Name: name}
+		if req.Method == domain.SplitWeighted {
+			share.Tier = member.Tier
+		}
+		if member.FixedAmount != nil {
+			share.IsFixed = true
+			share.Amount = *member.FixedAmount
+		} else {
+			totalWeight += shareWeight(share, weights)
+		}
+		shares = append(shares, share)
+	}
+	rest := req.TotalAmount - fixedTotal
+	for i := range shares {
+		if !shares[i].IsFixed {
+			shares[i].Amount = domain.PerPerson(rest*shareWeight(shares[i], weights), totalWeight, req.Rounding)
+		}
+	}
+	return shares, nil
+}
+
+// shareWeight はメンバーの重みを返す（区分の指定がない場合は1）
+func shareWeight(share domain.MemberShare, weights map[string]int) int {
+	if weight, ok := weights[share.Tier]; ok {
+		return weight
+	}
+	return 1
+}
+
+// attendeeNames は参加と回答したメンバーの名前をメンバーの順に返す（同じ名前は1人とする）
+func attendeeNames(members []domain.Member) []string {
+	names := make([]string, 0, len(members))
+	seen := make(map[string]bool)
+	for _, member := range membersByStatus(members, "attending") {
+		if !seen[member.Name] {
+			seen[member.Name] = true
+			names = append(names, member.Name)
+		}
+	}
+	return names
+}
+
+// carryOverPayments は再計算前の支払い済みの額・催促の状況を同じ名前のメンバーに引き継ぐ
+// 支払い済みとするのは、支払い済みの額が再計算後の金額以上の場合のみ
+func carryOverPayments(shares []domain.MemberShare, previous []domain.MemberShare) {
+	for i := range shares {
+		if old := findShare(previous, shares[i].Name); old != nil {
+			shares[i].PaidAmount = old.PaidAmount
+			shares[i].PaidAt = old.PaidAt
+			shares[i].IsPaid = old.PaidAmount > 0 && old.PaidAmount >= shares[i].Amount
+			shares[i].RemindedAt = old.RemindedAt
+			shares[i].ReminderCount = old.ReminderCount
+		}
+	}
+}
+
+// findShare は名前でメンバーの金額を探す（見つからない場合は nil）
+func findShare(shares []domain.MemberShare, name string) *domain.MemberShare {
+	for i := range shares {
+		if shares[i].Name == name {
+			return &shares[i]
+		}
+	}
+	return nil
+}
+
+// summarizeSettlement は支払い済み・未払い・返金する金額と、幹事の負担額・集めすぎた額を計算し直す
+func summarizeSettlement(settlement *domain.Settlement) {
+	settlement.CollectedAmount, settlement.UnpaidAmount, settlement.UnpaidCount, settlement.OverpaidAmount = 0, 0, 0, 0
+	sum := 0
+	for _, share := range settlement.Shares {
+		sum += share.Amount
+		settlement.CollectedAmount += share.PaidAmount
+		settlement.OverpaidAmount += max(share.PaidAmount-share.Amount, 0)
+		if outstanding := share.Outstanding(); outstanding > 0 {
+			settlement.UnpaidAmount += outstanding
+			settlement.UnpaidCount++
+		}
+	}
+	settlement.OrganizerRemainder = max(settlement.TotalAmount-sum, 0)
+	settlement.Surplus = max(sum-settlement.TotalAmount, 0)
+}
+
+// reminderMessage は催促の文面を返す（一部を支払い済みの場合は、金額の変更による差額として伝える）
+func reminderMessage(title string, share *domain.MemberShare, lang i18n.Language) string {
+	partial := share.PaidAmount > 0
+	if lang == i18n.English {
+		if partial {
+			return fmt.Sprintf("Payment reminder for %q: %s, your share has changed and %d yen remains to be paid.", title, share.Name, share.Outstanding())
+		}
+		return fmt.Sprintf("Payment reminder for %q: %s, your share is %d yen.", title, share.Name, share.Amount)
+	}
+	if partial {
+		return fmt.Sprintf("「%s」の精算のお願い: 金額の変更により、%sさんのお支払い額は残り%d円です。", title, share.Name, share.Outstanding())
+	}
+	return fmt.Sprintf("「%s」の精算のお願い: %sさんのお支払い額は%d円です。", title, share.Name, share.Amount)
+}
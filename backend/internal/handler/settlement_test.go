@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// newTestSettlementHandler は参加3名・不参加1名のメンバーがいるイベントと、その SettlementHandler を作成
+func newTestSettlementHandler(t *testing.T) (*SettlementHandler, string) {
+	t.Helper()
+	events, _, event := seedEvent(t, []domain.Member{
+		{Name: "田中", Email: "tanaka@example.com", Status: "attending"},
+		{Name: "佐藤", Status: "attending"},
+		{Name: "鈴木", Email: "suzuki@example.com", Status: "attending"},
+		{Name: "伊藤", Status: "declined"},
+	}, func(event *domain.Event) {
+		event.Title = "歓送迎会"
+		event.Date = "2099-04-10"
+	})
+	return NewSettlementHandler(events, WithClock(clock.NewFixedClock(testNow))), event.ID
+}
+
+// shareAmounts はメンバーの名前ごとの金額を返す
+func shareAmounts(settlement *domain.Settlement) map[string]int {
+	amounts := make(map[string]int, len(settlement.Shares))
+	for _, share := range settlement.Shares {
+		amounts[share.Name] = share.Amount
+	}
+	return amounts
+}
+
+func intPtr(v int) *int { return &v }
+
+func TestSaveSettlement(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           domain.SettlementRequest
+		wantAmounts   map[string]int
+		wantRemainder int
+		wantSurplus   int
+	}{
+		{
+			name:          "均等・端数は幹事の負担（既定）",
+			req:           domain.SettlementRequest{TotalAmount: 10000},
+			wantAmounts:   map[string]int{"田中": 3333, "佐藤": 3333, "鈴木": 3333},
+			wantRemainder: 1,
+		},
+		{
+			name:        "均等・1円単位に切り上げ",
+			req:         domain.SettlementRequest{TotalAmount: 10000, Rounding: domain.RoundingNone},
+			wantAmounts: map[string]int{"田中": 3334, "佐藤": 3334, "鈴木": 3334},
+			wantSurplus: 2,
+		},
+		{
+			name:          "均等・100円単位に切り捨て",
+			req:           domain.SettlementRequest{TotalAmount: 10000, Rounding: domain.RoundingFloor100},
+			wantAmounts:   map[string]int{"田中": 3300, "佐藤": 3300, "鈴木": 3300},
+			wantRemainder: 100,
+		},
+		{
+			name: "区分の重み",
+			req: domain.SettlementRequest{
+				TotalAmount: 20000, Method: domain.SplitWeighted, Rounding: domain.RoundingCeil100,
+				Tiers:   []domain.SettlementTier{{Name: "部長", Weight: 2}, {Name: "一般", Weight: 1}},
+				Members: []domain.SettlementMemberRequest{{Name: "田中", Tier: "部長"}, {Name: "佐藤", Tier: "一般"}},
+			},
+			wantAmounts:   map[string]int{"田中": 10000, "佐藤": 5000, "鈴木": 5000},
+			wantRemainder: 0,
+		},
+		{
+			name: "固定額のメンバーを除いて均等",
+			req: domain.SettlementRequest{
+				TotalAmount: 12000, Rounding: domain.RoundingCeil1000,
+				Members: []domain.SettlementMemberRequest{{Name: " 鈴木 ", FixedAmount: intPtr(1500)}},
+			},
+			wantAmounts: map[string]int{"田中": 6000, "佐藤": 6000, "鈴木": 1500},
+			wantSurplus: 1500,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, eventID := newTestSettlementHandler(t)
+
+			settlement, err := h.SaveSettlement(context.Background(), eventID, "owner", &tt.req)
+			if err != nil {
+				t.Fatalf("SaveSettlement() error = %v", err)
+			}
+			got := shareAmounts(settlement)
+			if len(got) != len(tt.wantAmounts) {
+				t.Fatalf("Shares = %+v, 参加メンバーのみを期待", settlement.Shares)
+			}
+			for name, want := range tt.wantAmounts {
+				if got[name] != want {
+					t.Errorf("%s の Amount = %d, %d を期待", name, got[name], want)
+				}
+			}
+			if settlement.OrganizerRemainder != tt.wantRemainder || settlement.Surplus != tt.wantSurplus {
+				t.Errorf("OrganizerRemainder = %d, Surplus = %d, %d・%d を期待",
+					settlement.OrganizerRemainder, settlement.Surplus, tt.wantRemainder, tt.wantSurplus)
+			}
+			if settlement.UnpaidCount != 3 || settlement.CollectedAmount != 0 {
+				t.Errorf("UnpaidCount = %d, CollectedAmount = %d, 全員未払いを期待", settlement.UnpaidCount, settlement.CollectedAmount)
+			}
+
+			saved, err := h.GetSettlement(context.Background(), eventID, "owner")
+			if err != nil || saved.TotalAmount != tt.req.TotalAmount {
+				t.Errorf("GetSettlement() = %+v, %v, 保存した精算を期待", saved, err)
+			}
+		})
+	}
+}
+
+func TestSaveSettlementValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       domain.SettlementRequest
+		wantField string
+	}{
+		{name: "総額なし", req: domain.SettlementRequest{}, wantField: "totalAmount"},
+		{name: "未知の分け方", req: domain.SettlementRequest{TotalAmount: 1000, Method: "random"}, wantField: "method"},
+		{
+			name: "区分の重みが範囲外",
+			req: domain.SettlementRequest{TotalAmount: 1000, Method: domain.SplitWeighted,
+				Tiers: []domain.SettlementTier{{Name: "一般", Weight: 1}, {Name: "部長", Weight: 0}}},
+			wantField: "tiers[1].weight",
+		},
+		{name: "区分なしの重みづけ", req: domain.SettlementRequest{TotalAmount: 1000, Method: domain.SplitWeighted}, wantField: "tiers"},
+		{
+			name: "区分名の重複",
+			req: domain.SettlementRequest{TotalAmount: 1000, Method: domain.SplitWeighted,
+				Tiers: []domain.SettlementTier{{Name: "一般", Weight: 1}, {Name: "一般", Weight: 2}}},
+			wantField: "tiers",
+		},
+		{
+			name: "未定義の区分",
+			req: domain.SettlementRequest{TotalAmount: 1000, Method: domain.SplitWeighted,
+				Tiers:   []domain.SettlementTier{{Name: "一般", Weight: 1}},
+				Members: []domain.SettlementMemberRequest{{Name: "田中", Tier: "部長"}}},
+			wantField: "members",
+		},
+		{
+			name:      "参加していないメンバー",
+			req:       domain.SettlementRequest{TotalAmount: 1000, Members: []domain.SettlementMemberRequest{{Name: "伊藤", FixedAmount: intPtr(0)}}},
+			wantField: "members",
+		},
+		{
+			name:      "固定額の合計が総額を超える",
+			req:       domain.SettlementRequest{TotalAmount: 1000, Members: []domain.SettlementMemberRequest{{Name: "田中", FixedAmount: intPtr(1001)}}},
+			wantField: "members",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, eventID := newTestSettlementHandler(t)
+
+			_, err := h.SaveSettlement(context.Background(), eventID, "owner", &tt.req)
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("SaveSettlement() error = %v, ValidationError を期待", err)
+			}
+			fields, _ := validationErr.Info.Details["fields"].(validation.Errors)
+			if len(fields) == 0 || fields[0].Field != tt.wantField {
+				t.Errorf("fields = %+v, %s を期待", fields, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestSettlementPaymentsAndReminders(t *testing.T) {
+	ctx := context.Background()
+	h, eventID := newTestSettlementHandler(t)
+
+	if _, err := h.UpdatePayment(ctx, eventID, "owner", &domain.PaymentRequest{Name: "田中", IsPaid: true}); !errors.Is(err, ErrNoSettlement) {
+		t.Fatalf("精算作成前の UpdatePayment() error = %v, ErrNoSettlement を期待", err)
+	}
+	if _, err := h.SaveSettlement(ctx, eventID, "owner", &domain.SettlementRequest{TotalAmount: 9000}); err != nil {
+		t.Fatalf("SaveSettlement() error = %v", err)
+	}
+
+	settlement, err := h.UpdatePayment(ctx, eventID, "owner", &domain.PaymentRequest{Name: "田中", IsPaid: true})
+	if err != nil {
+		t.Fatalf("UpdatePayment() error = %v", err)
+	}
+	if settlement.CollectedAmount != 3000 || settlement.UnpaidAmount != 6000 || settlement.UnpaidCount != 2 {
+		t.Errorf("CollectedAmount = %d, UnpaidAmount = %d, UnpaidCount = %d, 3000・6000・2 を期待",
+			settlement.CollectedAmount, settlement.UnpaidAmount, settlement.UnpaidCount)
+	}
+	if _, err := h.UpdatePayment(ctx, eventID, "owner", &domain.PaymentRequest{Name: "伊藤", IsPaid: true}); err == nil {
+		t.Error("精算の対象外のメンバーでエラーを期待")
+	}
+
+	reminders, err := h.RemindUnpaid(ctx, eventID, "owner")
+	if err != nil {
+		t.Fatalf("RemindUnpaid() error = %v", err)
+	}
+	if len(reminders) != 2 || reminders[0].Name != "佐藤" || reminders[1].Email != "suzuki@example.com" {
+		t.Fatalf("reminders = %+v, 未払いの佐藤・鈴木を期待", reminders)
+	}
+	if want := "「歓送迎会」の精算のお願い: 佐藤さんのお支払い額は3000円です。"; reminders[0].Message != want {
+		t.Errorf("Message = %q, %q を期待", reminders[0].Message, want)
+	}
+	if _, err := h.RemindUnpaid(ctx, eventID, "owner"); err != nil {
+		t.Fatalf("RemindUnpaid() error = %v", err)
+	}
+
+	// 総額を上げて再計算すると、支払い済みの額・催促の状況は引き継ぎ、差額は未払いになる
+	settlement, err = h.SaveSettlement(ctx, eventID, "owner", &domain.SettlementRequest{TotalAmount: 12000})
+	if err != nil {
+		t.Fatalf("SaveSettlement() error = %v", err)
+	}
+	tanaka, sato := findShare(settlement.Shares, "田中"), findShare(settlement.Shares, "佐藤")
+	if tanaka.IsPaid || tanaka.PaidAmount != 3000 || tanaka.PaidAt == nil || tanaka.Amount != 4000 {
+		t.Errorf("田中 = %+v, 3000円支払い済み・4000円のため未払いを期待", tanaka)
+	}
+	if sato.IsPaid || sato.ReminderCount != 2 || sato.RemindedAt == nil {
+		t.Errorf("佐藤 = %+v, 未払い・催促2回を期待", sato)
+	}
+	if settlement.CollectedAmount != 3000 || settlement.UnpaidAmount != 9000 || settlement.UnpaidCount != 3 {
+		t.Errorf("CollectedAmount = %d, UnpaidAmount = %d, UnpaidCount = %d, 3000・9000・3 を期待",
+			settlement.CollectedAmount, settlement.UnpaidAmount, settlement.UnpaidCount)
+	}
+	reminders, err = h.RemindUnpaid(ctx, eventID, "owner")
+	if err != nil {
+		t.Fatalf("RemindUnpaid() error = %v", err)
+	}
+	if len(reminders) != 3 || reminders[0].Name != "田中" || reminders[0].Amount != 1000 {
+		t.Fatalf("reminders = %+v, 田中の差額1000円を含む3件を期待", reminders)
+	}
+	if want := "「歓送迎会」の精算のお願い: 金額の変更により、田中さんのお支払い額は残り1000円です。"; reminders[0].Message != want {
+		t.Errorf("Message = %q, %q を期待", reminders[0].Message, want)
+	}
+
+	// 総額を下げて再計算すると、支払い済みの額が金額以上のため支払い済みとし、超えた分は返金する額になる
+	settlement, err = h.SaveSettlement(ctx, eventID, "owner", &domain.SettlementRequest{TotalAmount: 6000})
+	if err != nil {
+		t.Fatalf("SaveSettlement() error = %v", err)
+	}
+	if tanaka := findShare(settlement.Shares, "田中"); !tanaka.IsPaid || tanaka.Amount != 2000 {
+		t.Errorf("田中 = %+v, 2000円・支払い済みを期待", tanaka)
+	}
+	if settlement.CollectedAmount != 3000 || settlement.UnpaidAmount != 4000 || settlement.OverpaidAmount != 1000 {
+		t.Errorf("CollectedAmount = %d, UnpaidAmount = %d, OverpaidAmount = %d, 3000・4000・1000 を期待",
+			settlement.CollectedAmount, settlement.UnpaidAmount, settlement.OverpaidAmount)
+	}
+
+	settlement, err = h.UpdatePayment(ctx, eventID, "owner", &domain.PaymentRequest{Name: "田中", IsPaid: false})
+	if err != nil {
+		t.Fatalf("UpdatePayment() error = %v", err)
+	}
+	if tanaka := findShare(settlement.Shares, "田中"); tanaka.IsPaid || tanaka.PaidAt != nil || tanaka.PaidAmount != 0 {
+		t.Errorf("田中 = %+v, 未払いに戻すと PaidAt・PaidAmount もなくなることを期待", tanaka)
+	}
+}
+
+func TestSettlementPermissions(t *testing.T) {
+	ctx := context.Background()
+	h, eventID := newTestSettlementHandler(t)
+
+	if _, err := h.GetSettlement(ctx, eventID, "owner"); !errors.Is(err, ErrNoSettlement) {
+		t.Errorf("GetSettlement() error = %v, ErrNoSettlement を期待", err)
+	}
+	if _, err := h.SaveSettlement(ctx, eventID, "stranger", &domain.SettlementRequest{TotalAmount: 1000}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("他のユーザーの SaveSettlement() error = %v, ErrForbidden を期待", err)
+	}
+	if _, err := h.RemindUnpaid(ctx, eventID, "stranger"); !errors.Is(err, ErrForbidden) {
+		t.Errorf("他のユーザーの RemindUnpaid() error = %v, ErrForbidden を期待", err)
+	}
+}
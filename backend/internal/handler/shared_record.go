@@ -0,0 +1,343 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// ReportsToHide は共有記録を審査待ちにして非表示にする不適切報告の件数（報告者の人数）
+const ReportsToHide = 3
+
+// 共有記録の一覧のページ分割の既定値
+const (
+	defaultSharedRecordPage  = 1
+	defaultSharedRecordLimit = 10
+)
+
+// maskedName はメンバーの名前を伏せ字にした文字列
+const maskedName = "○○"
+
+// ShareRecord は記録を「みんなの記録」に公開し、公開した内容を返す
+// イベント名・コメントに含まれるメンバーの名前は公開時に伏せ字にする
+// 公開中に再度呼び出すとコメント・表示名を置き換える（いいねは引き継ぐ）
+func (h *RecordHandler) ShareRecord(ctx context.Context, recordID string, organizerID string, req *domain.ShareRecordRequest) (*domain.SharedRecord, error) {
+	lang := i18n.FromContext(ctx)
+	req.ShareNote = strings.TrimSpace(req.ShareNote)
+	req.DisplayName = strings.TrimSpace(norm.NFKC.String(req.DisplayName))
+	if err := h.events.validator.Validate(req, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+
+	record, err := h.getOwnedRecord(ctx, recordID, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	if record.ModerationStatus == domain.ModerationPendingReview {
+		return nil, ErrRecordUnderReview
+	}
+
+	names, err := h.memberNames(ctx, record.EventID)
+	if err != nil {
+		return nil, err
+	}
+	hidePersonalInfo := req.HidePersonalInfo == nil || *req.HidePersonalInfo
+	record.IsShared = true
+	record.Share = &domain.RecordShare{
+		Title:            maskNames(record.Event.Title, names),
+		Note:             maskNames(req.ShareNote, names),
+		DisplayName:      req.DisplayName,
+		HidePersonalInfo: hidePersonalInfo,
+		SharedAt:         h.clock.Now(),
+	}
+
+	updated, err := h.recordRepo.UpdateRecord(ctx, record)
+	if err != nil {
+		return nil, fmt.Errorf("記録の共有に失敗しました: %w", err)
+	}
+	shared := toSharedRecord(updated, organizerID)
+	return &shared, nil
+}
+
+// UnshareRecord は記録の公開を停止する（いいね・不適切報告は再公開に備えて残す）
+func (h *RecordHandler) UnshareRecord(ctx context.Context, recordID string, organizerID string) error {
+	record, err := h.getOwnedRecord(ctx, recordID, organizerID)
+	if err != nil {
+		return err
+	}
+	if !record.IsShared {
+		return nil
+	}
+
+	record.IsShared = false
+	if _, err := h.recordRepo.UpdateRecord(ctx, record); err != nil {
+		return fmt.Errorf("記録の共有停止に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// ListSharedRecords は「みんなの記録」を公開日時の新しい順に絞り込んで返す
+// 審査待ちの記録は含めない
+func (h *RecordHandler) ListSharedRecords(ctx context.Context, userID string, query *domain.SharedRecordQuery) (*domain.SharedRecordPage, error) {
+	lang := i18n.FromContext(ctx)
+	query.Area = strings.TrimSpace(norm.NFKC.String(query.Area))
+	query.Genre = strings.TrimSpace(norm.NFKC.String(query.Genre))
+	if query.Page == 0 {
+		query.Page = defaultSharedRecordPage
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultSharedRecordLimit
+	}
+	if err := h.events.validator.Validate(query, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+	if query.MaxPrice > 0 && query.MinPrice > query.MaxPrice {
+		return nil, combinationError("maxPrice",
+			"最高金額は最低金額以上で入力してください",
+			"Max price must be greater than or equal to min price", lang)
+	}
+
+	records, err := h.recordRepo.ListSharedRecords(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("共有記録一覧の取得に失敗しました: %w", err)
+	}
+
+	matched := make([]*domain.EventRecord, 0, len(records))
+	for _, record := range records {
+		if isVisibleShared(record) && matchesSharedQuery(record, userID, query) {
+			matched = append(matched, record)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].Share.SharedAt.Equal(matched[j].Share.SharedAt) {
+			return matched[i].Share.SharedAt.After(matched[j].Share.SharedAt)
+		}
+		return matched[i].ID < matched[j].ID
+	})
+
+	start := min((query.Page-1)*query.Limit, len(matched))
+	end := min(start+query.Limit, len(matched))
+	page := &domain.SharedRecordPage{
+		Records: make([]domain.SharedRecord, 0, end-start),
+		Pagination: domain.Pagination{
+			Page:       query.Page,
+			Limit:      query.Limit,
+			Total:      len(matched),
+			TotalPages: (len(matched) + query.Limit - 1) / query.Limit,
+		},
+	}
+	for _, record := range matched[start:end] {
+		page.Records = append(page.Records, toSharedRecord(record, userID))
+	}
+	return page, nil
+}
+
+// LikeRecord は共有記録にいいねする（いいね済みの場合は何もしない）
+// 自分の記録にはいいねできない
+func (h *RecordHandler) LikeRecord(ctx context.Context, recordID string, userID string) (*domain.RecordLikes, error) {
+	record, err := h.getSharedRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+	if record.OrganizerID == userID {
+		return nil, ErrOwnRecord
+	}
+
+	// 並行するいいねを失わないよう、追加はリポジトリで原子的に行う
+	updated, err := h.recordRepo.AddLike(ctx, recordID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("いいねの保存に失敗しました: %w", err)
+	}
+	return &domain.RecordLikes{LikeCount: len(updated.LikedBy), IsLiked: true}, nil
+}
+
+// UnlikeRecord は共有記録のいいねを取り消す（いいねしていない場合は何もしない）
+func (h *RecordHandler) UnlikeRecord(ctx context.Context, recordID string, userID string) (*domain.RecordLikes, error) {
+	if _, err := h.getSharedRecord(ctx, recordID); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.recordRepo.RemoveLike(ctx, recordID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("いいねの取り消しに失敗しました: %w", err)
+	}
+	return &domain.RecordLikes{LikeCount: len(updated.LikedBy), IsLiked: false}, nil
+}
+
+// ReportRecord は共有記録の不適切報告を受け付ける
+// 同じユーザーの報告は1件として扱い、報告者が ReportsToHide 人に達した記録は審査待ちにして一覧から外す
+// 自分の記録は報告できない
+func (h *RecordHandler) ReportRecord(ctx context.Context, recordID string, userID string, req *domain.ReportRecordRequest) error {
+	lang := i18n.FromContext(ctx)
+	req.Comment = strings.TrimSpace(req.Comment)
+	if err := h.events.validator.Validate(req, lang); err != nil {
+		return &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+
+	record, err := h.getSharedRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+	if record.OrganizerID == userID {
+		return ErrOwnRecord
+	}
+
+	// 報告済みの判定・追記・審査待ちへの変更は、並行する報告を失わないようリポジトリで原子的に行う
+	report := domain.RecordReport{
+		ReporterID: userID,
+		Reason:     req.Reason,
+		Comment:    req.Comment,
+		ReportedAt: h.clock.Now(),
+	}
+	if _, err := h.recordRepo.AddReport(ctx, recordID, report, ReportsToHide); err != nil {
+		return fmt.Errorf("不適切報告の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// getSharedRecord は一覧に表示中の共有記録を取得する
+// 共有していない・審査待ちの記録は存在しないものとして扱う
+func (h *RecordHandler) getSharedRecord(ctx context.Context, recordID string) (*domain.EventRecord, error) {
+	if !recordIDPattern.MatchString(recordID) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRecordID, recordID)
+	}
+
+	record, err := h.recordRepo.GetRecord(ctx, recordID)
+	if err != nil {
+		return nil, fmt.Errorf("記録の取得に失敗しました: %w", err)
+	}
+	if !isVisibleShared(record) {
+		return nil, fmt.Errorf("共有記録が見つかりません: %s: %w", recordID, repository.ErrNotFound)
+	}
+	return record, nil
+}
+
+// memberNames は伏せ字にするイベントのメンバーの名前を返す
+// イベントが削除済みの場合は伏せ字にする名前がないため空を返す
+func (h *RecordHandler) memberNames(ctx context.Context, eventID string) ([]string, error) {
+	event, err := h.events.eventRepo.GetEvent(ctx, eventID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("イベントの取得に失敗しました: %w", err)
+	}
+
+	names := make([]string, 0, len(event.Members))
+	for _, member := range event.Members {
+		names = append(names, member.Name)
+	}
+	return names, nil
+}
+
+// maskNames は text に含まれる名前を伏せ字にする
+// 1文字の名前は一般的な語と区別できないため対象外とし、長い名前から置き換えて部分一致の取り残しを防ぐ
+func maskNames(text string, names []string) string {
+	sorted := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(norm.NFKC.String(name))
+		if utf8.RuneCountInString(name) >= 2 {
+			sorted = append(sorted, name)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return utf8.RuneCountInString(sorted[i]) > utf8.RuneCountInString(sorted[j])
+	})
+
+	text = norm.NFKC.String(text)
+	for _, name := range sorted {
+		text = strings.ReplaceAll(text, name, maskedName)
+	}
+	return text
+}
+
+// isVisibleShared は記録が「みんなの記録」に表示できる状態かどうかを返す
+func isVisibleShared(record *domain.EventRecord) bool {
+	return record.IsShared && record.Share != nil && record.ModerationStatus != domain.ModerationPendingReview
+}
+
+// matchesSharedQuery は記録が絞り込み条件に一致するかどうかを返す
+// 金額の条件を指定した場合、総額を記録していない（1人あたり0円の）記録は除く
+func matchesSharedQuery(record *domain.EventRecord, userID string, query *domain.SharedRecordQuery) bool {
+	if query.Purpose != "" && record.Event.Purpose != query.Purpose {
+		return false
+	}
+	if query.Area != "" {
+		if record.Venue == nil || (!strings.Contains(record.Venue.Area, query.Area) && !strings.Contains(record.Venue.Address, query.Area)) {
+			return false
+		}
+	}
+	if query.Genre != "" && (record.Venue == nil || !strings.Contains(record.Venue.Genre, query.Genre)) {
+		return false
+	}
+	if query.MinPrice > 0 || query.MaxPrice > 0 {
+		if record.CostPerPerson == 0 || record.CostPerPerson < query.MinPrice {
+			return false
+		}
+		if query.MaxPrice > 0 && record.CostPerPerson > query.MaxPrice {
+			return false
+		}
+	}
+	if query.Liked && !slices.Contains(record.LikedBy, userID) {
+		return false
+	}
+	return true
+}
+
+// toSharedRecord は記録を公開用の形式に変換する（userID はいいね済みかどうかの判定に使う）
+// 総額・記録のメモ・会場を決定した人は公開しない
+func toSharedRecord(record *domain.EventRecord, userID string) domain.SharedRecord {
+	organizer := domain.SharedRecordOrganizer{Name: "匿名の幹事"}
+	if !record.Share.HidePersonalInfo {
+		organizer = domain.SharedRecordOrganizer{ID: record.OrganizerID, Name: record.Share.DisplayName}
+		if organizer.Name == "" {
+			organizer.Name = "幹事"
+		}
+	}
+
+	var venue *domain.SharedVenue
+	if v := record.Venue; v != nil {
+		venue = &domain.SharedVenue{
+			Name:           v.Name,
+			Address:        v.Address,
+			Phone:          v.Phone,
+			MapURL:         v.MapURL,
+			Genre:          v.Genre,
+			Area:           v.Area,
+			ReservationURL: v.ReservationURL,
+		}
+	}
+
+	return domain.SharedRecord{
+		ID: record.ID,
+		EventLog: domain.SharedEventLog{
+			ID:            record.ID,
+			Rating:        record.Rating,
+			Notes:         record.Share.Note,
+			CostPerPerson: record.CostPerPerson,
+			Attendees:     record.Attendees,
+			Venue:         venue,
+			CreatedAt:     record.CreatedAt,
+		},
+		Event: domain.SharedRecordEvent{
+			Title:   record.Share.Title,
+			Purpose: record.Event.Purpose,
+		},
+		Organizer:        organizer,
+		LikeCount:        len(record.LikedBy),
+		IsLiked:          slices.Contains(record.LikedBy, userID),
+		ParticipantCount: record.Attendees,
+		EventDate:        record.Event.Date,
+		SharedAt:         record.Share.SharedAt,
+	}
+}
@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// addSharedRecord は記録を保存して「みんなの記録」に公開し、記録IDを返す
+// 対象のイベントが存在しない記録のため、メンバーの名前の伏せ字は行われない
+func addSharedRecord(t *testing.T, h *RecordHandler, record domain.EventRecord) string {
+	t.Helper()
+	ctx := context.Background()
+	record.ID = h.idGen.NewID("rec")
+	if record.OrganizerID == "" {
+		record.OrganizerID = "owner"
+	}
+	if _, err := h.recordRepo.CreateRecord(ctx, &record); err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+	if _, err := h.ShareRecord(ctx, record.ID, record.OrganizerID, &domain.ShareRecordRequest{}); err != nil {
+		t.Fatalf("ShareRecord() error = %v", err)
+	}
+	return record.ID
+}
+
+func TestShareRecord(t *testing.T) {
+	ctx := context.Background()
+	h, eventID := newTestRecordHandler(t, true)
+	record, err := h.CreateRecord(ctx, "owner", &domain.CreateRecordRequest{
+		EventID: eventID, Rating: 5, Notes: "田中さんが遅刻", TotalCost: 12000,
+	})
+	if err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+
+	if _, err := h.ShareRecord(ctx, record.ID, "someone-else", &domain.ShareRecordRequest{}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("他の幹事の ShareRecord() error = %v, ErrForbidden を期待", err)
+	}
+
+	shared, err := h.ShareRecord(ctx, record.ID, "owner", &domain.ShareRecordRequest{ShareNote: "田中さん・佐藤さんも満足のお店でした"})
+	if err != nil {
+		t.Fatalf("ShareRecord() error = %v", err)
+	}
+	if shared.EventLog.Notes != "○○さん・○○さんも満足のお店でした" {
+		t.Errorf("Notes = %q, メンバーの名前の伏せ字を期待（記録のメモは公開しない）", shared.EventLog.Notes)
+	}
+	if shared.Organizer != (domain.SharedRecordOrganizer{Name: "匿名の幹事"}) {
+		t.Errorf("Organizer = %+v, 省略時は幹事の情報を伏せることを期待", shared.Organizer)
+	}
+	if shared.EventLog.CostPerPerson != 4000 || shared.ParticipantCount != 3 || shared.EventDate != "2099-04-10" {
+		t.Errorf("SharedRecord = %+v", shared)
+	}
+	if shared.EventLog.Venue == nil || shared.EventLog.Venue.Name != "炭火焼鳥 鳥心 新宿店" {
+		t.Errorf("Venue = %+v, 記録の会場を期待", shared.EventLog.Venue)
+	}
+
+	hide := false
+	shared, err = h.ShareRecord(ctx, record.ID, "owner", &domain.ShareRecordRequest{DisplayName: "山田", HidePersonalInfo: &hide})
+	if err != nil {
+		t.Fatalf("2回目の ShareRecord() error = %v", err)
+	}
+	if shared.Organizer != (domain.SharedRecordOrganizer{ID: "owner", Name: "山田"}) {
+		t.Errorf("Organizer = %+v, 表示名の公開を期待", shared.Organizer)
+	}
+
+	page, err := h.ListSharedRecords(ctx, "viewer", &domain.SharedRecordQuery{})
+	if err != nil {
+		t.Fatalf("ListSharedRecords() error = %v", err)
+	}
+	if len(page.Records) != 1 || page.Records[0].ID != record.ID {
+		t.Errorf("Records = %+v, 公開した1件を期待", page.Records)
+	}
+
+	if err := h.UnshareRecord(ctx, record.ID, "owner"); err != nil {
+		t.Fatalf("UnshareRecord() error = %v", err)
+	}
+	if page, _ := h.ListSharedRecords(ctx, "viewer", &domain.SharedRecordQuery{}); len(page.Records) != 0 {
+		t.Errorf("共有停止後の Records = %+v, 空を期待", page.Records)
+	}
+	if _, err := h.LikeRecord(ctx, record.ID, "viewer"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("共有停止後の LikeRecord() error = %v, ErrNotFound を期待", err)
+	}
+}
+
+func TestListSharedRecords(t *testing.T) {
+	ctx := context.Background()
+	h, _ := newTestRecordHandler(t, true)
+	shinjuku := addSharedRecord(t, h, domain.EventRecord{
+		Event:         domain.RecordEvent{Title: "新人歓迎会", Purpose: "welcome", Date: "2099-04-10"},
+		Venue:         &domain.Venue{Name: "炭火焼鳥 鳥心", Genre: "焼鳥・居酒屋", Area: "新宿"},
+		CostPerPerson: 4000,
+	})
+	shibuya := addSharedRecord(t, h, domain.EventRecord{
+		Event:         domain.RecordEvent{Title: "送別会", Purpose: "farewell", Date: "2099-03-20"},
+		Venue:         &domain.Venue{Name: "トラットリア", Genre: "イタリアン", Area: "渋谷"},
+		CostPerPerson: 6000,
+		OrganizerID:   "other-organizer",
+	})
+	noCost := addSharedRecord(t, h, domain.EventRecord{
+		Event: domain.RecordEvent{Title: "歓迎ランチ", Purpose: "welcome", Date: "2099-04-01"},
+		Venue: &domain.Venue{Name: "大衆酒場", Address: "東京都新宿区西新宿1-1-1", Genre: "居酒屋"},
+	})
+	if _, err := h.LikeRecord(ctx, shibuya, "viewer"); err != nil {
+		t.Fatalf("LikeRecord() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query domain.SharedRecordQuery
+		want  []string
+	}{
+		{name: "条件なし", query: domain.SharedRecordQuery{}, want: []string{shinjuku, shibuya, noCost}},
+		{name: "目的", query: domain.SharedRecordQuery{Purpose: "welcome"}, want: []string{shinjuku, noCost}},
+		{name: "エリア（住所も対象）", query: domain.SharedRecordQuery{Area: "新宿"}, want: []string{shinjuku, noCost}},
+		{name: "ジャンル（部分一致）", query: domain.SharedRecordQuery{Genre: "居酒屋"}, want: []string{shinjuku, noCost}},
+		{name: "最低金額", query: domain.SharedRecordQuery{MinPrice: 5000}, want: []string{shibuya}},
+		{name: "最高金額（金額なしは除く）", query: domain.SharedRecordQuery{MaxPrice: 5000}, want: []string{shinjuku}},
+		{name: "いいね済み", query: domain.SharedRecordQuery{Liked: true}, want: []string{shibuya}},
+		{name: "2ページ目", query: domain.SharedRecordQuery{Page: 2, Limit: 2}, want: []string{noCost}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := h.ListSharedRecords(ctx, "viewer", &tt.query)
+			if err != nil {
+				t.Fatalf("ListSharedRecords() error = %v", err)
+			}
+			got := make([]string, 0, len(page.Records))
+			for _, record := range page.Records {
+				got = append(got, record.ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("IDs = %v, %v を期待", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("IDs = %v, %v を期待", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+
+	page, _ := h.ListSharedRecords(ctx, "viewer", &domain.SharedRecordQuery{Limit: 2})
+	if page.Pagination != (domain.Pagination{Page: 1, Limit: 2, Total: 3, TotalPages: 2}) {
+		t.Errorf("Pagination = %+v", page.Pagination)
+	}
+	if !page.Records[1].IsLiked || page.Records[1].LikeCount != 1 || page.Records[0].IsLiked {
+		t.Errorf("Records = %+v, いいねの状態の反映を期待", page.Records)
+	}
+}
+
+func TestListSharedRecordsValidation(t *testing.T) {
+	h, _ := newTestRecordHandler(t, true)
+	tests := []struct {
+		name      string
+		query     domain.SharedRecordQuery
+		wantField string
+	}{
+		{name: "最低金額が最高金額を超える", query: domain.SharedRecordQuery{MinPrice: 6000, MaxPrice: 3000}, wantField: "maxPrice"},
+		{name: "件数が上限を超える", query: domain.SharedRecordQuery{Limit: 100}, wantField: "limit"},
+		{name: "未知の目的", query: domain.SharedRecordQuery{Purpose: "party"}, wantField: "purpose"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.ListSharedRecords(context.Background(), "viewer", &tt.query)
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("ListSharedRecords() error = %v, ValidationError を期待", err)
+			}
+			fields, _ := validationErr.Info.Details["fields"].(validation.Errors)
+			if len(fields) != 1 || fields[0].Field != tt.wantField {
+				t.Errorf("fields = %+v, %s を期待", fields, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestLikeRecord(t *testing.T) {
+	ctx := context.Background()
+	h, _ := newTestRecordHandler(t, true)
+	recordID := addSharedRecord(t, h, domain.EventRecord{Event: domain.RecordEvent{Title: "新人歓迎会"}})
+
+	steps := []struct {
+		name   string
+		userID string
+		like   bool
+		want   domain.RecordLikes
+	}{
+		{name: "いいね", userID: "viewer", like: true, want: domain.RecordLikes{LikeCount: 1, IsLiked: true}},
+		{name: "同じユーザーの2回目のいいね", userID: "viewer", like: true, want: domain.RecordLikes{LikeCount: 1, IsLiked: true}},
+		{name: "別のユーザーのいいね", userID: "another", like: true, want: domain.RecordLikes{LikeCount: 2, IsLiked: true}},
+		{name: "取り消し", userID: "viewer", like: false, want: domain.RecordLikes{LikeCount: 1, IsLiked: false}},
+		{name: "2回目の取り消し", userID: "viewer", like: false, want: domain.RecordLikes{LikeCount: 1, IsLiked: false}},
+	}
+	for _, step := range steps {
+		apply := h.UnlikeRecord
+		if step.like {
+			apply = h.LikeRecord
+		}
+		got, err := apply(ctx, recordID, step.userID)
+		if err != nil {
+			t.Fatalf("%s: error = %v", step.name, err)
+		}
+		if *got != step.want {
+			t.Errorf("%s: RecordLikes = %+v, %+v を期待", step.name, *got, step.want)
+		}
+	}
+
+	if _, err := h.LikeRecord(ctx, "rec_invalid", "viewer"); !errors.Is(err, ErrInvalidRecordID) {
+		t.Errorf("不正なIDの LikeRecord() error = %v, ErrInvalidRecordID を期待", err)
+	}
+	if _, err := h.LikeRecord(ctx, recordID, "owner"); !errors.Is(err, ErrOwnRecord) {
+		t.Errorf("自分の記録の LikeRecord() error = %v, ErrOwnRecord を期待", err)
+	}
+}
+
+func TestReportRecord(t *testing.T) {
+	ctx := context.Background()
+	h, _ := newTestRecordHandler(t, true)
+	recordID := addSharedRecord(t, h, domain.EventRecord{Event: domain.RecordEvent{Title: "新人歓迎会"}})
+	report := &domain.ReportRecordRequest{Reason: "inappropriate"}
+
+	if err := h.ReportRecord(ctx, recordID, "owner", report); !errors.Is(err, ErrOwnRecord) {
+		t.Fatalf("自分の記録の ReportRecord() error = %v, ErrOwnRecord を期待", err)
+	}
+
+	// 同じユーザーの報告は1件として数えるため、2人目までは表示されたまま
+	for _, userID := range []string{"reporter1", "reporter1", "reporter2"} {
+		if err := h.ReportRecord(ctx, recordID, userID, report); err != nil {
+			t.Fatalf("ReportRecord(%s) error = %v", userID, err)
+		}
+	}
+	if page, _ := h.ListSharedRecords(ctx, "viewer", &domain.SharedRecordQuery{}); len(page.Records) != 1 {
+		t.Fatalf("報告者2人の Records = %+v, 表示されたままを期待", page.Records)
+	}
+
+	if err := h.ReportRecord(ctx, recordID, "reporter3", report); err != nil {
+		t.Fatalf("ReportRecord(reporter3) error = %v", err)
+	}
+	if page, _ := h.ListSharedRecords(ctx, "viewer", &domain.SharedRecordQuery{}); len(page.Records) != 0 {
+		t.Errorf("報告者%d人の Records = %+v, 審査待ちで非表示を期待", ReportsToHide, page.Records)
+	}
+	if _, err := h.LikeRecord(ctx, recordID, "viewer"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("審査待ちの LikeRecord() error = %v, ErrNotFound を期待", err)
+	}
+	if _, err := h.ShareRecord(ctx, recordID, "owner", &domain.ShareRecordRequest{}); !errors.Is(err, ErrRecordUnderReview) {
+		t.Errorf("審査待ちの ShareRecord() error = %v, ErrRecordUnderReview を期待", err)
+	}
+	record, _ := h.GetRecord(ctx, recordID, "owner")
+	if record.ModerationStatus != domain.ModerationPendingReview {
+		t.Errorf("ModerationStatus = %q, 幹事には審査待ちであることの表示を期待", record.ModerationStatus)
+	}
+
+	var validationErr *ValidationError
+	if err := h.ReportRecord(ctx, recordID, "reporter4", &domain.ReportRecordRequest{Reason: "boring"}); !errors.As(err, &validationErr) {
+		t.Errorf("未知の理由の ReportRecord() error = %v, ValidationError を期待", err)
+	}
+}
+
+func TestMaskNames(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		names []string
+		want  string
+	}{
+		{name: "名前を伏せ字", text: "田中さん送別会", names: []string{"田中"}, want: "○○さん送別会"},
+		{name: "長い名前を優先", text: "田中太郎さんと田中さん", names: []string{"田中", "田中太郎"}, want: "○○さんと○○さん"},
+		{name: "1文字の名前は対象外", text: "林さんと森の中で", names: []string{"林"}, want: "林さんと森の中で"},
+		{name: "全角英字の名前", text: "Ｔｏｍさん歓迎会", names: []string{"Tom"}, want: "○○さん歓迎会"},
+		{name: "名前なし", text: "新人歓迎会", names: nil, want: "新人歓迎会"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maskNames(tt.text, tt.names); got != tt.want {
+				t.Errorf("maskNames() = %q, %q を期待", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+)
+
+// maxTopItems はよく使うジャンル・エリアとして返す最大件数
+const maxTopItems = 5
+
+// GetStats は幹事の期間内のイベント・記録を集計して返す
+// 会場のジャンル・エリアは、記録に会場がある場合は記録の会場（実際に開催したお店）、ない場合はイベントで決定した会場を数える
+func (h *RecordHandler) GetStats(ctx context.Context, organizerID string, query *domain.StatsQuery) (*domain.OrganizerStats, error) {
+	lang := i18n.FromContext(ctx)
+	if err := h.events.validator.Validate(query, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+	// YYYY-MM-DD 形式のため文字列の比較で日付の前後を判定できる
+	if query.From != "" && query.To != "" && query.From > query.To {
+		return nil, combinationError("to",
+			"終了日は開始日以降の日付を入力してください",
+			"To must be on or after from", lang)
+	}
+
+	events, err := h.events.eventRepo.ListEventsByOrganizer(ctx, organizerID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("イベント一覧の取得に失敗しました: %w", err)
+	}
+	records, err := h.recordRepo.ListRecordsByOrganizer(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("記録一覧の取得に失敗しました: %w", err)
+	}
+
+	stats := &domain.OrganizerStats{
+		Period:            *query,
+		EventsByStatus:    zeroCounts(domain.ValidEventStatuses),
+		EventsByPurpose:   zeroCounts(domain.ValidEventPurposes),
+		ResponseRateTrend: make([]domain.MonthlyResponseRate, 0),
+	}
+
+	venues := make([]*domain.Venue, 0)
+	// recorded は会場を記録済みのイベント（イベントの会場を重複して数えない）
+	recorded := make(map[string]bool)
+	ratingSum, costSum, costCount := 0, 0, 0
+	for _, record := range records {
+		if !inPeriod(record.Event.Date, query) {
+			continue
+		}
+		stats.RecordCount++
+		ratingSum += record.Rating
+		stats.TotalAttendees += record.Attendees
+		if record.CostPerPerson > 0 {
+			costSum += record.CostPerPerson
+			costCount++
+		}
+		if record.Venue != nil {
+			venues = append(venues, record.Venue)
+			recorded[record.EventID] = true
+		}
+	}
+	if stats.RecordCount > 0 {
+		stats.AverageRating = roundedRatio(ratingSum, stats.RecordCount, 10)
+	}
+	if costCount > 0 {
+		average := int(math.Round(float64(costSum) / float64(costCount)))
+		stats.AverageCostPerPerson = &average
+	}
+
+	months := make(map[string]*domain.MonthlyResponseRate)
+	members, responded := 0, 0
+	for _, event := range events {
+		if !inPeriod(event.Date, query) {
+			continue
+		}
+		stats.TotalEvents++
+		stats.EventsByStatus[event.Status]++
+		stats.EventsByPurpose[event.Purpose]++
+		if event.Venue != nil && !recorded[event.ID] {
+			venues = append(venues, event.Venue)
+		}
+
+		eventResponded := len(event.Members) - len(membersByStatus(event.Members, "pending"))
+		members += len(event.Members)
+		responded += eventResponded
+		if len(event.Date) < len("2006-01") {
+			continue
+		}
+		month := event.Date[:len("2006-01")]
+		if months[month] == nil {
+			months[month] = &domain.MonthlyResponseRate{Month: month}
+		}
+		months[month].Events++
+		months[month].Members += len(event.Members)
+		months[month].Responded += eventResponded
+	}
+	if members > 0 {
+		stats.ResponseRate = roundedRatio(responded, members, 1000)
+	}
+	for _, month := range months {
+		if month.Members > 0 {
+			month.Rate = roundedRatio(month.Responded, month.Members, 1000)
+		}
+		stats.ResponseRateTrend = append(stats.ResponseRateTrend, *month)
+	}
+	sort.Slice(stats.ResponseRateTrend, func(i, j int) bool {
+		return stats.ResponseRateTrend[i].Month < stats.ResponseRateTrend[j].Month
+	})
+
+	stats.TopGenres = topNames(venues, func(v *domain.Venue) string { return v.Genre })
+	stats.TopAreas = topNames(venues, func(v *domain.Venue) string { return v.Area })
+	return stats, nil
+}
+
+// inPeriod は開催日が集計期間に含まれるかどうかを返す
+// 期間を指定した場合、開催日が未定のイベントは含めない
+func inPeriod(date string, query *domain.StatsQuery) bool {
+	if query.From == "" && query.To == "" {
+		return true
+	}
+	if date == "" {
+		return false
+	}
+	return (query.From == "" || date >= query.From) && (query.To == "" || date <= query.To)
+}
+
+// zeroCounts は keys のすべてを0件としたマップを返す
+func zeroCounts(keys []string) map[string]int {
+	counts := make(map[string]int, len(keys))
+	for _, key := range keys {
+		counts[key] = 0
+	}
+	return counts
+}
+
+// roundedRatio は n / d を 1/scale 単位に四捨五入して返す
+func roundedRatio(n int, d int, scale float64) *float64 {
+	ratio := math.Round(float64(n)/float64(d)*scale) / scale
+	return &ratio
+}
+
+// topNames は会場の名前（ジャンル・エリア）を件数の多い順に最大 maxTopItems 件返す
+// 同数の場合は名前順、空の名前は数えない
+func topNames(venues []*domain.Venue, name func(*domain.Venue) string) []domain.NameCount {
+	counts := make(map[string]int)
+	for _, venue := range venues {
+		if n := name(venue); n != "" {
+			counts[n]++
+		}
+	}
+
+	top := make([]domain.NameCount, 0, len(counts))
+	for n, count := range counts {
+		top = append(top, domain.NameCount{Name: n, Count: count})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Name < top[j].Name
+	})
+	if len(top) > maxTopItems {
+		top = top[:maxTopItems]
+	}
+	return top
+}
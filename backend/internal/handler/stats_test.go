@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// newTestStatsHandler は幹事 owner のイベント4件（うち2件は記録あり）と他の幹事のイベント1件を作成し、その RecordHandler を返す
+func newTestStatsHandler(t *testing.T) *RecordHandler {
+	t.Helper()
+	ctx := context.Background()
+	events, repo := newTestEventHandler(t)
+	records := repository.NewMemoryRecordRepository()
+	h := NewRecordHandler(events, records, WithIDGenerator(idgen.NewSequenceGenerator()))
+
+	addEvent := func(organizerID string, event domain.Event) string {
+		created, err := events.CreateEvent(ctx, &domain.CreateEventRequest{Title: "テスト"}, organizerID)
+		if err != nil {
+			t.Fatalf("CreateEvent() error = %v", err)
+		}
+		event.ID = created.Data.ID
+		event.OrganizerID = organizerID
+		event.CreatedAt = created.Data.CreatedAt
+		event.UpdatedAt = created.Data.UpdatedAt
+		if _, err := repo.UpdateEvent(ctx, &event); err != nil {
+			t.Fatalf("UpdateEvent() error = %v", err)
+		}
+		return event.ID
+	}
+	addRecord := func(record domain.EventRecord) {
+		record.ID = h.idGen.NewID("rec")
+		record.OrganizerID = "owner"
+		if _, err := records.CreateRecord(ctx, &record); err != nil {
+			t.Fatalf("CreateRecord() error = %v", err)
+		}
+	}
+
+	julyWelcome := addEvent("owner", domain.Event{
+		Date: "2025-07-05", Status: "completed", Purpose: "welcome",
+		Members: []domain.Member{{Status: "attending"}, {Status: "attending"}, {Status: "declined"}, {Status: "pending"}},
+		Venue:   &domain.Venue{Name: "居酒屋 一番", Genre: "居酒屋", Area: "新宿"},
+	})
+	julyFarewell := addEvent("owner", domain.Event{
+		Date: "2025-07-20", Status: "completed", Purpose: "farewell",
+		Members: []domain.Member{{Status: "attending"}, {Status: "attending"}},
+		Venue:   &domain.Venue{Name: "トラットリア", Genre: "イタリアン", Area: "渋谷"},
+	})
+	addEvent("owner", domain.Event{
+		Date: "2025-09-01", Status: "confirmed", Purpose: "welcome",
+		Members: []domain.Member{{Status: "attending"}, {Status: "pending"}},
+		Venue:   &domain.Venue{Name: "炭火焼鳥 鳥心", Genre: "焼鳥", Area: "新宿"},
+	})
+	addEvent("owner", domain.Event{Status: "planning", Purpose: "other", Members: []domain.Member{{Status: "pending"}}})
+	addEvent("someone-else", domain.Event{Date: "2025-07-10", Status: "completed", Purpose: "social"})
+
+	// 記録の会場（焼鳥）はイベントの会場（居酒屋）より優先し、会場のない記録はイベントの会場を数える
+	addRecord(domain.EventRecord{
+		EventID: julyWelcome, Event: domain.RecordEvent{Date: "2025-07-05"},
+		Rating: 4, Attendees: 2, CostPerPerson: 4000,
+		Venue: &domain.Venue{Name: "焼鳥 とり八", Genre: "焼鳥", Area: "新宿"},
+	})
+	addRecord(domain.EventRecord{
+		EventID: julyFarewell, Event: domain.RecordEvent{Date: "2025-07-20"},
+		Rating: 5, Attendees: 2,
+	})
+	return h
+}
+
+func TestGetStats(t *testing.T) {
+	h := newTestStatsHandler(t)
+
+	stats, err := h.GetStats(context.Background(), "owner", &domain.StatsQuery{})
+	if err != nil {
+		t.Fatalf("GetStats() error = %v", err)
+	}
+
+	if stats.TotalEvents != 4 {
+		t.Errorf("TotalEvents = %d, 4 を期待（他の幹事のイベントは除く）", stats.TotalEvents)
+	}
+	wantStatus := map[string]int{"planning": 1, "confirmed": 1, "completed": 2}
+	if !reflect.DeepEqual(stats.EventsByStatus, wantStatus) {
+		t.Errorf("EventsByStatus = %v, %v を期待", stats.EventsByStatus, wantStatus)
+	}
+	wantPurpose := map[string]int{"welcome": 2, "farewell": 1, "year_end": 0, "social": 0, "other": 1}
+	if !reflect.DeepEqual(stats.EventsByPurpose, wantPurpose) {
+		t.Errorf("EventsByPurpose = %v, %v を期待", stats.EventsByPurpose, wantPurpose)
+	}
+	if stats.RecordCount != 2 || stats.TotalAttendees != 4 {
+		t.Errorf("RecordCount = %d, TotalAttendees = %d, 2・4 を期待", stats.RecordCount, stats.TotalAttendees)
+	}
+	if stats.AverageRating == nil || *stats.AverageRating != 4.5 {
+		t.Errorf("AverageRating = %v, 4.5 を期待", stats.AverageRating)
+	}
+	if stats.AverageCostPerPerson == nil || *stats.AverageCostPerPerson != 4000 {
+		t.Errorf("AverageCostPerPerson = %v, 総額を記録した記録のみの平均（4000）を期待", stats.AverageCostPerPerson)
+	}
+	if stats.ResponseRate == nil || *stats.ResponseRate != 0.667 {
+		t.Errorf("ResponseRate = %v, 6/9 = 0.667 を期待", stats.ResponseRate)
+	}
+
+	july, september := 0.833, 0.5
+	wantTrend := []domain.MonthlyResponseRate{
+		{Month: "2025-07", Events: 2, Members: 6, Responded: 5, Rate: &july},
+		{Month: "2025-09", Events: 1, Members: 2, Responded: 1, Rate: &september},
+	}
+	if !reflect.DeepEqual(stats.ResponseRateTrend, wantTrend) {
+		t.Errorf("ResponseRateTrend = %+v, %+v を期待（開催日が未定のイベントは除く）", stats.ResponseRateTrend, wantTrend)
+	}
+
+	wantGenres := []domain.NameCount{{Name: "焼鳥", Count: 2}, {Name: "イタリアン", Count: 1}}
+	if !reflect.DeepEqual(stats.TopGenres, wantGenres) {
+		t.Errorf("TopGenres = %+v, %+v を期待", stats.TopGenres, wantGenres)
+	}
+	wantAreas := []domain.NameCount{{Name: "新宿", Count: 2}, {Name: "渋谷", Count: 1}}
+	if !reflect.DeepEqual(stats.TopAreas, wantAreas) {
+		t.Errorf("TopAreas = %+v, %+v を期待", stats.TopAreas, wantAreas)
+	}
+}
+
+func TestGetStatsPeriod(t *testing.T) {
+	h := newTestStatsHandler(t)
+
+	stats, err := h.GetStats(context.Background(), "owner", &domain.StatsQuery{From: "2025-07-01", To: "2025-07-31"})
+	if err != nil {
+		t.Fatalf("GetStats() error = %v", err)
+	}
+	if stats.TotalEvents != 2 || stats.EventsByStatus["completed"] != 2 || stats.EventsByStatus["planning"] != 0 {
+		t.Errorf("TotalEvents = %d, EventsByStatus = %v, 7月の完了2件のみを期待", stats.TotalEvents, stats.EventsByStatus)
+	}
+	if stats.RecordCount != 2 || len(stats.ResponseRateTrend) != 1 || stats.ResponseRateTrend[0].Month != "2025-07" {
+		t.Errorf("RecordCount = %d, ResponseRateTrend = %+v", stats.RecordCount, stats.ResponseRateTrend)
+	}
+
+	stats, err = h.GetStats(context.Background(), "owner", &domain.StatsQuery{From: "2026-01-01"})
+	if err != nil {
+		t.Fatalf("GetStats() error = %v", err)
+	}
+	if stats.TotalEvents != 0 || stats.AverageRating != nil || stats.ResponseRate != nil || len(stats.TopGenres) != 0 {
+		t.Errorf("Stats = %+v, 対象がない場合は0件・nil を期待", stats)
+	}
+}
+
+func TestGetStatsValidation(t *testing.T) {
+	h := newTestStatsHandler(t)
+	tests := []struct {
+		name      string
+		query     domain.StatsQuery
+		wantField string
+	}{
+		{name: "開始日の形式", query: domain.StatsQuery{From: "2025/07/01"}, wantField: "from"},
+		{name: "終了日が開始日より前", query: domain.StatsQuery{From: "2025-07-31", To: "2025-07-01"}, wantField: "to"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.GetStats(context.Background(), "owner", &tt.query)
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("GetStats() error = %v, ValidationError を期待", err)
+			}
+			fields, _ := validationErr.Info.Details["fields"].(validation.Errors)
+			if len(fields) != 1 || fields[0].Field != tt.wantField {
+				t.Errorf("fields = %+v, %s を期待", fields, tt.wantField)
+			}
+		})
+	}
+}
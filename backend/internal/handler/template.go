@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// templateIDPattern はテンプレートIDの形式（"tpl_" + 32文字の16進数）
+var templateIDPattern = regexp.MustCompile(`^tpl_[a-f0-9]{32}$`)
+
+// eventBlueprint はイベントの複製・テンプレートで引き継ぐ項目
+// 日時・日程調整の投票・幹事ログは開催回ごとに異なるため含めない
+type eventBlueprint struct {
+	Title         string
+	Purpose       string
+	Notes         string
+	FormQuestions []domain.FormQuestion
+	Members       []domain.Member
+	Budget        *domain.EventBudget
+}
+
+// blueprintFromEvent は既存イベントから引き継ぐ項目を取り出す
+func blueprintFromEvent(event *domain.Event) eventBlueprint {
+	return eventBlueprint{
+		Title:         event.Title,
+		Purpose:       event.Purpose,
+		Notes:         event.Notes,
+		FormQuestions: copyFormQuestions(event.FormQuestions),
+		Members:       resetMembers(event.Members),
+		Budget:        copyBudget(event.Budget),
+	}
+}
+
+// blueprintFromTemplate はテンプレートから引き継ぐ項目を取り出す
+func blueprintFromTemplate(template *domain.EventTemplate) eventBlueprint {
+	return eventBlueprint{
+		Title:         template.Title,
+		Purpose:       template.Purpose,
+		Notes:         template.Notes,
+		FormQuestions: copyFormQuestions(template.FormQuestions),
+		Members:       resetMembers(template.Members),
+		Budget:        copyBudget(template.Budget),
+	}
+}
+
+// resetMembers はメンバーの名前・連絡先だけを残し、参加状況を未回答に戻す
+// 好み（アレルギー・予算等）や回答日時は前回のフォーム回答のため引き継がず、改めて回答してもらう
+func resetMembers(members []domain.Member) []domain.Member {
+	reset := make([]domain.Member, 0, len(members))
+	for _, member := range members {
+		reset = append(reset, domain.Member{
+			Name:   member.Name,
+			Email:  member.Email,
+			Status: "pending",
+		})
+	}
+	return reset
+}
+
+// copyFormQuestions は質問項目を選択肢のスライスも含めて複製する
+func copyFormQuestions(questions []domain.FormQuestion) []domain.FormQuestion {
+	if len(questions) == 0 {
+		return nil
+	}
+	copied := make([]domain.FormQuestion, len(questions))
+	for i, question := range questions {
+		copied[i] = question
+		copied[i].Options = append([]string(nil), question.Options...)
+	}
+	return copied
+}
+
+// copyBudget は予算を複製する（未設定の場合は nil）
+func copyBudget(budget *domain.EventBudget) *domain.EventBudget {
+	if budget == nil {
+		return nil
+	}
+	copied := *budget
+	return &copied
+}
+
+// DuplicateEvent は既存イベントを複製して新しいイベントを作成
+// タイトル・目的・備考・フォームの質問・メンバー（未回答に戻す）・予算を引き継ぎ、
+// 日時は req で指定する（タイトル・予算も req で上書きできる）
+// メンバーの連絡先を持ち出せるため、元のイベントの編集権限が必要
+func (h *EventHandler) DuplicateEvent(ctx context.Context, eventID string, organizerID string, req *domain.DuplicateEventRequest) (*domain.Event, error) {
+	source, err := h.GetEventFor(ctx, eventID, organizerID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	return h.createFromBlueprint(ctx, blueprintFromEvent(source), req, organizerID)
+}
+
+// createFromBlueprint は引き継ぐ項目とリクエストの日時から新しいイベントを作成する
+func (h *EventHandler) createFromBlueprint(ctx context.Context, blueprint eventBlueprint, req *domain.DuplicateEventRequest, organizerID string) (*domain.Event, error) {
+	// 作成時と同じく全角数字・前後の空白を正規化してから検証する
+	req.Title = strings.TrimSpace(norm.NFKC.String(req.Title))
+	req.Date = strings.TrimSpace(norm.NFKC.String(req.Date))
+	req.Time = strings.TrimSpace(norm.NFKC.String(req.Time))
+	lang := i18n.FromContext(ctx)
+	if err := h.validator.Validate(req, lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+	if err := budgetConflict(req.Budget, "budget.", lang); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, lang)}
+	}
+
+	title := blueprint.Title
+	if req.Title != "" {
+		title = req.Title
+	}
+	budget := blueprint.Budget
+	if req.Budget != nil {
+		budget = nil
+		if !req.Budget.IsZero() {
+			budget = copyBudget(req.Budget)
+		}
+	}
+
+	event := &domain.Event{
+		ID:            h.idGen.NewID("evt"),
+		Title:         title,
+		Purpose:       h.getDefaultPurpose(blueprint.Purpose),
+		Status:        "planning",
+		Date:          req.Date,
+		Time:          req.Time,
+		OrganizerID:   organizerID,
+		Members:       blueprint.Members,
+		Notes:         blueprint.Notes,
+		HasScheduling: req.HasScheduling,
+		Budget:        budget,
+		FormQuestions: blueprint.FormQuestions,
+	}
+
+	created, err := h.eventRepo.CreateEvent(ctx, event)
+	if err != nil {
+		return nil, fmt.Errorf("イベントの作成に失敗しました: %w", err)
+	}
+	return created, nil
+}
+
+// TemplateHandler はイベントテンプレートのビジネスロジックを処理
+// テンプレートの元になるイベントの取得・テンプレートからのイベント作成は EventHandler に委譲する
+type TemplateHandler struct {
+	// events はイベントの取得（権限チェック込み）と作成を担当
+	events *EventHandler
+
+	// templateRepo はテンプレートの永続化を担当
+	templateRepo repository.TemplateRepository
+
+	// idGen はテンプレートIDの生成元
+	idGen idgen.Generator
+}
+
+// NewTemplateHandler は新しいTemplateHandlerインスタンスを作成
+// opts を省略した場合はUUIDによるID生成を使用する
+func NewTemplateHandler(eventHandler *EventHandler, templateRepo repository.TemplateRepository, opts ...Option) *TemplateHandler {
+	o := newOptions(opts)
+	return &TemplateHandler{
+		events:       eventHandler,
+		templateRepo: templateRepo,
+		idGen:        o.idGen,
+	}
+}
+
+// SaveTemplate は幹事のイベントをテンプレートとして保存
+// メンバーの連絡先を持ち出せるため、元のイベントの編集権限が必要
+func (h *TemplateHandler) SaveTemplate(ctx context.Context, eventID string, organizerID string, req *domain.CreateTemplateRequest) (*domain.EventTemplate, error) {
+	req.Name = strings.TrimSpace(norm.NFKC.String(req.Name))
+	if err := h.events.validator.Validate(req, i18n.FromContext(ctx)); err != nil {
+		return nil, &ValidationError{Info: newValidationErrorInfo(err, i18n.FromContext(ctx))}
+	}
+
+	source, err := h.events.GetEventFor(ctx, eventID, organizerID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	name := req.Name
+	if name == "" {
+		name = source.Title
+	}
+	blueprint := blueprintFromEvent(source)
+
+	template := &domain.EventTemplate{
+		ID:            h.idGen.NewID("tpl"),
+		OrganizerID:   organizerID,
+		Name:          name,
+		SourceEventID: source.ID,
+		Title:         blueprint.Title,
+		Purpose:       blueprint.Purpose,
+		Notes:         blueprint.Notes,
+		FormQuestions: blueprint.FormQuestions,
+		Members:       blueprint.Members,
+		Budget:        blueprint.Budget,
+	}
+
+	created, err := h.templateRepo.CreateTemplate(ctx, template)
+	if err != nil {
+		return nil, fmt.Errorf("テンプレートの保存に失敗しました: %w", err)
+	}
+	return created, nil
+}
+
+// ListTemplates は幹事が保存したテンプレートの一覧を返す
+func (h *TemplateHandler) ListTemplates(ctx context.Context, organizerID string) ([]*domain.EventTemplate, error) {
+	templates, err := h.templateRepo.ListTemplatesByOrganizer(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("テンプレート一覧の取得に失敗しました: %w", err)
+	}
+	return templates, nil
+}
+
+// CreateEventFromTemplate はテンプレートから新しいイベントを作成
+func (h *TemplateHandler) CreateEventFromTemplate(ctx context.Context, templateID string, organizerID string, req *domain.DuplicateEventRequest) (*domain.Event, error) {
+	template, err := h.getOwnedTemplate(ctx, templateID, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	return h.events.createFromBlueprint(ctx, blueprintFromTemplate(template), req, organizerID)
+}
+
+// DeleteTemplate はテンプレートを削除（作成したイベントには影響しない）
+func (h *TemplateHandler) DeleteTemplate(ctx context.Context, templateID string, organizerID string) error {
+	if _, err := h.getOwnedTemplate(ctx, templateID, organizerID); err != nil {
+		return err
+	}
+	if err := h.templateRepo.DeleteTemplate(ctx, templateID); err != nil {
+		return fmt.Errorf("テンプレートの削除に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// getOwnedTemplate はIDの形式と所有者を確認してテンプレートを取得する
+func (h *TemplateHandler) getOwnedTemplate(ctx context.Context, templateID string, organizerID string) (*domain.EventTemplate, error) {
+	if !templateIDPattern.MatchString(templateID) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTemplateID, templateID)
+	}
+
+	template, err := h.templateRepo.GetTemplate(ctx, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("テンプレートの取得に失敗しました: %w", err)
+	}
+	if template.OrganizerID != organizerID {
+		return nil, ErrForbidden
+	}
+	return template, nil
+}
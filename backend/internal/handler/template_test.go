@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/idgen"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// createSourceEvent は複製元として、日時・メンバーの回答・フォームの質問・予算を持つイベントを作成する
+func createSourceEvent(t *testing.T, h *EventHandler, repo *repository.MemoryEventRepository) *domain.Event {
+	t.Helper()
+	ctx := context.Background()
+
+	created, err := h.CreateEvent(ctx, &domain.CreateEventRequest{
+		Title:   "四半期送別会",
+		Purpose: "farewell",
+		Date:    "2025-09-30",
+		Time:    "19:00",
+		Notes:   "二次会はカラオケ",
+	}, "owner")
+	if err != nil {
+		t.Fatalf("テスト用イベントの作成に失敗: %v", err)
+	}
+	if !created.Success {
+		t.Fatalf("テスト用イベントの作成に失敗: %+v", created.Error)
+	}
+
+	respondedAt := testNow
+	event := created.Data
+	event.HasScheduling = true
+	event.Members = []domain.Member{
+		{Name: "佐藤", Email: "sato@example.com", Status: "attending", Preferences: map[string]interface{}{"allergies": []string{"えび"}}, ResponseAt: &respondedAt},
+		{Name: "鈴木", Status: "declined"},
+	}
+	event.FormQuestions = []domain.FormQuestion{
+		{ID: "q_001", Question: "お名前", Type: "name", Required: true, Enabled: true},
+		{ID: "q_002", Question: "お酒は飲まれますか？", Type: "alcohol", Enabled: true, CanDisable: true, Options: []string{"飲む", "飲まない"}},
+	}
+	event.Budget = &domain.EventBudget{TargetPerPerson: 5000, Subsidy: 10000}
+	if _, err := repo.UpdateEvent(ctx, event); err != nil {
+		t.Fatalf("テスト用イベントの更新に失敗: %v", err)
+	}
+	return event
+}
+
+func TestDuplicateEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("引き継ぐ項目と引き継がない項目", func(t *testing.T) {
+		h, repo := newTestEventHandler(t)
+		source := createSourceEvent(t, h, repo)
+
+		got, err := h.DuplicateEvent(ctx, source.ID, "owner", &domain.DuplicateEventRequest{Date: "２０２５－１２－２６"})
+		if err != nil {
+			t.Fatalf("DuplicateEvent() error = %v", err)
+		}
+
+		if got.ID == source.ID || got.Status != "planning" || got.OrganizerID != "owner" {
+			t.Errorf("新しい企画中のイベントを期待: ID=%q, Status=%q, OrganizerID=%q", got.ID, got.Status, got.OrganizerID)
+		}
+		if got.Title != source.Title || got.Purpose != source.Purpose || got.Notes != source.Notes {
+			t.Errorf("タイトル・目的・備考が引き継がれていません: %+v", got)
+		}
+		if !reflect.DeepEqual(got.FormQuestions, source.FormQuestions) {
+			t.Errorf("FormQuestions = %+v, %+v を期待", got.FormQuestions, source.FormQuestions)
+		}
+		if !reflect.DeepEqual(got.Budget, source.Budget) {
+			t.Errorf("Budget = %+v, %+v を期待", got.Budget, source.Budget)
+		}
+		if got.Date != "2025-12-26" || got.Time != "" || got.HasScheduling {
+			t.Errorf("日時・日程調整は引き継がず指定値を使う想定: Date=%q, Time=%q, HasScheduling=%v", got.Date, got.Time, got.HasScheduling)
+		}
+
+		wantMembers := []domain.Member{
+			{Name: "佐藤", Email: "sato@example.com", Status: "pending"},
+			{Name: "鈴木", Status: "pending"},
+		}
+		if !reflect.DeepEqual(got.Members, wantMembers) {
+			t.Errorf("Members = %+v, 未回答に戻した %+v を期待", got.Members, wantMembers)
+		}
+
+		// 複製後に変更しても元のイベントに影響しないこと
+		got.FormQuestions[1].Options[0] = "変更"
+		got.Budget.Subsidy = 0
+		stored, _ := repo.GetEvent(ctx, source.ID)
+		if stored.FormQuestions[1].Options[0] != "飲む" {
+			t.Errorf("元のイベントの質問が変更されました: %+v", stored.FormQuestions[1])
+		}
+		if stored.Budget.Subsidy != 10000 {
+			t.Errorf("元のイベントの予算が変更されました: %+v", stored.Budget)
+		}
+	})
+
+	t.Run("タイトル・時刻の上書き", func(t *testing.T) {
+		h, repo := newTestEventHandler(t)
+		source := createSourceEvent(t, h, repo)
+
+		got, err := h.DuplicateEvent(ctx, source.ID, "owner", &domain.DuplicateEventRequest{Title: " 冬の送別会 ", Time: "18:30", HasScheduling: true})
+		if err != nil {
+			t.Fatalf("DuplicateEvent() error = %v", err)
+		}
+		if got.Title != "冬の送別会" || got.Time != "18:30" || !got.HasScheduling {
+			t.Errorf("上書きが反映されていません: Title=%q, Time=%q, HasScheduling=%v", got.Title, got.Time, got.HasScheduling)
+		}
+	})
+
+	budgetTests := []struct {
+		name   string
+		budget *domain.EventBudget
+		want   *domain.EventBudget
+	}{
+		{name: "予算の上書き", budget: &domain.EventBudget{TotalCap: 60000}, want: &domain.EventBudget{TotalCap: 60000}},
+		{name: "すべて0の予算は予算なし", budget: &domain.EventBudget{}, want: nil},
+	}
+	for _, tt := range budgetTests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, repo := newTestEventHandler(t)
+			source := createSourceEvent(t, h, repo)
+
+			got, err := h.DuplicateEvent(ctx, source.ID, "owner", &domain.DuplicateEventRequest{Budget: tt.budget})
+			if err != nil {
+				t.Fatalf("DuplicateEvent() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Budget, tt.want) {
+				t.Errorf("Budget = %+v, %+v を期待", got.Budget, tt.want)
+			}
+		})
+	}
+
+	errorTests := []struct {
+		name        string
+		organizerID string
+		eventID     string
+		req         domain.DuplicateEventRequest
+		wantErr     error
+	}{
+		{name: "他の幹事のイベントは複製できない", organizerID: "someone-else", wantErr: ErrForbidden},
+		{name: "形式が不正なID", organizerID: "owner", eventID: "evt_123", wantErr: ErrInvalidEventID},
+		{name: "存在しないイベント", organizerID: "owner", eventID: "evt_ffffffffffffffffffffffffffffffff", wantErr: repository.ErrNotFound},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, repo := newTestEventHandler(t)
+			source := createSourceEvent(t, h, repo)
+			eventID := tt.eventID
+			if eventID == "" {
+				eventID = source.ID
+			}
+
+			if _, err := h.DuplicateEvent(ctx, eventID, tt.organizerID, &tt.req); !errors.Is(err, tt.wantErr) {
+				t.Errorf("DuplicateEvent() error = %v, %v を期待", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("共同幹事は編集権限がある場合のみ複製できる", func(t *testing.T) {
+		h, repo := newTestEventHandler(t)
+		source := createSourceEvent(t, h, repo)
+		inviteAndAccept(t, h, source.ID, domain.RoleEditor, "editor-user")
+		inviteAndAccept(t, h, source.ID, domain.RoleViewer, "viewer-user")
+
+		if _, err := h.DuplicateEvent(ctx, source.ID, "viewer-user", &domain.DuplicateEventRequest{}); !errors.Is(err, ErrInsufficientPermission) {
+			t.Errorf("閲覧者の DuplicateEvent() error = %v, ErrInsufficientPermission を期待", err)
+		}
+		got, err := h.DuplicateEvent(ctx, source.ID, "editor-user", &domain.DuplicateEventRequest{})
+		if err != nil {
+			t.Fatalf("編集者の DuplicateEvent() error = %v", err)
+		}
+		if got.OrganizerID != "editor-user" {
+			t.Errorf("OrganizerID = %q, 複製した編集者を期待", got.OrganizerID)
+		}
+	})
+
+	t.Run("過去の日付はバリデーションエラー", func(t *testing.T) {
+		h, repo := newTestEventHandler(t)
+		source := createSourceEvent(t, h, repo)
+
+		_, err := h.DuplicateEvent(ctx, source.ID, "owner", &domain.DuplicateEventRequest{Date: "2025-09-01"})
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Info.Code != "VALIDATION_ERROR" {
+			t.Fatalf("DuplicateEvent() error = %v, ValidationError を期待", err)
+		}
+	})
+
+	t.Run("会社補助が総額の上限を超える予算はバリデーションエラー", func(t *testing.T) {
+		h, repo := newTestEventHandler(t)
+		source := createSourceEvent(t, h, repo)
+
+		_, err := h.DuplicateEvent(ctx, source.ID, "owner", &domain.DuplicateEventRequest{Budget: &domain.EventBudget{TotalCap: 10000, Subsidy: 20000}})
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("DuplicateEvent() error = %v, ValidationError を期待", err)
+		}
+		fields, _ := validationErr.Info.Details["fields"].(validation.Errors)
+		if len(fields) != 1 || fields[0].Field != "budget.subsidy" {
+			t.Errorf("fields = %+v, budget.subsidy を期待", fields)
+		}
+	})
+}
+
+func TestTemplateHandler(t *testing.T) {
+	ctx := context.Background()
+	h, repo := newTestEventHandler(t)
+	source := createSourceEvent(t, h, repo)
+	templates := NewTemplateHandler(h,
+		repository.NewMemoryTemplateRepository(repository.WithClock(clock.NewFixedClock(testNow))),
+		WithIDGenerator(idgen.NewSequenceGenerator()),
+	)
+
+	template, err := templates.SaveTemplate(ctx, source.ID, "owner", &domain.CreateTemplateRequest{})
+	if err != nil {
+		t.Fatalf("SaveTemplate() error = %v", err)
+	}
+	if template.ID != "tpl_00000000000000000000000000000001" || template.Name != "四半期送別会" || template.SourceEventID != source.ID || !reflect.DeepEqual(template.Budget, source.Budget) {
+		t.Errorf("保存したテンプレートが期待と異なります: %+v", template)
+	}
+	for _, member := range template.Members {
+		if member.Status != "pending" || member.Preferences != nil {
+			t.Errorf("テンプレートにメンバーの回答が含まれています: %+v", member)
+		}
+	}
+
+	if _, err := templates.SaveTemplate(ctx, source.ID, "someone-else", &domain.CreateTemplateRequest{Name: "盗用"}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("他の幹事のイベントの SaveTemplate() error = %v, ErrForbidden を期待", err)
+	}
+
+	inviteAndAccept(t, h, source.ID, domain.RoleViewer, "viewer-user")
+	if _, err := templates.SaveTemplate(ctx, source.ID, "viewer-user", &domain.CreateTemplateRequest{Name: "閲覧者"}); !errors.Is(err, ErrInsufficientPermission) {
+		t.Errorf("閲覧者の SaveTemplate() error = %v, ErrInsufficientPermission を期待", err)
+	}
+
+	list, err := templates.ListTemplates(ctx, "owner")
+	if err != nil || len(list) != 1 {
+		t.Fatalf("ListTemplates() = %d件, error = %v, 1件を期待", len(list), err)
+	}
+	if others, _ := templates.ListTemplates(ctx, "someone-else"); len(others) != 0 {
+		t.Errorf("他の幹事のテンプレートが一覧に含まれています: %+v", others)
+	}
+
+	event, err := templates.CreateEventFromTemplate(ctx, template.ID, "owner", &domain.DuplicateEventRequest{Date: "2026-03-27", Time: "19:00"})
+	if err != nil {
+		t.Fatalf("CreateEventFromTemplate() error = %v", err)
+	}
+	if event.Title != source.Title || event.Date != "2026-03-27" || len(event.Members) != 2 || len(event.FormQuestions) != 2 || !reflect.DeepEqual(event.Budget, source.Budget) {
+		t.Errorf("テンプレートから作成したイベントが期待と異なります: %+v", event)
+	}
+
+	errorTests := []struct {
+		name        string
+		templateID  string
+		organizerID string
+		wantErr     error
+	}{
+		{name: "他の幹事のテンプレートは使用できない", templateID: template.ID, organizerID: "someone-else", wantErr: ErrForbidden},
+		{name: "形式が不正なID", templateID: "tpl_1", organizerID: "owner", wantErr: ErrInvalidTemplateID},
+		{name: "存在しないテンプレート", templateID: "tpl_ffffffffffffffffffffffffffffffff", organizerID: "owner", wantErr: repository.ErrNotFound},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := templates.CreateEventFromTemplate(ctx, tt.templateID, tt.organizerID, &domain.DuplicateEventRequest{}); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CreateEventFromTemplate() error = %v, %v を期待", err, tt.wantErr)
+			}
+			if err := templates.DeleteTemplate(ctx, tt.templateID, tt.organizerID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeleteTemplate() error = %v, %v を期待", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := templates.DeleteTemplate(ctx, template.ID, "owner"); err != nil {
+		t.Fatalf("DeleteTemplate() error = %v", err)
+	}
+	if list, _ := templates.ListTemplates(ctx, "owner"); len(list) != 0 {
+		t.Errorf("削除後も一覧に残っています: %+v", list)
+	}
+	if _, err := repo.GetEvent(ctx, event.ID); err != nil {
+		t.Errorf("テンプレート削除で作成済みイベントが削除されました: %v", err)
+	}
+}
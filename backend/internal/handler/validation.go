@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/validation"
+)
+
+// newValidator はハンドラー共通のバリデーターを作成
+// 組み込みルールに加えて、現在時刻に依存するカスタムルールを登録する
+//
+// カスタムルール:
+//   - notpast=<layout>: 日付が過去でないこと（当日は可）
+func newValidator(c clock.Clock) *validation.Validator {
+	v := validation.New()
+	v.Register("notpast", validation.NotPast(c))
+	return v
+}
+
+// newValidationErrorInfo はバリデーションエラーをAPIのエラー情報に変換
+// フロントエンドが入力欄ごとにエラーを表示できるよう、details.fields にフィールド別のエラーを格納する
+// message はエラーが1件ならそのメッセージ、複数件なら件数を示す要約（lang の言語）
+//
+// レスポンス例:
+//
+//	{
+//	  "code": "VALIDATION_ERROR",
+//	  "message": "入力内容に2件の誤りがあります",
+//	  "details": {
+//	    "fields": [
+//	      {"field": "title", "code": "REQUIRED", "message": "イベントタイトルは必須です"},
+//	      {"field": "time", "code": "INVALID_FORMAT", "message": "時刻の形式が正しくありません（HH:MM形式で入力してください）", "params": {"format": "HH:MM"}}
+//	    ]
+//	  }
+//	}
+func newValidationErrorInfo(err error, lang i18n.Language) *domain.ErrorInfo {
+	info := &domain.ErrorInfo{
+		Code:    "VALIDATION_ERROR",
+		Message: err.Error(),
+	}
+
+	var fieldErrors validation.Errors
+	if !errors.As(err, &fieldErrors) {
+		return info
+	}
+
+	info.Details = map[string]interface{}{
+		"fields": fieldErrors,
+	}
+	if len(fieldErrors) > 1 {
+		info.Message = validationSummary(len(fieldErrors), lang)
+	}
+
+	return info
+}
+
+// validationSummary は複数のフィールドエラーがある場合の要約メッセージを返す
+func validationSummary(count int, lang i18n.Language) string {
+	if lang == i18n.English {
+		return fmt.Sprintf("%d fields are invalid", count)
+	}
+	return fmt.Sprintf("入力内容に%d件の誤りがあります", count)
+}
@@ -0,0 +1,67 @@
+package i18n
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+)
+
+// Language はレスポンスメッセージの言語
+type Language string
+
+const (
+	// Japanese は日本語（デフォルト）
+	Japanese Language = "ja"
+
+	// English は英語
+	English Language = "en"
+)
+
+// Default は Accept-Language が未指定・未対応の場合に使用する言語
+const Default = Japanese
+
+// matcher はサポートする言語の中から Accept-Language に最も近いものを選ぶ
+// 先頭の言語がフォールバック先になる
+var matcher = language.NewMatcher([]language.Tag{
+	language.Japanese,
+	language.English,
+})
+
+// ParseAcceptLanguage は Accept-Language ヘッダーから使用する言語を決定する
+// 例: "en-US,en;q=0.9" → English, "ja-JP" → Japanese, "" や "fr" → Japanese
+func ParseAcceptLanguage(header string) Language {
+	if header == "" {
+		return Default
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 {
+		return Default
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return Default
+	}
+	if index == 1 {
+		return English
+	}
+	return Japanese
+}
+
+// contextKey はcontextに言語を格納するためのキー型
+// 他パッケージのキーと衝突しないよう非公開の型を使用する
+type contextKey struct{}
+
+// WithLanguage は言語を格納したcontextを返す
+func WithLanguage(ctx context.Context, lang Language) context.Context {
+	return context.WithValue(ctx, contextKey{}, lang)
+}
+
+// FromContext はcontextに格納された言語を返す（未設定の場合は Default）
+func FromContext(ctx context.Context) Language {
+	if lang, ok := ctx.Value(contextKey{}).(Language); ok {
+		return lang
+	}
+	return Default
+}
@@ -0,0 +1,38 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   Language
+	}{
+		{header: "", want: Japanese},
+		{header: "ja", want: Japanese},
+		{header: "ja-JP,ja;q=0.9,en;q=0.8", want: Japanese},
+		{header: "en", want: English},
+		{header: "en-US,en;q=0.9", want: English},
+		{header: "fr-FR", want: Japanese},
+		{header: "fr-FR,en;q=0.5", want: English},
+		{header: "!!invalid!!", want: Japanese},
+	}
+
+	for _, tt := range tests {
+		if got := ParseAcceptLanguage(tt.header); got != tt.want {
+			t.Errorf("ParseAcceptLanguage(%q) = %q, %q を期待", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestContext(t *testing.T) {
+	if got := FromContext(context.Background()); got != Default {
+		t.Errorf("未設定の FromContext() = %q, %q を期待", got, Default)
+	}
+	ctx := WithLanguage(context.Background(), English)
+	if got := FromContext(ctx); got != English {
+		t.Errorf("FromContext() = %q, %q を期待", got, English)
+	}
+}
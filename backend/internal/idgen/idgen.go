@@ -0,0 +1,48 @@
+package idgen
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Generator はリソースの一意なIDを生成するインターフェース
+// uuid.New() の直接呼び出しを置き換え、テスト時にIDを予測可能にする
+type Generator interface {
+	// NewID は "<prefix>_" + 32文字の16進文字列 形式のIDを返す
+	// 例: NewID("evt") → "evt_0123456789abcdef0123456789abcdef"
+	NewID(prefix string) string
+}
+
+// UUIDGenerator はUUID v4を使用する本番用のGenerator実装
+type UUIDGenerator struct{}
+
+// NewID はUUID（ハイフンなし）にプレフィックスを付けたIDを返す
+func (UUIDGenerator) NewID(prefix string) string {
+	return fmt.Sprintf("%s_%s", prefix, strings.ReplaceAll(uuid.New().String(), "-", ""))
+}
+
+// SequenceGenerator は連番でIDを生成するGenerator実装
+// テストで "evt_00000000000000000000000000000001" のような決定的なIDを得るために使用
+type SequenceGenerator struct {
+	mu   sync.Mutex
+	next uint64
+}
+
+// NewSequenceGenerator は1から採番を開始するSequenceGeneratorを作成
+func NewSequenceGenerator() *SequenceGenerator {
+	return &SequenceGenerator{next: 1}
+}
+
+// NewID は連番を32桁の16進数にゼロ埋めしたIDを返す
+// 形式はUUIDGeneratorと同じため、IDの形式チェックもそのまま通過する
+func (g *SequenceGenerator) NewID(prefix string) string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	id := fmt.Sprintf("%s_%032x", prefix, g.next)
+	g.next++
+	return id
+}
@@ -0,0 +1,121 @@
+// Package logging は log/slog によるJSON形式の構造化ログを提供する
+//
+// CloudWatch Logs Insights で検索できるよう、全ログをJSONで出力し、
+// contextに格納したリクエストID・幹事ID・イベントIDを自動で付与する。
+// メールアドレス・電話番号・アレルギー・備考などの個人情報は出力前に伏字にする。
+//
+// 使用例:
+//
+//	logging.Setup()                                         // main の先頭で一度だけ呼び出す
+//	ctx = logging.With(ctx, slog.String(logging.KeyEventID, event.ID))
+//	slog.InfoContext(ctx, "イベント作成成功")                 // requestId・organizerId・eventId が付与される
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// contextから自動付与される属性のキー
+const (
+	KeyRequestID   = "requestId"
+	KeyOrganizerID = "organizerId"
+	KeyEventID     = "eventId"
+)
+
+// Setup は環境変数 LOG_LEVEL のレベルで標準出力にJSONログを出力するロガーを
+// slog のデフォルトに設定する（log パッケージの出力も同じロガーに流れる）
+func Setup() {
+	slog.SetDefault(New(os.Stdout, LevelFromEnv()))
+}
+
+// New はJSON形式・個人情報マスキング・context属性付与を行うロガーを作成
+func New(w io.Writer, level slog.Leveler) *slog.Logger {
+	handler := slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: redactAttr,
+	})
+	return slog.New(contextHandler{Handler: handler})
+}
+
+// LevelFromEnv は環境変数 LOG_LEVEL（debug / info / warn / error）からログレベルを決定する
+func LevelFromEnv() slog.Level {
+	return ParseLevel(os.Getenv("LOG_LEVEL"))
+}
+
+// ParseLevel はログレベル名を slog.Level に変換する（不明な値・未指定は info）
+func ParseLevel(name string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// contextKey はcontextにログ属性を格納するためのキー型
+type contextKey struct{}
+
+// With はログに自動付与する属性を追加したcontextを返す
+// 同じキーを再度追加した場合は新しい値が優先される
+func With(ctx context.Context, attrs ...slog.Attr) context.Context {
+	existing := attrsFromContext(ctx)
+	merged := make([]slog.Attr, 0, len(existing)+len(attrs))
+	for _, attr := range existing {
+		if !containsKey(attrs, attr.Key) {
+			merged = append(merged, attr)
+		}
+	}
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, contextKey{}, merged)
+}
+
+// attrsFromContext はcontextに格納されたログ属性を返す
+func attrsFromContext(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	attrs, _ := ctx.Value(contextKey{}).([]slog.Attr)
+	return attrs
+}
+
+// containsKey は属性リストに指定キーが含まれるかを判定する
+func containsKey(attrs []slog.Attr, key string) bool {
+	for _, attr := range attrs {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// contextHandler はcontextのログ属性を各レコードに付与する slog.Handler
+type contextHandler struct {
+	slog.Handler
+}
+
+// Handle はcontextの属性を追加してから下位のハンドラーに渡す
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if attrs := attrsFromContext(ctx); len(attrs) > 0 {
+		record = record.Clone()
+		record.AddAttrs(attrs...)
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs は属性を追加したハンドラーを返す（contextHandler のまま包む）
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup はグループを追加したハンドラーを返す（contextHandler のまま包む）
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithGroup(name)}
+}
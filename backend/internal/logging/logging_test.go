@@ -0,0 +1,98 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// decodeLine はJSONログの1行をマップに変換する
+func decodeLine(t *testing.T, buf *bytes.Buffer) map[string]interface{} {
+	t.Helper()
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("ログがJSONではありません: %v\n%s", err, buf.String())
+	}
+	return entry
+}
+
+func TestContextAttributes(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(&buf, slog.LevelInfo)
+
+	ctx := With(context.Background(), slog.String(KeyRequestID, "req-1"), slog.String(KeyOrganizerID, "user-1"))
+	ctx = With(ctx, slog.String(KeyEventID, "evt_1"), slog.String(KeyRequestID, "req-2"))
+	logger.InfoContext(ctx, "イベント作成成功")
+
+	entry := decodeLine(t, &buf)
+	want := map[string]string{"msg": "イベント作成成功", "level": "INFO", KeyRequestID: "req-2", KeyOrganizerID: "user-1", KeyEventID: "evt_1"}
+	for key, value := range want {
+		if entry[key] != value {
+			t.Errorf("%s = %v, %q を期待", key, entry[key], value)
+		}
+	}
+}
+
+func TestRedaction(t *testing.T) {
+	event := domain.Event{
+		ID:    "evt_1",
+		Title: "新人歓迎会",
+		Notes: "佐藤さんは甲殻類アレルギー",
+		Members: []domain.Member{{
+			Name:        "佐藤",
+			Email:       "sato@example.com",
+			Preferences: map[string]interface{}{"allergies": []string{"えび"}, "budget": 5000},
+		}},
+	}
+
+	var buf bytes.Buffer
+	logger := New(&buf, slog.LevelInfo)
+	logger.Info("テスト",
+		slog.String("email", "kanji@example.com"),
+		slog.String("Phone_Number", "090-1234-5678"),
+		slog.Any("event", event),
+		slog.Group("member", slog.String("allergy", "そば")),
+		slog.Any("error", errors.New("接続エラー")),
+	)
+
+	output := buf.String()
+	for _, secret := range []string{"kanji@example.com", "090-1234-5678", "sato@example.com", "えび", "甲殻類", "そば"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("ログに個人情報 %q が含まれています: %s", secret, output)
+		}
+	}
+	for _, kept := range []string{"新人歓迎会", "佐藤", "5000", "接続エラー"} {
+		if !strings.Contains(output, kept) {
+			t.Errorf("ログに %q が含まれていません: %s", kept, output)
+		}
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := map[string]slog.Level{
+		"debug": slog.LevelDebug,
+		"INFO":  slog.LevelInfo,
+		"warn":  slog.LevelWarn,
+		"error": slog.LevelError,
+		"":      slog.LevelInfo,
+		"trace": slog.LevelInfo,
+	}
+	for name, want := range tests {
+		if got := ParseLevel(name); got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v を期待", name, got, want)
+		}
+	}
+
+	var buf bytes.Buffer
+	logger := New(&buf, ParseLevel("warn"))
+	logger.Info("出力されない")
+	if buf.Len() != 0 {
+		t.Errorf("warn レベルで info ログが出力されました: %s", buf.String())
+	}
+}
@@ -0,0 +1,85 @@
+package logging
+
+import (
+	"encoding/json"
+	"log/slog"
+	"strings"
+)
+
+// RedactedValue は伏字にした値の代わりに出力する文字列
+const RedactedValue = "[REDACTED]"
+
+// sensitiveKeys はログに出力してはならない項目のキー（小文字・区切り文字なしで比較）
+// アレルギーは要配慮個人情報（健康情報）にあたるため、CloudWatch Logs に残さない
+var sensitiveKeys = map[string]bool{
+	"email":       true,
+	"phone":       true,
+	"phonenumber": true,
+	"tel":         true,
+	"allergy":     true,
+	"allergies":   true,
+	"notes":       true,
+}
+
+// isSensitiveKey はキーがマスキング対象かを判定する
+// "Email"・"phone_number"・"allergies" などの表記揺れを吸収する
+func isSensitiveKey(key string) bool {
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	return sensitiveKeys[normalized]
+}
+
+// redactAttr は slog.HandlerOptions.ReplaceAttr として個人情報を伏字にする
+//
+//   - キーがマスキング対象の属性は値を RedactedValue に置き換える
+//   - 構造体・マップ・スライスはJSONに変換した上で、入れ子のキーも含めて伏字にする
+//     （例: slog.Any("event", event) の members[].email や preferences.allergies）
+func redactAttr(groups []string, attr slog.Attr) slog.Attr {
+	if isSensitiveKey(attr.Key) {
+		return slog.String(attr.Key, RedactedValue)
+	}
+
+	if attr.Value.Kind() != slog.KindAny {
+		return attr
+	}
+	value := attr.Value.Any()
+	if _, isError := value.(error); isError {
+		return attr
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return attr
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return attr
+	}
+	switch decoded.(type) {
+	case map[string]interface{}, []interface{}:
+		return slog.Any(attr.Key, redactValue(decoded))
+	default:
+		return attr
+	}
+}
+
+// redactValue はJSONをデコードした値を再帰的にたどり、マスキング対象のキーを伏字にする
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isSensitiveKey(key) {
+				v[key] = RedactedValue
+				continue
+			}
+			v[key] = redactValue(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// CORSConfig はCORSポリシーの設定
+type CORSConfig struct {
+	// AllowedOrigins は許可するオリジン（例: "https://kanji-navi.app"）
+	// "*" を含む場合は全オリジンを許可する（開発用途のみ）
+	// 空の場合はどのオリジンにもCORSヘッダーを返さない
+	AllowedOrigins []string
+
+	// AllowedMethods はプリフライトで許可するHTTPメソッド
+	AllowedMethods []string
+
+	// AllowedHeaders はプリフライトで許可するリクエストヘッダー
+	AllowedHeaders []string
+
+	// ExposedHeaders はブラウザのJavaScriptから参照可能にするレスポンスヘッダー
+	ExposedHeaders []string
+
+	// MaxAge はプリフライト結果をブラウザがキャッシュする秒数（0 の場合は送信しない）
+	MaxAge int
+}
+
+// デフォルトのCORS設定値（環境変数が未設定の場合に使用）
+var (
+	defaultCORSMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
+	defaultCORSHeaders = []string{"Content-Type", "Authorization", "Accept-Language", "X-Request-Id", "Idempotency-Key", "x-organizer-id"}
+	defaultCORSExposed = []string{RequestIDHeader, IdempotentReplayedHeader}
+)
+
+// defaultCORSMaxAge はプリフライト結果のキャッシュ秒数（10分）
+const defaultCORSMaxAge = 600
+
+// LoadCORSConfigFromEnv は環境変数からCORS設定を読み込む
+//
+// 環境変数（いずれもカンマ区切り）:
+//   - CORS_ALLOWED_ORIGINS: 許可するオリジン（未設定の場合は全て拒否）
+//   - CORS_ALLOWED_METHODS: 許可するメソッド（未設定の場合は GET,POST,PUT,DELETE,OPTIONS）
+//   - CORS_ALLOWED_HEADERS: 許可するヘッダー（未設定の場合は Content-Type,Authorization 等）
+//   - CORS_MAX_AGE: プリフライトのキャッシュ秒数（未設定の場合は600）
+func LoadCORSConfigFromEnv() CORSConfig {
+	config := CORSConfig{
+		AllowedOrigins: splitList(os.Getenv("CORS_ALLOWED_ORIGINS")),
+		AllowedMethods: splitList(os.Getenv("CORS_ALLOWED_METHODS")),
+		AllowedHeaders: splitList(os.Getenv("CORS_ALLOWED_HEADERS")),
+		ExposedHeaders: defaultCORSExposed,
+		MaxAge:         defaultCORSMaxAge,
+	}
+	if len(config.AllowedMethods) == 0 {
+		config.AllowedMethods = defaultCORSMethods
+	}
+	if len(config.AllowedHeaders) == 0 {
+		config.AllowedHeaders = defaultCORSHeaders
+	}
+	if maxAge, err := strconv.Atoi(os.Getenv("CORS_MAX_AGE")); err == nil && maxAge >= 0 {
+		config.MaxAge = maxAge
+	}
+	return config
+}
+
+// envCORSConfig はLambda起動後に一度だけ環境変数から読み込んだCORS設定
+var envCORSConfig = sync.OnceValue(LoadCORSConfigFromEnv)
+
+// CORS はCORSポリシーを適用する
+//
+//   - プリフライト（Origin と Access-Control-Request-Method 付きの OPTIONS）には、
+//     後続のハンドラーを呼ばずに204で応答する
+//   - 許可されたオリジンからのリクエストには Access-Control-Allow-Origin を付与する
+//   - オリジンごとに応答が変わるため、キャッシュ用に常に Vary: Origin を付与する
+func CORS(config CORSConfig) Middleware {
+	allowAll := false
+	allowed := make(map[string]bool, len(config.AllowedOrigins))
+	for _, origin := range config.AllowedOrigins {
+		if origin == "*" {
+			allowAll = true
+		}
+		allowed[strings.TrimRight(origin, "/")] = true
+	}
+
+	allowMethods := strings.Join(config.AllowedMethods, ",")
+	allowHeaders := strings.Join(config.AllowedHeaders, ",")
+	exposeHeaders := strings.Join(config.ExposedHeaders, ",")
+
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			origin := Header(request, "Origin")
+			originAllowed := origin != "" && (allowAll || allowed[origin])
+
+			// プリフライトリクエスト
+			if request.HTTPMethod == "OPTIONS" && origin != "" && Header(request, "Access-Control-Request-Method") != "" {
+				response := events.APIGatewayProxyResponse{StatusCode: 204}
+				setHeader(&response, "Vary", "Origin")
+				if originAllowed {
+					setAllowOrigin(&response, origin, allowAll)
+					setHeader(&response, "Access-Control-Allow-Methods", allowMethods)
+					setHeader(&response, "Access-Control-Allow-Headers", allowHeaders)
+					if config.MaxAge > 0 {
+						setHeader(&response, "Access-Control-Max-Age", strconv.Itoa(config.MaxAge))
+					}
+				}
+				return response, nil
+			}
+
+			response, err := next(ctx, request)
+			setHeader(&response, "Vary", "Origin")
+			if originAllowed {
+				setAllowOrigin(&response, origin, allowAll)
+				if exposeHeaders != "" {
+					setHeader(&response, "Access-Control-Expose-Headers", exposeHeaders)
+				}
+			}
+			return response, err
+		}
+	}
+}
+
+// setAllowOrigin は Access-Control-Allow-Origin を設定する
+// 全オリジン許可の場合は "*"、それ以外はリクエストのオリジンをそのまま返す
+func setAllowOrigin(response *events.APIGatewayProxyResponse, origin string, allowAll bool) {
+	if allowAll {
+		setHeader(response, "Access-Control-Allow-Origin", "*")
+		return
+	}
+	setHeader(response, "Access-Control-Allow-Origin", origin)
+}
+
+// splitList はカンマ区切りの文字列を空要素を除いたスライスに変換する
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
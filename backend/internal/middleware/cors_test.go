@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func TestCORS(t *testing.T) {
+	config := CORSConfig{
+		AllowedOrigins: []string{"https://kanji-navi.app", "http://localhost:8081"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Content-Type", "Authorization"},
+		ExposedHeaders: []string{RequestIDHeader},
+		MaxAge:         600,
+	}
+	preflight := func(origin string) events.APIGatewayProxyRequest {
+		return events.APIGatewayProxyRequest{HTTPMethod: "OPTIONS", Headers: map[string]string{
+			"Origin":                        origin,
+			"Access-Control-Request-Method": "POST",
+		}}
+	}
+	get := func(origin string) events.APIGatewayProxyRequest {
+		request := events.APIGatewayProxyRequest{HTTPMethod: "GET"}
+		if origin != "" {
+			request.Headers = map[string]string{"origin": origin}
+		}
+		return request
+	}
+
+	tests := []struct {
+		name        string
+		config      CORSConfig
+		request     events.APIGatewayProxyRequest
+		wantStatus  int
+		wantHeaders map[string]string
+	}{
+		{
+			name:       "許可オリジンのプリフライト",
+			config:     config,
+			request:    preflight("https://kanji-navi.app"),
+			wantStatus: 204,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "https://kanji-navi.app",
+				"Access-Control-Allow-Methods": "GET,POST",
+				"Access-Control-Allow-Headers": "Content-Type,Authorization",
+				"Access-Control-Max-Age":       "600",
+				"Vary":                         "Origin",
+			},
+		},
+		{
+			name:        "許可されていないオリジンのプリフライト",
+			config:      config,
+			request:     preflight("https://evil.example.com"),
+			wantStatus:  204,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": "", "Access-Control-Allow-Methods": "", "Vary": "Origin"},
+		},
+		{
+			name:       "許可オリジンの通常リクエスト",
+			config:     config,
+			request:    get("http://localhost:8081"),
+			wantStatus: 200,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":   "http://localhost:8081",
+				"Access-Control-Expose-Headers": RequestIDHeader,
+				"Access-Control-Allow-Methods":  "",
+				"Vary":                          "Origin",
+			},
+		},
+		{
+			name:        "許可されていないオリジンの通常リクエスト",
+			config:      config,
+			request:     get("https://evil.example.com"),
+			wantStatus:  200,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": "", "Vary": "Origin"},
+		},
+		{
+			name:        "Origin なし（同一オリジン・サーバー間通信）",
+			config:      config,
+			request:     get(""),
+			wantStatus:  200,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": "", "Vary": "Origin"},
+		},
+		{
+			name:        "全オリジン許可",
+			config:      CORSConfig{AllowedOrigins: []string{"*"}},
+			request:     get("https://any.example.com"),
+			wantStatus:  200,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": "*"},
+		},
+		{
+			name:        "オリジン未設定では全て拒否",
+			config:      CORSConfig{},
+			request:     preflight("https://kanji-navi.app"),
+			wantStatus:  204,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := Chain(okHandler, CORS(tt.config))(context.Background(), tt.request)
+			if err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("StatusCode = %d, %d を期待", resp.StatusCode, tt.wantStatus)
+			}
+			for name, want := range tt.wantHeaders {
+				if got := resp.Headers[name]; got != want {
+					t.Errorf("%s = %q, %q を期待", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadCORSConfigFromEnv(t *testing.T) {
+	t.Setenv("CORS_ALLOWED_ORIGINS", " https://kanji-navi.app , http://localhost:8081,")
+	t.Setenv("CORS_ALLOWED_METHODS", "")
+	t.Setenv("CORS_MAX_AGE", "60")
+
+	config := LoadCORSConfigFromEnv()
+	if len(config.AllowedOrigins) != 2 || config.AllowedOrigins[0] != "https://kanji-navi.app" || config.AllowedOrigins[1] != "http://localhost:8081" {
+		t.Errorf("AllowedOrigins = %q", config.AllowedOrigins)
+	}
+	if len(config.AllowedMethods) != len(defaultCORSMethods) {
+		t.Errorf("AllowedMethods = %q, デフォルト値を期待", config.AllowedMethods)
+	}
+	if config.MaxAge != 60 {
+		t.Errorf("MaxAge = %d, 60 を期待", config.MaxAge)
+	}
+}
@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+// IdempotencyKeyHeader はクライアントが再送を識別するためのキーを指定するヘッダー名
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader は保存済みのレスポンスを返したことを示すレスポンスヘッダー名
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// maxIdempotencyKeyLength は Idempotency-Key の最大長（UUIDを想定し、余裕を持たせた値）
+const maxIdempotencyKeyLength = 255
+
+// Idempotency は Idempotency-Key ヘッダー付きのリクエストを冪等に処理する
+// タイムアウト後の再送でイベントが重複作成されないよう、初回のレスポンスを
+// repository.IdempotencyTTL の間保存し、同じキーの再送にはそのレスポンスを返す
+//
+//   - 同じキー・同じ内容の再送: 保存済みのレスポンスを返す（Idempotent-Replayed: true）
+//   - 同じキー・異なる内容: 422 IDEMPOTENCY_KEY_REUSED
+//   - 初回のリクエストが処理中: 409 IDEMPOTENCY_KEY_IN_PROGRESS
+//   - 初回のリクエストが処理中のまま repository.IdempotencyLease を過ぎた: 未使用のキーとして処理し直す
+//   - ヘッダーなし: 通常どおり処理する
+//
+// キーは幹事ごとに管理するため、Authenticate より内側（ハンドラーの直前）に適用する
+// 5xxエラーのレスポンスは保存せず、同じキーでの再試行を許可する
+func Idempotency(repo repository.IdempotencyRepository) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			key := Header(request, IdempotencyKeyHeader)
+			if key == "" || repo == nil {
+				return next(ctx, request)
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				return Error(400, "INVALID_IDEMPOTENCY_KEY", "Idempotency-Key が長すぎます", map[string]interface{}{
+					"maxLength": maxIdempotencyKeyLength,
+				}), nil
+			}
+
+			principal, ok := auth.PrincipalFromContext(ctx)
+			if !ok {
+				return next(ctx, request)
+			}
+			organizerID := principal.UserID
+			requestHash := hashRequest(request)
+
+			_, err := repo.ReserveIdempotencyKey(ctx, organizerID, key, requestHash)
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				if response, found := replayIdempotentResponse(ctx, repo, organizerID, key, requestHash); found {
+					return response, nil
+				}
+				// 確保済みのレコードが解放・失効した直後（確保の期限切れを含む）のため、もう一度だけ確保を試みる
+				_, err = repo.ReserveIdempotencyKey(ctx, organizerID, key, requestHash)
+				if errors.Is(err, repository.ErrAlreadyExists) {
+					return idempotencyInProgress(), nil
+				}
+			}
+			if err != nil {
+				slog.ErrorContext(ctx, "Idempotency-Key の確保に失敗", slog.Any("error", err))
+				return Error(500, "INTERNAL_ERROR", "サーバー内部エラーが発生しました", nil), nil
+			}
+
+			response, err := next(ctx, request)
+			if err != nil || response.StatusCode >= 500 {
+				if releaseErr := repo.ReleaseIdempotencyKey(ctx, organizerID, key); releaseErr != nil {
+					slog.ErrorContext(ctx, "Idempotency-Key の解放に失敗", slog.Any("error", releaseErr))
+				}
+				return response, err
+			}
+
+			// 保存に失敗しても処理自体は成功しているため、レスポンスはそのまま返す
+			// 処理中のまま残ったキーは repository.IdempotencyLease の経過後に再送で確保し直せる
+			if err := repo.CompleteIdempotencyKey(ctx, organizerID, key, response.StatusCode, response.Body); err != nil {
+				slog.ErrorContext(ctx, "冪等性レコードの保存に失敗", slog.Any("error", err))
+			}
+			return response, nil
+		}
+	}
+}
+
+// replayIdempotentResponse は使用済みのキーで届いたリクエストへのレスポンスを返す
+// 確保に失敗した直後にレコードが解放・失効した場合（処理中のまま確保の期限を過ぎた場合を含む）は
+// 未使用のキーとして扱うため、found = false を返す
+func replayIdempotentResponse(ctx context.Context, repo repository.IdempotencyRepository, organizerID string, key string, requestHash string) (response events.APIGatewayProxyResponse, found bool) {
+	record, err := repo.GetIdempotencyRecord(ctx, organizerID, key)
+	if errors.Is(err, repository.ErrNotFound) {
+		return events.APIGatewayProxyResponse{}, false
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "冪等性レコードの取得に失敗", slog.Any("error", err))
+		return Error(500, "INTERNAL_ERROR", "サーバー内部エラーが発生しました", nil), true
+	}
+
+	if record.RequestHash != requestHash {
+		slog.WarnContext(ctx, "Idempotency-Key が異なるリクエストで再利用されました")
+		return Error(422, "IDEMPOTENCY_KEY_REUSED", "この Idempotency-Key は異なる内容のリクエストで使用済みです", nil), true
+	}
+	if !record.Completed() {
+		return idempotencyInProgress(), true
+	}
+
+	slog.InfoContext(ctx, "保存済みのレスポンスを返します", slog.Int("status", record.StatusCode))
+	return events.APIGatewayProxyResponse{
+		StatusCode: record.StatusCode,
+		Headers: map[string]string{
+			"Content-Type":           "application/json",
+			IdempotentReplayedHeader: "true",
+		},
+		Body: record.ResponseBody,
+	}, true
+}
+
+// idempotencyInProgress は同じキーの初回リクエストが処理中であることを示す409レスポンス
+func idempotencyInProgress() events.APIGatewayProxyResponse {
+	return Error(409, "IDEMPOTENCY_KEY_IN_PROGRESS", "同じ Idempotency-Key のリクエストを処理中です。しばらくしてから再試行してください", nil)
+}
+
+// hashRequest はリクエストのメソッド・パス・ボディからSHA-256ハッシュを計算する
+// ヘッダーは再送時に変わり得る（X-Request-Id等）ため含めない
+func hashRequest(request events.APIGatewayProxyRequest) string {
+	sum := sha256.Sum256([]byte(request.HTTPMethod + "\n" + request.Path + "\n" + request.Body))
+	return hex.EncodeToString(sum[:])
+}
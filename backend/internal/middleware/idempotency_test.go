@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/repository"
+)
+
+func TestIdempotency(t *testing.T) {
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "user-1"})
+	request := events.APIGatewayProxyRequest{
+		HTTPMethod: "POST",
+		Path:       "/events",
+		Headers:    map[string]string{"idempotency-key": "key-1"},
+		Body:       `{"title":"歓迎会"}`,
+	}
+
+	t.Run("初回のリクエストが処理中の場合は409", func(t *testing.T) {
+		repo := repository.NewMemoryIdempotencyRepository()
+		if _, err := repo.ReserveIdempotencyKey(ctx, "user-1", "key-1", hashRequest(request)); err != nil {
+			t.Fatalf("ReserveIdempotencyKey() error = %v", err)
+		}
+
+		resp, _ := Idempotency(repo)(okHandler)(ctx, request)
+		if resp.StatusCode != 409 || errorCode(t, resp) != "IDEMPOTENCY_KEY_IN_PROGRESS" {
+			t.Errorf("StatusCode = %d, body = %s, 409 IDEMPOTENCY_KEY_IN_PROGRESS を期待", resp.StatusCode, resp.Body)
+		}
+	})
+
+	t.Run("処理中のまま確保の期限を過ぎたキーは処理し直す", func(t *testing.T) {
+		fixed := clock.NewFixedClock(time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC))
+		repo := repository.NewMemoryIdempotencyRepository(repository.WithClock(fixed))
+		// 初回のリクエストがキーを確保したまま、完了も解放もされずに終了した状態
+		if _, err := repo.ReserveIdempotencyKey(ctx, "user-1", "key-1", hashRequest(request)); err != nil {
+			t.Fatalf("ReserveIdempotencyKey() error = %v", err)
+		}
+		fixed.Advance(repository.IdempotencyLease)
+
+		calls := 0
+		counting := func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			calls++
+			return okHandler(ctx, request)
+		}
+		h := Idempotency(repo)(counting)
+		if resp, _ := h(ctx, request); resp.StatusCode != 200 || calls != 1 {
+			t.Fatalf("期限後の再送: StatusCode = %d, 呼び出し回数 = %d, 200・1回を期待", resp.StatusCode, calls)
+		}
+		resp, _ := h(ctx, request)
+		if resp.Headers[IdempotentReplayedHeader] != "true" || calls != 1 {
+			t.Errorf("再送: headers = %v, 呼び出し回数 = %d, 保存済みレスポンス・1回を期待", resp.Headers, calls)
+		}
+	})
+
+	t.Run("5xxエラーは保存せず再試行を許可", func(t *testing.T) {
+		repo := repository.NewMemoryIdempotencyRepository()
+		calls := 0
+		flaky := func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			calls++
+			if calls == 1 {
+				return Error(500, "INTERNAL_ERROR", "一時的なエラー", nil), nil
+			}
+			return okHandler(ctx, request)
+		}
+		h := Idempotency(repo)(flaky)
+
+		if resp, _ := h(ctx, request); resp.StatusCode != 500 {
+			t.Fatalf("1回目の StatusCode = %d, 500 を期待", resp.StatusCode)
+		}
+		resp, _ := h(ctx, request)
+		if resp.StatusCode != 200 || calls != 2 {
+			t.Errorf("再試行: StatusCode = %d, 呼び出し回数 = %d, 200・2回を期待", resp.StatusCode, calls)
+		}
+		if resp.Headers[IdempotentReplayedHeader] != "" {
+			t.Errorf("再試行が保存済みレスポンスとして返されました: %v", resp.Headers)
+		}
+	})
+
+	t.Run("長すぎるキーは400", func(t *testing.T) {
+		long := request
+		long.Headers = map[string]string{IdempotencyKeyHeader: strings.Repeat("k", maxIdempotencyKeyLength+1)}
+
+		resp, _ := Idempotency(repository.NewMemoryIdempotencyRepository())(okHandler)(ctx, long)
+		if resp.StatusCode != 400 || errorCode(t, resp) != "INVALID_IDEMPOTENCY_KEY" {
+			t.Errorf("StatusCode = %d, body = %s, 400 INVALID_IDEMPOTENCY_KEY を期待", resp.StatusCode, resp.Body)
+		}
+	})
+}
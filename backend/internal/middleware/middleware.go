@@ -0,0 +1,264 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"mime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+	"github.com/luck-tech/kanji-log/backend/internal/logging"
+)
+
+// HandlerFunc はAPI Gateway Proxy統合のLambdaハンドラー
+// lambda.Start にそのまま渡すことができる
+type HandlerFunc func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+
+// Middleware はHandlerFuncを包んで共通処理を追加する関数
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// DefaultMaxBodyBytes はリクエストボディの既定の上限サイズ（100KB）
+const DefaultMaxBodyBytes = 100 * 1024
+
+// RequestIDHeader はリクエストIDを受け渡すヘッダー名
+const RequestIDHeader = "X-Request-Id"
+
+// Chain はミドルウェアを適用したハンドラーを返す
+// mws の先頭が最も外側（最初に実行される）になる
+func Chain(h HandlerFunc, mws ...Middleware) HandlerFunc {
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+	return h
+}
+
+// Config は Stack で組み立てる標準ミドルウェアの設定
+type Config struct {
+	// Methods は許可するHTTPメソッド（例: "POST"）
+	Methods []string
+
+	// Authenticator が nil の場合は認証不要の公開エンドポイントとして扱う
+	Authenticator *auth.Authenticator
+
+	// MaxBodyBytes はリクエストボディの上限サイズ（0 の場合は DefaultMaxBodyBytes）
+	MaxBodyBytes int
+
+	// CORS はCORSポリシー（nil の場合は環境変数 CORS_* から読み込んだ設定）
+	CORS *CORSConfig
+}
+
+// Stack は全Lambda共通のミドルウェアを標準の順序で返す
+// CORSのプリフライトはメソッド検証・認証より前に応答する
+//
+// 実行順序（外側から）:
+//
+//	Recover → RequestID → Logging → CORS → Language → AllowMethods → LimitBody → RequireJSON → Authenticate → ハンドラー
+//
+// 使用例:
+//
+//	h := middleware.Chain(createEvent, middleware.Stack(middleware.Config{
+//		Methods:       []string{"POST"},
+//		Authenticator: authenticator,
+//	})...)
+//	lambda.Start(h)
+func Stack(config Config) []Middleware {
+	maxBodyBytes := config.MaxBodyBytes
+	if maxBodyBytes == 0 {
+		maxBodyBytes = DefaultMaxBodyBytes
+	}
+
+	corsConfig := config.CORS
+	if corsConfig == nil {
+		envConfig := envCORSConfig()
+		corsConfig = &envConfig
+	}
+
+	mws := []Middleware{
+		Recover(),
+		RequestID(),
+		Logging(),
+		CORS(*corsConfig),
+		Language(),
+		AllowMethods(config.Methods...),
+		LimitBody(maxBodyBytes),
+		RequireJSON(),
+	}
+	if config.Authenticator != nil {
+		mws = append(mws, Authenticate(config.Authenticator))
+	}
+	return mws
+}
+
+// Recover はハンドラー内のpanicを捕捉し、500エラーレスポンスに変換する
+// panicでLambdaが異常終了すると、API Gatewayは汎用の502を返してしまうため
+func Recover() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, request events.APIGatewayProxyRequest) (response events.APIGatewayProxyResponse, err error) {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					slog.ErrorContext(ctx, "panicが発生しました",
+						slog.Any("panic", recovered),
+						slog.String("stack", string(debug.Stack())),
+					)
+					response = Error(500, "INTERNAL_ERROR", "サーバー内部エラーが発生しました", nil)
+					err = nil
+				}
+			}()
+			return next(ctx, request)
+		}
+	}
+}
+
+// requestIDKey はcontextにリクエストIDを格納するためのキー型
+type requestIDKey struct{}
+
+// RequestID はリクエストIDをcontext（ログ属性を含む）に格納し、レスポンスヘッダーにも付与する
+// クライアントが X-Request-Id を送信した場合はそれを引き継ぎ、
+// なければAPI GatewayのリクエストIDを使用する（CloudWatch Logsとの突き合わせに使う）
+func RequestID() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			requestID := Header(request, RequestIDHeader)
+			if requestID == "" {
+				requestID = request.RequestContext.RequestID
+			}
+
+			ctx = context.WithValue(ctx, requestIDKey{}, requestID)
+			ctx = logging.With(ctx, slog.String(logging.KeyRequestID, requestID))
+			response, err := next(ctx, request)
+			if requestID != "" {
+				setHeader(&response, RequestIDHeader, requestID)
+			}
+			return response, err
+		}
+	}
+}
+
+// RequestIDFromContext はcontextに格納されたリクエストIDを返す（未設定の場合は空文字）
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// Logging はリクエストの受信とレスポンスのステータス・処理時間をログに記録する
+// リクエストボディには個人情報が含まれ得るため記録しない
+func Logging() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			start := time.Now()
+			slog.InfoContext(ctx, "リクエスト受信",
+				slog.String("method", request.HTTPMethod),
+				slog.String("path", request.Path),
+			)
+
+			response, err := next(ctx, request)
+
+			slog.InfoContext(ctx, "レスポンス送信",
+				slog.Int("status", response.StatusCode),
+				slog.Int64("durationMs", time.Since(start).Milliseconds()),
+			)
+			return response, err
+		}
+	}
+}
+
+// Language は Accept-Language ヘッダーからメッセージの言語を決定し、contextに格納する
+// ハンドラーでは i18n.FromContext(ctx) で取得する
+func Language() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			lang := i18n.ParseAcceptLanguage(Header(request, "Accept-Language"))
+			return next(i18n.WithLanguage(ctx, lang), request)
+		}
+	}
+}
+
+// AllowMethods は許可されていないHTTPメソッドのリクエストを拒否する
+func AllowMethods(methods ...string) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			for _, method := range methods {
+				if strings.EqualFold(request.HTTPMethod, method) {
+					return next(ctx, request)
+				}
+			}
+			message := fmt.Sprintf("%sメソッドのみサポートされています", strings.Join(methods, ", "))
+			return Error(400, "METHOD_NOT_ALLOWED", message, nil), nil
+		}
+	}
+}
+
+// LimitBody はボディが maxBytes を超えるリクエストを413で拒否する
+func LimitBody(maxBytes int) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			if len(request.Body) > maxBytes {
+				return Error(413, "PAYLOAD_TOO_LARGE", "リクエストボディが大きすぎます", map[string]interface{}{
+					"maxBytes": maxBytes,
+				}), nil
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// RequireJSON はボディ付きのリクエストに Content-Type: application/json を要求する
+// ボディが空のリクエスト（GET・DELETE等）は対象外
+func RequireJSON() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			if request.Body == "" {
+				return next(ctx, request)
+			}
+
+			mediaType, _, err := mime.ParseMediaType(Header(request, "Content-Type"))
+			if err != nil || mediaType != "application/json" {
+				return Error(415, "UNSUPPORTED_MEDIA_TYPE", "Content-Type は application/json を指定してください", nil), nil
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// Authenticate はリクエストを認証し、認証済みユーザーをcontextに格納する
+// 以降のログには幹事ID（organizerId）が付与される
+// ハンドラーでは auth.PrincipalFromContext(ctx) で取得する
+func Authenticate(authenticator *auth.Authenticator) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			principal, err := authenticator.Authenticate(request)
+			if err != nil {
+				slog.WarnContext(ctx, "認証エラー", slog.Any("error", err))
+				return Error(401, "UNAUTHORIZED", "認証が必要です", nil), nil
+			}
+			ctx = logging.With(ctx, slog.String(logging.KeyOrganizerID, principal.UserID))
+			return next(auth.WithPrincipal(ctx, principal), request)
+		}
+	}
+}
+
+// Header はヘッダー名の大文字・小文字を区別せずに値を取得
+// API Gatewayはクライアントが送信した表記のままヘッダーを渡すため、
+// "Content-Type" と "content-type" の両方に対応する必要がある
+func Header(request events.APIGatewayProxyRequest, name string) string {
+	for key, value := range request.Headers {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+	return ""
+}
+
+// setHeader はレスポンスヘッダーを設定する（Headers が nil の場合は初期化）
+func setHeader(response *events.APIGatewayProxyResponse, name string, value string) {
+	if response.Headers == nil {
+		response.Headers = make(map[string]string)
+	}
+	response.Headers[name] = value
+}
@@ -0,0 +1,176 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/luck-tech/kanji-log/backend/internal/auth"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+)
+
+// okHandler は200を返すだけのテスト用ハンドラー
+func okHandler(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	return JSON(200, map[string]bool{"success": true}), nil
+}
+
+// errorCode はエラーレスポンスのボディから error.code を取り出す
+func errorCode(t *testing.T, response events.APIGatewayProxyResponse) string {
+	t.Helper()
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(response.Body), &body); err != nil {
+		t.Fatalf("レスポンスボディのパースに失敗: %v", err)
+	}
+	return body.Error.Code
+}
+
+func TestStack(t *testing.T) {
+	authenticator, err := auth.New(auth.Config{DevMode: true})
+	if err != nil {
+		t.Fatalf("auth.New() error = %v", err)
+	}
+	h := Chain(okHandler, Stack(Config{
+		Methods:       []string{"POST"},
+		Authenticator: authenticator,
+		MaxBodyBytes:  16,
+		CORS:          &CORSConfig{AllowedOrigins: []string{"https://kanji-navi.app"}},
+	})...)
+	withOrigin := func(request events.APIGatewayProxyRequest) events.APIGatewayProxyRequest {
+		headers := map[string]string{"Origin": "https://kanji-navi.app"}
+		for key, value := range request.Headers {
+			headers[key] = value
+		}
+		request.Headers = headers
+		return request
+	}
+
+	jsonHeaders := map[string]string{"content-type": "application/json; charset=utf-8", auth.DevHeader: "user-1"}
+
+	tests := []struct {
+		name       string
+		request    events.APIGatewayProxyRequest
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "正常なリクエスト", request: events.APIGatewayProxyRequest{HTTPMethod: "POST", Headers: jsonHeaders, Body: `{}`}, wantStatus: 200},
+		{name: "許可されていないメソッド", request: events.APIGatewayProxyRequest{HTTPMethod: "GET", Headers: jsonHeaders}, wantStatus: 400, wantCode: "METHOD_NOT_ALLOWED"},
+		{name: "ボディが上限を超える", request: events.APIGatewayProxyRequest{HTTPMethod: "POST", Headers: jsonHeaders, Body: `{"a":"0123456789"}`}, wantStatus: 413, wantCode: "PAYLOAD_TOO_LARGE"},
+		{name: "Content-Type がない", request: events.APIGatewayProxyRequest{HTTPMethod: "POST", Headers: map[string]string{auth.DevHeader: "user-1"}, Body: `{}`}, wantStatus: 415, wantCode: "UNSUPPORTED_MEDIA_TYPE"},
+		{name: "ボディが空なら Content-Type は不要", request: events.APIGatewayProxyRequest{HTTPMethod: "POST", Headers: map[string]string{auth.DevHeader: "user-1"}}, wantStatus: 200},
+		{name: "認証情報なし", request: events.APIGatewayProxyRequest{HTTPMethod: "POST", Headers: map[string]string{"Content-Type": "application/json"}, Body: `{}`}, wantStatus: 401, wantCode: "UNAUTHORIZED"},
+		{name: "プリフライトはメソッド検証・認証より前に応答", request: events.APIGatewayProxyRequest{HTTPMethod: "OPTIONS", Headers: map[string]string{"Access-Control-Request-Method": "POST"}}, wantStatus: 204},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := h(context.Background(), withOrigin(tt.request))
+			if err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("StatusCode = %d, %d を期待 (body: %s)", resp.StatusCode, tt.wantStatus, resp.Body)
+			}
+			if tt.wantCode != "" {
+				if code := errorCode(t, resp); code != tt.wantCode {
+					t.Errorf("error.code = %q, %q を期待", code, tt.wantCode)
+				}
+			}
+			// エラーレスポンスにもCORSヘッダーが付与されること
+			if resp.Headers["Access-Control-Allow-Origin"] != "https://kanji-navi.app" || resp.Headers["Vary"] != "Origin" {
+				t.Errorf("CORSヘッダー = %v", resp.Headers)
+			}
+		})
+	}
+}
+
+func TestRecover(t *testing.T) {
+	panicking := func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		panic("boom")
+	}
+
+	resp, err := Chain(panicking, Recover())(context.Background(), events.APIGatewayProxyRequest{})
+	if err != nil {
+		t.Fatalf("error = %v, nil を期待", err)
+	}
+	if resp.StatusCode != 500 || errorCode(t, resp) != "INTERNAL_ERROR" {
+		t.Errorf("StatusCode = %d, body = %s, 500 INTERNAL_ERROR を期待", resp.StatusCode, resp.Body)
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name    string
+		request events.APIGatewayProxyRequest
+		want    string
+	}{
+		{
+			name:    "API GatewayのリクエストID",
+			request: events.APIGatewayProxyRequest{RequestContext: events.APIGatewayProxyRequestContext{RequestID: "apigw-1"}},
+			want:    "apigw-1",
+		},
+		{
+			name: "クライアント指定のIDを優先",
+			request: events.APIGatewayProxyRequest{
+				Headers:        map[string]string{"x-request-id": "client-1"},
+				RequestContext: events.APIGatewayProxyRequestContext{RequestID: "apigw-1"},
+			},
+			want: "client-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			h := Chain(func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+				got = RequestIDFromContext(ctx)
+				return okHandler(ctx, request)
+			}, RequestID())
+
+			resp, _ := h(context.Background(), tt.request)
+			if got != tt.want {
+				t.Errorf("RequestIDFromContext() = %q, %q を期待", got, tt.want)
+			}
+			if resp.Headers[RequestIDHeader] != tt.want {
+				t.Errorf("%s = %q, %q を期待", RequestIDHeader, resp.Headers[RequestIDHeader], tt.want)
+			}
+		})
+	}
+}
+
+func TestChainContext(t *testing.T) {
+	authenticator, _ := auth.New(auth.Config{DevMode: true})
+
+	var principal *auth.Principal
+	var lang i18n.Language
+	h := Chain(func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		principal, _ = auth.PrincipalFromContext(ctx)
+		lang = i18n.FromContext(ctx)
+		return okHandler(ctx, request)
+	}, Language(), Authenticate(authenticator))
+
+	_, _ = h(context.Background(), events.APIGatewayProxyRequest{Headers: map[string]string{
+		auth.DevHeader:    "user-1",
+		"Accept-Language": "en-US",
+	}})
+
+	if principal == nil || principal.UserID != "user-1" {
+		t.Errorf("Principal = %+v, user-1 を期待", principal)
+	}
+	if lang != i18n.English {
+		t.Errorf("言語 = %q, en を期待", lang)
+	}
+}
+
+func TestJSONMarshalError(t *testing.T) {
+	resp := JSON(200, map[string]interface{}{"ch": make(chan int)})
+	if resp.StatusCode != 500 || !strings.Contains(resp.Body, "RESPONSE_ERROR") {
+		t.Errorf("StatusCode = %d, body = %s, 500 RESPONSE_ERROR を期待", resp.StatusCode, resp.Body)
+	}
+}
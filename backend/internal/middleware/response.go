@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"encoding/json"
+	"log/slog"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// JSON は任意の値をJSONにしたAPI Gatewayレスポンスを生成
+// マーシャリングに失敗した場合は500のエラーレスポンスを返す
+// CORSヘッダーは CORS ミドルウェアが付与するため、ここでは設定しない
+func JSON(statusCode int, body interface{}) events.APIGatewayProxyResponse {
+	responseBody, err := json.Marshal(body)
+	if err != nil {
+		slog.Error("レスポンスJSONマーシャリングエラー", slog.Any("error", err))
+		return Error(500, "RESPONSE_ERROR", "レスポンスの生成に失敗しました", nil)
+	}
+
+	return events.APIGatewayProxyResponse{
+		StatusCode: statusCode,
+		Headers: map[string]string{
+			"Content-Type": "application/json",
+		},
+		Body: string(responseBody),
+	}
+}
+
+// Error は統一されたエラーレスポンス形式を生成
+//
+// レスポンス例:
+//
+//	{"success": false, "error": {"code": "UNAUTHORIZED", "message": "認証が必要です"}}
+func Error(statusCode int, code string, message string, details map[string]interface{}) events.APIGatewayProxyResponse {
+	errorInfo := map[string]interface{}{
+		"code":    code,
+		"message": message,
+	}
+	if details != nil {
+		errorInfo["details"] = details
+	}
+
+	// map のみで構成されるためマーシャリングは失敗しない
+	responseBody, _ := json.Marshal(map[string]interface{}{
+		"success": false,
+		"error":   errorInfo,
+	})
+
+	return events.APIGatewayProxyResponse{
+		StatusCode: statusCode,
+		Headers: map[string]string{
+			"Content-Type": "application/json",
+		},
+		Body: string(responseBody),
+	}
+}
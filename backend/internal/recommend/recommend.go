@@ -0,0 +1,306 @@
+// Package recommend は参加メンバーの好みから候補店舗を評価し、推薦理由付きで選ぶ
+//
+// 推薦は3種類で、それぞれ異なる店舗を最大1件ずつ返す。
+//   - majority: 予算・好きなジャンル・お酒の好みに合う人数が最も多いお店
+//   - inclusive: 全員のアレルギー・食事制限に対応できるお店（majority 以外で最も評価が高いもの）
+//   - challenge: メンバーの好きなジャンルにないお店（予算が過半数に合うものを優先）
+//
+// 外部サービスや保存先に依存しない純粋な計算のため、同じ入力には常に同じ結果を返す。
+package recommend
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+)
+
+// 1人あたりの得点の配分
+// 予算は参加の可否に直結するため、ジャンルの好みより重くする
+const (
+	budgetPoints  = 3
+	genrePoints   = 2
+	alcoholPoints = 1
+)
+
+// 店舗の特徴（domain.Restaurant.Features）のうち推薦に使用するもの
+const (
+	featureAllergy      = "アレルギー対応"
+	featureFreeDrink    = "飲み放題"
+	featureNonAlcoholic = "ノンアルコール充実"
+)
+
+// candidate は1店舗の評価結果
+type candidate struct {
+	restaurant domain.Restaurant
+
+	// score はメンバーごとの得点の合計
+	score int
+
+	// budgetFits は予算が合うメンバーの人数
+	budgetFits int
+
+	// genreFans はジャンルが好きなメンバーの人数
+	genreFans int
+
+	// drinkersServed はお酒を飲むメンバーがいて飲み放題がある場合の、お酒を飲むメンバーの人数
+	drinkersServed int
+
+	// inclusive は全員のアレルギー・食事制限に対応できるかどうか
+	inclusive bool
+}
+
+// Recommend は候補店舗から推薦の種類ごとに1件ずつ選び、majority・inclusive・challenge の順に返す
+// 条件を満たす店舗がない種類は結果に含めない（候補が空の場合は空のスライス）
+func Recommend(members []domain.MemberPreferences, restaurants []domain.Restaurant, lang i18n.Language) []domain.RestaurantSuggestion {
+	restrictions := collectRestrictions(members)
+	ranked := make([]candidate, 0, len(restaurants))
+	for _, restaurant := range restaurants {
+		ranked = append(ranked, evaluate(restaurant, members, restrictions))
+	}
+
+	// 得点の高い順（同点は評価の高い順、さらに同じなら検索結果の順）
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].restaurant.Rating > ranked[j].restaurant.Rating
+	})
+
+	suggestions := make([]domain.RestaurantSuggestion, 0, 3)
+	picked := make(map[string]bool)
+	pick := func(c candidate, recommendationType string, reason string) {
+		picked[c.restaurant.ID] = true
+		suggestions = append(suggestions, domain.RestaurantSuggestion{
+			Restaurant:           c.restaurant,
+			RecommendationType:   recommendationType,
+			RecommendationReason: reason,
+			Budget:               c.restaurant.PriceRange,
+		})
+	}
+
+	if len(ranked) == 0 {
+		return suggestions
+	}
+	majority := ranked[0]
+	pick(majority, domain.RecommendationMajority, majorityReason(majority, len(members), lang))
+
+	for _, c := range ranked {
+		if c.inclusive && !picked[c.restaurant.ID] {
+			pick(c, domain.RecommendationInclusive, inclusiveReason(c, restrictions, len(members), lang))
+			break
+		}
+	}
+
+	if c, ok := challengeCandidate(ranked, picked, len(members)); ok {
+		pick(c, domain.RecommendationChallenge, challengeReason(c, len(members), lang))
+	}
+	return suggestions
+}
+
+// restrictions はメンバー全体のアレルギー・食事制限（重複なし、回答順）
+type restrictions struct {
+	allergies []string
+	dietary   []string
+}
+
+// all はアレルギーと食事制限をまとめて返す
+func (r restrictions) all() []string {
+	return append(append([]string(nil), r.allergies...), r.dietary...)
+}
+
+// collectRestrictions はメンバーのアレルギー・食事制限を重複なく集める
+func collectRestrictions(members []domain.MemberPreferences) restrictions {
+	var r restrictions
+	seen := make(map[string]bool)
+	for _, member := range members {
+		for _, allergy := range member.Allergies {
+			if !seen[allergy] {
+				seen[allergy] = true
+				r.allergies = append(r.allergies, allergy)
+			}
+		}
+		for _, restriction := range member.DietaryRestrictions {
+			if !seen[restriction] {
+				seen[restriction] = true
+				r.dietary = append(r.dietary, restriction)
+			}
+		}
+	}
+	return r
+}
+
+// evaluate はメンバー全員の好みに対する店舗の得点を計算する
+func evaluate(restaurant domain.Restaurant, members []domain.MemberPreferences, r restrictions) candidate {
+	c := candidate{restaurant: restaurant, inclusive: true}
+	drinkers := 0
+	for _, member := range members {
+		if fitsBudget(restaurant, member.BudgetRange) {
+			c.score += budgetPoints
+			c.budgetFits++
+		}
+
+		// 好きなジャンルが未回答のメンバーは、どの店でも半分の得点とする
+		if len(member.FavoriteGenres) == 0 {
+			c.score += genrePoints / 2
+		} else if matchesGenre(restaurant.Genre, member.FavoriteGenres) {
+			c.score += genrePoints
+			c.genreFans++
+		}
+
+		switch member.AlcoholPreference {
+		case domain.AlcoholYes:
+			drinkers++
+			if hasFeature(restaurant, featureFreeDrink) {
+				c.score += alcoholPoints
+			}
+		case domain.AlcoholNo:
+			if hasFeature(restaurant, featureNonAlcoholic) {
+				c.score += alcoholPoints
+			}
+		}
+	}
+	if drinkers > 0 && hasFeature(restaurant, featureFreeDrink) {
+		c.drinkersServed = drinkers
+	}
+
+	for _, allergy := range r.allergies {
+		if !hasFeature(restaurant, featureAllergy) && !supports(restaurant, allergy) {
+			c.inclusive = false
+		}
+	}
+	for _, restriction := range r.dietary {
+		if !supports(restaurant, restriction) {
+			c.inclusive = false
+		}
+	}
+	return c
+}
+
+// challengeCandidate はメンバーの好きなジャンルにない店舗のうち、評価が最も高いものを返す
+// 予算が過半数に合う店舗を優先し、なければ予算を問わずに選ぶ
+func challengeCandidate(ranked []candidate, picked map[string]bool, memberCount int) (candidate, bool) {
+	var best candidate
+	found := false
+	bestAffordable := false
+	for _, c := range ranked {
+		if picked[c.restaurant.ID] || c.genreFans > 0 {
+			continue
+		}
+		affordable := c.budgetFits*2 >= memberCount
+		if !found || (affordable && !bestAffordable) ||
+			(affordable == bestAffordable && c.restaurant.Rating > best.restaurant.Rating) {
+			best, found, bestAffordable = c, true, affordable
+		}
+	}
+	return best, found
+}
+
+// fitsBudget は店舗の予算帯がメンバーの予算の範囲と重なるかを返す
+// 予算が未回答・店舗の予算が不明の場合は合うものとして扱う
+func fitsBudget(restaurant domain.Restaurant, budget *domain.BudgetRange) bool {
+	if budget == nil || (restaurant.BudgetMin == 0 && restaurant.BudgetMax == 0) {
+		return true
+	}
+	if budget.Max > 0 && restaurant.BudgetMin > budget.Max {
+		return false
+	}
+	if restaurant.BudgetMax > 0 && restaurant.BudgetMax < budget.Min {
+		return false
+	}
+	return true
+}
+
+// matchesGenre は店舗のジャンルが好きなジャンルのいずれかと一致するかを返す
+// "イタリアン" と "イタリアン・フレンチ" のような表記の差を許容するため部分一致で判定する
+func matchesGenre(genre string, favorites []string) bool {
+	if genre == "" {
+		return false
+	}
+	for _, favorite := range favorites {
+		if strings.Contains(genre, favorite) || strings.Contains(favorite, genre) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasFeature は店舗が特徴 feature を持つかを返す
+func hasFeature(restaurant domain.Restaurant, feature string) bool {
+	for _, f := range restaurant.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// supports は店舗が個別の制限（例: "ベジタリアン" → "ベジタリアン対応"）に対応しているかを返す
+func supports(restaurant domain.Restaurant, restriction string) bool {
+	for _, f := range restaurant.Features {
+		if strings.HasSuffix(f, "対応") && strings.Contains(f, restriction) {
+			return true
+		}
+	}
+	return false
+}
+
+// majorityReason は majority の推薦理由を返す
+func majorityReason(c candidate, memberCount int, lang i18n.Language) string {
+	if lang == i18n.English {
+		reason := fmt.Sprintf("Fits the budget of %d of %d members", c.budgetFits, memberCount)
+		if c.genreFans > 0 {
+			reason += fmt.Sprintf(", and %d like %s", c.genreFans, c.restaurant.Genre)
+		}
+		if c.drinkersServed > 0 {
+			reason += fmt.Sprintf(". All-you-can-drink for the %d who drink", c.drinkersServed)
+		}
+		return reason + "."
+	}
+
+	reason := fmt.Sprintf("参加者%d名中%d名の予算に合い", memberCount, c.budgetFits)
+	if c.genreFans > 0 {
+		reason += fmt.Sprintf("、%d名が好きな%sのお店です", c.genreFans, c.restaurant.Genre)
+	} else {
+		reason += "、多くのメンバーの条件に合うお店です"
+	}
+	if c.drinkersServed > 0 {
+		reason += fmt.Sprintf("。飲み放題があり、お酒を飲む%d名も楽しめます", c.drinkersServed)
+	}
+	return reason + "。"
+}
+
+// inclusiveReason は inclusive の推薦理由を返す
+func inclusiveReason(c candidate, r restrictions, memberCount int, lang i18n.Language) string {
+	all := r.all()
+	if lang == i18n.English {
+		if len(all) == 0 {
+			return fmt.Sprintf("No member has allergies or dietary restrictions; this is another option that fits the budget of %d of %d members.", c.budgetFits, memberCount)
+		}
+		return fmt.Sprintf("Accommodates everyone's allergies and dietary restrictions (%s), and fits the budget of %d of %d members.",
+			strings.Join(all, ", "), c.budgetFits, memberCount)
+	}
+	if len(all) == 0 {
+		return fmt.Sprintf("アレルギー・食事制限のあるメンバーはいません。参加者%d名中%d名の予算に合う別の候補です。", memberCount, c.budgetFits)
+	}
+	return fmt.Sprintf("全員のアレルギー・食事制限（%s）に対応できるお店です。参加者%d名中%d名の予算に合います。",
+		strings.Join(all, "、"), memberCount, c.budgetFits)
+}
+
+// challengeReason は challenge の推薦理由を返す
+func challengeReason(c candidate, memberCount int, lang i18n.Language) string {
+	if lang == i18n.English {
+		reason := fmt.Sprintf("A chance to try %s, which nobody listed as a favorite", c.restaurant.Genre)
+		if c.restaurant.Rating > 0 {
+			reason += fmt.Sprintf(" (rated %.1f)", c.restaurant.Rating)
+		}
+		return reason + fmt.Sprintf(". Fits the budget of %d of %d members.", c.budgetFits, memberCount)
+	}
+	reason := fmt.Sprintf("メンバーの好きなジャンルにはない%sに挑戦できるお店です", c.restaurant.Genre)
+	if c.restaurant.Rating > 0 {
+		reason += fmt.Sprintf("（評価%.1f）", c.restaurant.Rating)
+	}
+	return reason + fmt.Sprintf("。参加者%d名中%d名の予算に合います。", memberCount, c.budgetFits)
+}
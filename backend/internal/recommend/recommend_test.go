@@ -0,0 +1,152 @@
+package recommend
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+)
+
+// testMembers はお酒好きの居酒屋派2名（うち1名はえびアレルギー）と、お酒を飲まないベジタリアン1名
+var testMembers = []domain.MemberPreferences{
+	{
+		FavoriteGenres:    []string{"居酒屋"},
+		BudgetRange:       &domain.BudgetRange{Min: 3000, Max: 4000},
+		AlcoholPreference: domain.AlcoholYes,
+	},
+	{
+		Allergies:         []string{"えび"},
+		FavoriteGenres:    []string{"居酒屋", "和食"},
+		BudgetRange:       &domain.BudgetRange{Min: 2500, Max: 4000},
+		AlcoholPreference: domain.AlcoholYes,
+	},
+	{
+		FavoriteGenres:      []string{"イタリアン"},
+		BudgetRange:         &domain.BudgetRange{Min: 3000, Max: 5000},
+		AlcoholPreference:   domain.AlcoholNo,
+		DietaryRestrictions: []string{"ベジタリアン"},
+	},
+}
+
+var (
+	izakaya  = domain.Restaurant{ID: "izakaya", Genre: "居酒屋", BudgetMin: 3001, BudgetMax: 4000, Rating: 3.8, Features: []string{"飲み放題"}}
+	washoku  = domain.Restaurant{ID: "washoku", Genre: "和食", BudgetMin: 3001, BudgetMax: 4000, Rating: 4.0, Features: []string{"アレルギー対応", "ベジタリアン対応"}}
+	korean   = domain.Restaurant{ID: "korean", Genre: "韓国料理", BudgetMin: 3001, BudgetMax: 4000, Rating: 4.3, Features: []string{"飲み放題"}}
+	yakiniku = domain.Restaurant{ID: "yakiniku", Genre: "焼肉・ホルモン", BudgetMin: 7001, BudgetMax: 10000, Rating: 4.8}
+)
+
+func TestRecommend(t *testing.T) {
+	tests := []struct {
+		name        string
+		restaurants []domain.Restaurant
+		want        map[string]string
+	}{
+		{
+			name:        "3種類とも異なる店舗を選ぶ",
+			restaurants: []domain.Restaurant{yakiniku, korean, washoku, izakaya},
+			want: map[string]string{
+				domain.RecommendationMajority:  "izakaya",
+				domain.RecommendationInclusive: "washoku",
+				domain.RecommendationChallenge: "korean",
+			},
+		},
+		{
+			name:        "全員に対応できる店舗がなければ inclusive は返さない",
+			restaurants: []domain.Restaurant{izakaya, korean},
+			want: map[string]string{
+				domain.RecommendationMajority:  "izakaya",
+				domain.RecommendationChallenge: "korean",
+			},
+		},
+		{
+			name:        "予算が過半数に合わない店舗も他になければ challenge にする",
+			restaurants: []domain.Restaurant{izakaya, yakiniku},
+			want: map[string]string{
+				domain.RecommendationMajority:  "izakaya",
+				domain.RecommendationChallenge: "yakiniku",
+			},
+		},
+		{
+			name:        "候補なし",
+			restaurants: nil,
+			want:        map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Recommend(testMembers, tt.restaurants, i18n.Japanese)
+			gotIDs := make(map[string]string)
+			for _, suggestion := range got {
+				gotIDs[suggestion.RecommendationType] = suggestion.ID
+				if suggestion.RecommendationReason == "" {
+					t.Errorf("%s の推薦理由が空です", suggestion.RecommendationType)
+				}
+			}
+			if len(gotIDs) != len(tt.want) || len(got) != len(tt.want) {
+				t.Fatalf("Recommend() = %v, %v を期待", gotIDs, tt.want)
+			}
+			for recommendationType, id := range tt.want {
+				if gotIDs[recommendationType] != id {
+					t.Errorf("%s = %q, %q を期待", recommendationType, gotIDs[recommendationType], id)
+				}
+			}
+		})
+	}
+}
+
+func TestRecommendReasons(t *testing.T) {
+	restaurants := []domain.Restaurant{yakiniku, korean, washoku, izakaya}
+
+	tests := []struct {
+		lang i18n.Language
+		want []string
+	}{
+		{
+			lang: i18n.Japanese,
+			want: []string{
+				"参加者3名中3名の予算に合い、2名が好きな居酒屋のお店です。飲み放題があり、お酒を飲む2名も楽しめます。",
+				"全員のアレルギー・食事制限（えび、ベジタリアン）に対応できるお店です。参加者3名中3名の予算に合います。",
+				"メンバーの好きなジャンルにはない韓国料理に挑戦できるお店です（評価4.3）。参加者3名中3名の予算に合います。",
+			},
+		},
+		{
+			lang: i18n.English,
+			want: []string{
+				"Fits the budget of 3 of 3 members, and 2 like 居酒屋. All-you-can-drink for the 2 who drink.",
+				"Accommodates everyone's allergies and dietary restrictions (えび, ベジタリアン), and fits the budget of 3 of 3 members.",
+				"A chance to try 韓国料理, which nobody listed as a favorite (rated 4.3). Fits the budget of 3 of 3 members.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.lang), func(t *testing.T) {
+			got := Recommend(testMembers, restaurants, tt.lang)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Recommend() = %d件, %d件を期待", len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if got[i].RecommendationReason != want {
+					t.Errorf("推薦理由[%d] = %q, %q を期待", i, got[i].RecommendationReason, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRecommendWithoutPreferences(t *testing.T) {
+	// 好みが未回答のメンバーだけでも、評価の高い順に推薦できる
+	members := []domain.MemberPreferences{{}, {}}
+	got := Recommend(members, []domain.Restaurant{izakaya, washoku}, i18n.Japanese)
+	if len(got) != 2 || got[0].ID != "washoku" || got[1].RecommendationType != domain.RecommendationInclusive {
+		t.Fatalf("Recommend() = %+v, 評価順に majority・inclusive を期待", got)
+	}
+	if !strings.HasPrefix(got[1].RecommendationReason, "アレルギー・食事制限のあるメンバーはいません") {
+		t.Errorf("推薦理由 = %q", got[1].RecommendationReason)
+	}
+	if got[0].Budget != got[0].PriceRange {
+		t.Errorf("Budget = %q, PriceRange と同じ値を期待", got[0].Budget)
+	}
+}
@@ -0,0 +1,173 @@
+// Package recurrence は domain.RecurrenceRule から定期開催の日付を計算する
+//
+// iCalendar（RFC 5545）の RRULE のうち、飲み会の定期開催で使う
+// 毎週・隔週（FREQ=WEEKLY）、毎月の日付指定（BYMONTHDAY）、毎月第n曜日（BYDAY=3FR, -1FR）に対応する。
+// 存在しない日付（2月30日・第5金曜がない月など）は RRULE と同様にスキップする。
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// DateLayout は開催日の形式（YYYY-MM-DD）
+const DateLayout = "2006-01-02"
+
+// maxIterations は日付計算の繰り返し回数の上限（不正なルールでの無限ループ防止）
+const maxIterations = 1000
+
+// ErrInvalidRule は頻度と曜日・日付の組み合わせが不正であることを表す
+var ErrInvalidRule = errors.New("繰り返しルールの組み合わせが正しくありません")
+
+// weekdays は RRULE の曜日表記と time.Weekday の対応
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// weekdayCodes は time.Weekday から RRULE の曜日表記への対応
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// Normalize は省略された項目を起点日から補完したルールを返す
+// 組み合わせが不正な場合は ErrInvalidRule をラップしたエラーを返す
+//
+//   - Interval 未指定 → 1
+//   - weekly の Weekday 未指定 → 起点日の曜日
+//   - monthly で MonthDay・Week とも未指定 → 起点日の日付（毎月同じ日）
+//   - monthly の Week 指定で Weekday 未指定 → 起点日の曜日
+func Normalize(rule domain.RecurrenceRule, start time.Time) (domain.RecurrenceRule, error) {
+	if rule.Interval == 0 {
+		rule.Interval = 1
+	}
+
+	switch rule.Frequency {
+	case "weekly":
+		if rule.MonthDay != 0 || rule.Week != 0 {
+			return rule, fmt.Errorf("%w: 毎週の繰り返しに日付・第何週は指定できません", ErrInvalidRule)
+		}
+		if rule.Weekday == "" {
+			rule.Weekday = weekdayCodes[start.Weekday()]
+		}
+	case "monthly":
+		if rule.MonthDay != 0 && (rule.Week != 0 || rule.Weekday != "") {
+			return rule, fmt.Errorf("%w: 日付と曜日は同時に指定できません", ErrInvalidRule)
+		}
+		if rule.Weekday != "" && rule.Week == 0 {
+			return rule, fmt.Errorf("%w: 毎月の曜日指定には第何週の指定が必要です", ErrInvalidRule)
+		}
+		if rule.MonthDay == 0 && rule.Week == 0 {
+			rule.MonthDay = start.Day()
+		}
+		if rule.Week != 0 && rule.Weekday == "" {
+			rule.Weekday = weekdayCodes[start.Weekday()]
+		}
+	default:
+		return rule, fmt.Errorf("%w: 未対応の頻度です: %s", ErrInvalidRule, rule.Frequency)
+	}
+
+	if _, ok := weekdays[rule.Weekday]; rule.Weekday != "" && !ok {
+		return rule, fmt.Errorf("%w: 無効な曜日です: %s", ErrInvalidRule, rule.Weekday)
+	}
+	return rule, nil
+}
+
+// Dates は start 以降 until 以前（両端を含む）でルールに合う日付を最大 limit 件返す
+// rule は Normalize 済みであること。limit が 0 以下の場合は件数を制限しない
+func Dates(rule domain.RecurrenceRule, start time.Time, until time.Time, limit int) []time.Time {
+	start = truncateDay(start)
+	until = truncateDay(until)
+
+	var dates []time.Time
+	add := func(date time.Time) bool {
+		if date.Before(start) || date.After(until) {
+			return true
+		}
+		dates = append(dates, date)
+		return limit <= 0 || len(dates) < limit
+	}
+
+	switch rule.Frequency {
+	case "weekly":
+		// 起点日以降で最初の該当曜日から、Interval 週ごと
+		offset := (int(weekdays[rule.Weekday]) - int(start.Weekday()) + 7) % 7
+		date := start.AddDate(0, 0, offset)
+		for i := 0; i < maxIterations && !date.After(until); i++ {
+			if !add(date) {
+				break
+			}
+			date = date.AddDate(0, 0, 7*rule.Interval)
+		}
+	case "monthly":
+		// 起点日の月から Interval か月ごとに、その月の該当日を求める
+		first := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+		for i := 0; i < maxIterations; i++ {
+			month := first.AddDate(0, i*rule.Interval, 0)
+			if month.After(until) {
+				break
+			}
+			date, ok := dateInMonth(rule, month)
+			if ok && !add(date) {
+				break
+			}
+		}
+	}
+	return dates
+}
+
+// dateInMonth は month（1日）の月でルールに合う日付を返す（存在しない場合は false）
+func dateInMonth(rule domain.RecurrenceRule, month time.Time) (time.Time, bool) {
+	if rule.MonthDay != 0 {
+		date := month.AddDate(0, 0, rule.MonthDay-1)
+		return date, date.Month() == month.Month()
+	}
+
+	weekday := weekdays[rule.Weekday]
+	if rule.Week > 0 {
+		offset := (int(weekday) - int(month.Weekday()) + 7) % 7
+		date := month.AddDate(0, 0, offset+7*(rule.Week-1))
+		return date, date.Month() == month.Month()
+	}
+
+	// 最終週: 月末から遡って最初の該当曜日
+	last := month.AddDate(0, 1, -1)
+	offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+	return last.AddDate(0, 0, -offset), true
+}
+
+// RRule はルールを iCalendar の RRULE 文字列に変換する
+// endDate（YYYY-MM-DD、空なら省略）は UNTIL、count（0 なら省略）は COUNT になる
+func RRule(rule domain.RecurrenceRule, endDate string, count int) string {
+	parts := []string{
+		"FREQ=" + strings.ToUpper(rule.Frequency),
+		fmt.Sprintf("INTERVAL=%d", rule.Interval),
+	}
+	switch {
+	case rule.MonthDay != 0:
+		parts = append(parts, fmt.Sprintf("BYMONTHDAY=%d", rule.MonthDay))
+	case rule.Week != 0:
+		parts = append(parts, fmt.Sprintf("BYDAY=%d%s", rule.Week, rule.Weekday))
+	case rule.Weekday != "":
+		parts = append(parts, "BYDAY="+rule.Weekday)
+	}
+	if endDate != "" {
+		parts = append(parts, "UNTIL="+strings.ReplaceAll(endDate, "-", ""))
+	}
+	if count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", count))
+	}
+	return strings.Join(parts, ";")
+}
+
+// truncateDay は時刻を切り捨てたUTCの日付を返す
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,146 @@
+package recurrence
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// date はテスト用に YYYY-MM-DD をUTCの日付に変換する
+func date(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(DateLayout, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestDates(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  domain.RecurrenceRule
+		start string
+		until string
+		limit int
+		want  []string
+	}{
+		{
+			name:  "毎週（起点日の曜日）",
+			rule:  domain.RecurrenceRule{Frequency: "weekly"},
+			start: "2025-09-10", until: "2025-10-01",
+			want: []string{"2025-09-10", "2025-09-17", "2025-09-24", "2025-10-01"},
+		},
+		{
+			name:  "隔週金曜（起点日以降の最初の金曜から）",
+			rule:  domain.RecurrenceRule{Frequency: "weekly", Interval: 2, Weekday: "FR"},
+			start: "2025-09-10", until: "2025-10-31",
+			want: []string{"2025-09-12", "2025-09-26", "2025-10-10", "2025-10-24"},
+		},
+		{
+			name:  "毎月31日（31日がない月はスキップ）",
+			rule:  domain.RecurrenceRule{Frequency: "monthly", MonthDay: 31},
+			start: "2025-09-10", until: "2026-01-31",
+			want: []string{"2025-10-31", "2025-12-31", "2026-01-31"},
+		},
+		{
+			name:  "毎月第3金曜",
+			rule:  domain.RecurrenceRule{Frequency: "monthly", Week: 3, Weekday: "FR"},
+			start: "2025-09-10", until: "2025-12-31",
+			want: []string{"2025-09-19", "2025-10-17", "2025-11-21", "2025-12-19"},
+		},
+		{
+			name:  "毎月最終金曜",
+			rule:  domain.RecurrenceRule{Frequency: "monthly", Week: -1, Weekday: "FR"},
+			start: "2025-09-27", until: "2025-12-31",
+			want: []string{"2025-10-31", "2025-11-28", "2025-12-26"},
+		},
+		{
+			name:  "第5水曜（存在する月のみ）",
+			rule:  domain.RecurrenceRule{Frequency: "monthly", Week: 5, Weekday: "WE"},
+			start: "2025-09-01", until: "2025-12-31",
+			want: []string{"2025-10-29", "2025-12-31"},
+		},
+		{
+			name:  "2か月ごと・件数上限",
+			rule:  domain.RecurrenceRule{Frequency: "monthly", Interval: 2, MonthDay: 15},
+			start: "2025-09-10", until: "2026-12-31", limit: 3,
+			want: []string{"2025-09-15", "2025-11-15", "2026-01-15"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := date(t, tt.start)
+			rule, err := Normalize(tt.rule, start)
+			if err != nil {
+				t.Fatalf("Normalize() error = %v", err)
+			}
+
+			var got []string
+			for _, d := range Dates(rule, start, date(t, tt.until), tt.limit) {
+				got = append(got, d.Format(DateLayout))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Dates() = %v, %v を期待", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	start := date(t, "2025-09-19") // 金曜
+
+	tests := []struct {
+		name    string
+		rule    domain.RecurrenceRule
+		want    domain.RecurrenceRule
+		wantErr bool
+	}{
+		{name: "毎月は起点日の日付を補完", rule: domain.RecurrenceRule{Frequency: "monthly"}, want: domain.RecurrenceRule{Frequency: "monthly", Interval: 1, MonthDay: 19}},
+		{name: "第何週のみ指定は起点日の曜日を補完", rule: domain.RecurrenceRule{Frequency: "monthly", Week: 3}, want: domain.RecurrenceRule{Frequency: "monthly", Interval: 1, Week: 3, Weekday: "FR"}},
+		{name: "毎週に日付は指定できない", rule: domain.RecurrenceRule{Frequency: "weekly", MonthDay: 1}, wantErr: true},
+		{name: "日付と曜日の同時指定", rule: domain.RecurrenceRule{Frequency: "monthly", MonthDay: 1, Week: 1}, wantErr: true},
+		{name: "毎月の曜日のみ指定", rule: domain.RecurrenceRule{Frequency: "monthly", Weekday: "MO"}, wantErr: true},
+		{name: "未対応の頻度", rule: domain.RecurrenceRule{Frequency: "daily"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.rule, start)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRule) {
+					t.Errorf("Normalize() error = %v, ErrInvalidRule を期待", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Normalize() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize() = %+v, %+v を期待", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRRule(t *testing.T) {
+	tests := []struct {
+		rule    domain.RecurrenceRule
+		endDate string
+		count   int
+		want    string
+	}{
+		{rule: domain.RecurrenceRule{Frequency: "weekly", Interval: 2, Weekday: "FR"}, want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR"},
+		{rule: domain.RecurrenceRule{Frequency: "monthly", Interval: 1, Week: -1, Weekday: "FR"}, count: 6, want: "FREQ=MONTHLY;INTERVAL=1;BYDAY=-1FR;COUNT=6"},
+		{rule: domain.RecurrenceRule{Frequency: "monthly", Interval: 1, MonthDay: 25}, endDate: "2026-03-31", want: "FREQ=MONTHLY;INTERVAL=1;BYMONTHDAY=25;UNTIL=20260331"},
+	}
+	for _, tt := range tests {
+		if got := RRule(tt.rule, tt.endDate, tt.count); got != tt.want {
+			t.Errorf("RRule(%+v) = %q, %q を期待", tt.rule, got, tt.want)
+		}
+	}
+}
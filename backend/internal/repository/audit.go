@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// AuditRepository はイベントの監査ログの永続化を担当するインターフェース
+// 監査ログは追記のみで、更新・削除のメソッドは持たない
+type AuditRepository interface {
+	// AppendAuditEntry は監査ログを追記（CreatedAt・SortKey はリポジトリで設定）
+	AppendAuditEntry(ctx context.Context, entry *domain.AuditEntry) error
+
+	// ListAuditEntries はイベントの監査ログを記録順（古い順）で返す
+	ListAuditEntries(ctx context.Context, eventID string) ([]*domain.AuditEntry, error)
+}
+
+// auditSortKey は記録日時の順に並ぶソートキーを返す
+// 同時刻の記録はIDで順序を確定させる
+func auditSortKey(entry *domain.AuditEntry) string {
+	return entry.CreatedAt.UTC().Format("2006-01-02T15:04:05.000000000Z") + "#" + entry.ID
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// DynamoDBAuditRepository はDynamoDBを使用したAuditRepositoryの実装
+// テーブルはパーティションキー eventId、ソートキー sk（"<記録日時>#<ID>"）を持つ
+type DynamoDBAuditRepository struct {
+	// client はDynamoDB操作用のAWS SDKクライアント
+	client *dynamodb.Client
+
+	// tableName は監査ログを格納するDynamoDBテーブル名
+	// 例: kanji-log-event-audit-dev
+	tableName string
+
+	// clock はCreatedAtに設定する時刻の取得元
+	clock clock.Clock
+}
+
+// NewDynamoDBAuditRepository は新しいDynamoDBAuditRepositoryインスタンスを作成
+func NewDynamoDBAuditRepository(client *dynamodb.Client, tableName string, opts ...Option) AuditRepository {
+	o := newOptions(opts)
+	return &DynamoDBAuditRepository{
+		client:    client,
+		tableName: tableName,
+		clock:     o.clock,
+	}
+}
+
+// AppendAuditEntry は監査ログをDynamoDBに追記
+// 既存の記録を上書きしないよう、同じキーが存在しない場合のみ書き込む
+func (r *DynamoDBAuditRepository) AppendAuditEntry(ctx context.Context, entry *domain.AuditEntry) error {
+	entry.CreatedAt = r.clock.Now()
+	entry.SortKey = auditSortKey(entry)
+
+	item, err := attributevalue.MarshalMap(entry)
+	if err != nil {
+		return fmt.Errorf("監査ログのマーシャリングに失敗: %w", err)
+	}
+
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(r.tableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(sk)"),
+	})
+	if err != nil {
+		return fmt.Errorf("DynamoDBへの監査ログ保存に失敗: %w", err)
+	}
+	return nil
+}
+
+// ListAuditEntries はイベントの監査ログをソートキーの昇順（記録順）でQueryする
+func (r *DynamoDBAuditRepository) ListAuditEntries(ctx context.Context, eventID string) ([]*domain.AuditEntry, error) {
+	paginator := dynamodb.NewQueryPaginator(r.client, &dynamodb.QueryInput{
+		TableName:              aws.String(r.tableName),
+		KeyConditionExpression: aws.String("eventId = :eventId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":eventId": &types.AttributeValueMemberS{Value: eventID},
+		},
+		ScanIndexForward: aws.Bool(true),
+	})
+
+	entries := make([]*domain.AuditEntry, 0)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DynamoDBでの監査ログ取得に失敗: %w", err)
+		}
+		var items []*domain.AuditEntry
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			return nil, fmt.Errorf("監査ログのアンマーシャリングに失敗: %w", err)
+		}
+		entries = append(entries, items...)
+	}
+	return entries, nil
+}
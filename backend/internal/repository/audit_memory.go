@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// MemoryAuditRepository はメモリ上に監査ログを保持するAuditRepositoryの実装
+// ローカル開発サーバーやテストで使用する（プロセス終了でデータは消える）
+type MemoryAuditRepository struct {
+	mu      sync.RWMutex
+	entries map[string][]domain.AuditEntry
+	clock   clock.Clock
+}
+
+// NewMemoryAuditRepository は空のMemoryAuditRepositoryを作成
+func NewMemoryAuditRepository(opts ...Option) *MemoryAuditRepository {
+	o := newOptions(opts)
+	return &MemoryAuditRepository{
+		entries: make(map[string][]domain.AuditEntry),
+		clock:   o.clock,
+	}
+}
+
+// AppendAuditEntry は監査ログをメモリに追記
+func (r *MemoryAuditRepository) AppendAuditEntry(ctx context.Context, entry *domain.AuditEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry.CreatedAt = r.clock.Now()
+	entry.SortKey = auditSortKey(entry)
+	r.entries[entry.EventID] = append(r.entries[entry.EventID], *entry)
+	return nil
+}
+
+// ListAuditEntries はイベントの監査ログを追記順で返す
+func (r *MemoryAuditRepository) ListAuditEntries(ctx context.Context, eventID string) ([]*domain.AuditEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored := r.entries[eventID]
+	entries := make([]*domain.AuditEntry, 0, len(stored))
+	for i := range stored {
+		entry := stored[i]
+		entries = append(entries, &entry)
+	}
+	return entries, nil
+}
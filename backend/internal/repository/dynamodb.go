@@ -4,13 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
 	"github.com/luck-tech/kanji-log/backend/internal/domain"
 )
 
@@ -19,14 +20,15 @@ import (
 type EventRepository interface {
 	// CreateEvent は新しいイベントをDynamoDBに保存
 	// 成功時は作成されたEventを返し、失敗時はエラーを返す
+	// 同じIDのイベントが既に存在する場合は ErrAlreadyExists をラップしたエラーを返す
 	CreateEvent(ctx context.Context, event *domain.Event) (*domain.Event, error)
 
 	// GetEvent はIDでイベントを取得
-	// 存在しない場合はnil, ErrorNotFoundを返す
+	// 存在しない場合はnil, ErrNotFoundを返す
 	GetEvent(ctx context.Context, eventID string) (*domain.Event, error)
 
 	// UpdateEvent は既存イベントを更新
-	// 楽観的ロック（UpdatedAt比較）を使用して安全に更新
+	// 楽観的ロック（Version比較）を使用し、読み込み後に別の更新が保存されていた場合は ErrConflict をラップしたエラーを返す
 	UpdateEvent(ctx context.Context, event *domain.Event) (*domain.Event, error)
 
 	// DeleteEvent は指定されたイベントを削除
@@ -34,8 +36,12 @@ type EventRepository interface {
 	DeleteEvent(ctx context.Context, eventID string) error
 
 	// ListEventsByOrganizer は幹事IDでイベント一覧を取得
-	// ページネーション対応、ステータスフィルタリング可能
+	// ページネーション対応、ステータス（"status"）でフィルタリング可能
 	ListEventsByOrganizer(ctx context.Context, organizerID string, filters map[string]interface{}) ([]*domain.Event, error)
+
+	// ListEventsBySeries は定期開催シリーズの開催回を取得
+	// 所有者を移譲した開催回も含めるため、幹事IDではなくシリーズIDで絞り込む
+	ListEventsBySeries(ctx context.Context, seriesID string) ([]*domain.Event, error)
 }
 
 // DynamoDBEventRepository はDynamoDBを使用したEventRepositoryの実装
@@ -46,20 +52,26 @@ type DynamoDBEventRepository struct {
 	// tableName はイベントデータを格納するDynamoDBテーブル名
 	// 環境別に分離される（例: kanji-log-events-dev）
 	tableName string
+
+	// clock はCreatedAt/UpdatedAtに設定する時刻の取得元
+	clock clock.Clock
 }
 
 // NewDynamoDBEventRepository は新しいDynamoDBEventRepositoryインスタンスを作成
-func NewDynamoDBEventRepository(client *dynamodb.Client, tableName string) EventRepository {
+// opts を省略した場合はシステム時刻を使用する
+func NewDynamoDBEventRepository(client *dynamodb.Client, tableName string, opts ...Option) EventRepository {
+	o := newOptions(opts)
 	return &DynamoDBEventRepository{
 		client:    client,
 		tableName: tableName,
+		clock:     o.clock,
 	}
 }
 
 // CreateEvent は新しいイベントをDynamoDBに保存
 func (r *DynamoDBEventRepository) CreateEvent(ctx context.Context, event *domain.Event) (*domain.Event, error) {
 	// 現在時刻を設定
-	now := time.Now().UTC()
+	now := r.clock.Now()
 	event.CreatedAt = now
 	event.UpdatedAt = now
 
@@ -73,9 +85,6 @@ func (r *DynamoDBEventRepository) CreateEvent(ctx context.Context, event *domain
 		event.Members = []domain.Member{}
 	}
 
-	// デバッグログ: marshalする前のevent構造体を確認
-	fmt.Printf("DEBUG: Event before marshalling: %+v\n", event)
-
 	// Go構造体をDynamoDB AttributeValueに変換
 	// DynamoDBはJSON形式ではなく独自のAttributeValue形式を使用
 	item, err := attributevalue.MarshalMap(event)
@@ -83,15 +92,9 @@ func (r *DynamoDBEventRepository) CreateEvent(ctx context.Context, event *domain
 		return nil, fmt.Errorf("イベントデータのマーシャリングに失敗: %w", err)
 	}
 
-	// デバッグログ: マーシャリング後のitem構造を確認
-	fmt.Printf("DEBUG: Marshalled item: %+v\n", item)
-	
-	// id フィールドが実際に存在するかチェック
-	if idAttr, exists := item["id"]; exists {
-		fmt.Printf("DEBUG: ID attribute found: %+v\n", idAttr)
-	} else {
-		fmt.Printf("DEBUG: ERROR - ID attribute NOT found in marshalled item\n")
-		return nil, fmt.Errorf("ID attribute not found after marshalling")
+	// パーティションキー（id）が欠けたアイテムは保存できないため事前にチェック
+	if _, exists := item["id"]; !exists {
+		return nil, fmt.Errorf("マーシャリング後のアイテムに id 属性がありません")
 	}
 
 	// DynamoDBにアイテムを挿入
@@ -108,7 +111,7 @@ func (r *DynamoDBEventRepository) CreateEvent(ctx context.Context, event *domain
 		// DynamoDB固有のエラーハンドリング
 		var conditionalCheckFailedException *types.ConditionalCheckFailedException
 		if errors.As(err, &conditionalCheckFailedException) {
-			return nil, fmt.Errorf("同じIDのイベントが既に存在します: %s", event.ID)
+			return nil, fmt.Errorf("同じIDのイベントが既に存在します: %s: %w", event.ID, ErrAlreadyExists)
 		}
 		return nil, fmt.Errorf("DynamoDBへのイベント保存に失敗: %w", err)
 	}
@@ -133,7 +136,7 @@ func (r *DynamoDBEventRepository) GetEvent(ctx context.Context, eventID string)
 
 	// アイテムが存在しない場合
 	if result.Item == nil {
-		return nil, fmt.Errorf("イベントが見つかりません: %s", eventID)
+		return nil, fmt.Errorf("イベントが見つかりません: %s: %w", eventID, ErrNotFound)
 	}
 
 	// DynamoDB AttributeValueをGo構造体に変換
@@ -148,35 +151,48 @@ func (r *DynamoDBEventRepository) GetEvent(ctx context.Context, eventID string)
 
 // UpdateEvent は既存イベントを更新
 func (r *DynamoDBEventRepository) UpdateEvent(ctx context.Context, event *domain.Event) (*domain.Event, error) {
-	// 更新時刻を現在時刻に設定
-	event.UpdatedAt = time.Now().UTC()
+	// 読み込んだ時点の版数を条件に使い、保存する版数は1つ進める
+	expectedVersion := event.Version
+	updated := *event
+	updated.UpdatedAt = r.clock.Now()
+	updated.Version = expectedVersion + 1
 
 	// Go構造体をDynamoDB AttributeValueに変換
-	item, err := attributevalue.MarshalMap(event)
+	item, err := attributevalue.MarshalMap(&updated)
 	if err != nil {
 		return nil, fmt.Errorf("イベントデータのマーシャリングに失敗: %w", err)
 	}
 
-	// DynamoDBのアイテムを更新
-	// 楽観的ロック：UpdatedAtが期待値と一致する場合のみ更新
+	// 楽観的ロック：保存済みの版数が読み込んだ時点と一致する場合のみ更新
+	// 版数の導入前に保存されたアイテムには version 属性がないため、版数0として扱う
+	condition := "attribute_exists(id) AND version = :version"
+	if expectedVersion == 0 {
+		condition = "attribute_exists(id) AND (attribute_not_exists(version) OR version = :version)"
+	}
+
 	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
-		TableName: aws.String(r.tableName),
-		Item:      item,
-		// 条件式：アイテムが存在し、かつIDが一致する場合のみ更新
-		ConditionExpression: aws.String("attribute_exists(id) AND id = :id"),
+		TableName:           aws.String(r.tableName),
+		Item:                item,
+		ConditionExpression: aws.String(condition),
 		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":id": &types.AttributeValueMemberS{Value: event.ID},
+			":version": &types.AttributeValueMemberN{Value: strconv.Itoa(expectedVersion)},
 		},
+		// 条件を満たさなかった場合に、存在しないのか並行更新なのかを区別するため変更前のアイテムを返させる
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
 	})
 
 	if err != nil {
 		var conditionalCheckFailedException *types.ConditionalCheckFailedException
 		if errors.As(err, &conditionalCheckFailedException) {
-			return nil, fmt.Errorf("イベントが存在しないか、並行更新が発生しました: %s", event.ID)
+			if conditionalCheckFailedException.Item == nil {
+				return nil, fmt.Errorf("更新対象のイベントが見つかりません: %s: %w", event.ID, ErrNotFound)
+			}
+			return nil, fmt.Errorf("イベントが並行して更新されました: %s: %w", event.ID, ErrConflict)
 		}
 		return nil, fmt.Errorf("DynamoDBでのイベント更新に失敗: %w", err)
 	}
 
+	*event = updated
 	return event, nil
 }
 
@@ -195,7 +211,7 @@ func (r *DynamoDBEventRepository) DeleteEvent(ctx context.Context, eventID strin
 	if err != nil {
 		var conditionalCheckFailedException *types.ConditionalCheckFailedException
 		if errors.As(err, &conditionalCheckFailedException) {
-			return fmt.Errorf("削除対象のイベントが見つかりません: %s", eventID)
+			return fmt.Errorf("削除対象のイベントが見つかりません: %s: %w", eventID, ErrNotFound)
 		}
 		return fmt.Errorf("DynamoDBでのイベント削除に失敗: %w", err)
 	}
@@ -218,14 +234,17 @@ func (r *DynamoDBEventRepository) ListEventsByOrganizer(ctx context.Context, org
 	}
 
 	// ステータスフィルターがある場合は条件を追加
+	filterExpression := "organizerId = :organizerId"
 	if status, exists := filters["status"]; exists {
-		input.FilterExpression = aws.String("organizerId = :organizerId AND #status = :status")
+		filterExpression += " AND #status = :status"
 		input.ExpressionAttributeNames = map[string]string{
 			"#status": "status", // statusは予約語のため別名使用
 		}
 		input.ExpressionAttributeValues[":status"] = &types.AttributeValueMemberS{Value: status.(string)}
 	}
 
+	input.FilterExpression = aws.String(filterExpression)
+
 	result, err := r.client.Scan(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("DynamoDBでのイベント一覧取得に失敗: %w", err)
@@ -246,3 +265,34 @@ func (r *DynamoDBEventRepository) ListEventsByOrganizer(ctx context.Context, org
 
 	return events, nil
 }
+
+// ListEventsBySeries は定期開催シリーズの開催回を取得
+// フィルターは1MBごとのページを読んだ後に適用されるため、全ページを読み切る
+// 注意：ListEventsByOrganizer と同じくScanベース（将来的にseriesIdのGSI使用を推奨）
+func (r *DynamoDBEventRepository) ListEventsBySeries(ctx context.Context, seriesID string) ([]*domain.Event, error) {
+	paginator := dynamodb.NewScanPaginator(r.client, &dynamodb.ScanInput{
+		TableName:        aws.String(r.tableName),
+		FilterExpression: aws.String("seriesId = :seriesId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":seriesId": &types.AttributeValueMemberS{Value: seriesID},
+		},
+	})
+
+	events := make([]*domain.Event, 0)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DynamoDBでの開催回一覧取得に失敗: %w", err)
+		}
+		for _, item := range page.Items {
+			var event domain.Event
+			if err := attributevalue.UnmarshalMap(item, &event); err != nil {
+				// 個別のアイテム変換エラーは全体処理を停止させない
+				continue
+			}
+			events = append(events, &event)
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"errors"
+)
+
+// ErrNotFound は指定されたリソースが存在しないことを表すエラー
+// 呼び出し側は errors.Is(err, repository.ErrNotFound) で判定する
+var ErrNotFound = errors.New("リソースが見つかりません")
+
+// ErrAlreadyExists は同じキーのリソースが既に存在するため作成できないことを表すエラー
+// 呼び出し側は errors.Is(err, repository.ErrAlreadyExists) で判定する
+var ErrAlreadyExists = errors.New("リソースが既に存在します")
+
+// ErrConflict は読み込んだ後に別の更新が保存されていたため、上書きを拒否したことを表すエラー
+// 呼び出し側は errors.Is(err, repository.ErrConflict) で判定し、読み込みからやり直す
+var ErrConflict = errors.New("リソースが他の操作で更新されています")
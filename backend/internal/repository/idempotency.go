@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// IdempotencyTTL は冪等性レコードの保持期間
+// この期間内に同じ Idempotency-Key で再送されたリクエストには初回のレスポンスを返す
+const IdempotencyTTL = 24 * time.Hour
+
+// IdempotencyLease は処理中のキーを確保しておく期間
+// Lambda のタイムアウト（iac/modules/lambda の timeout、既定30秒）より少し長くし、
+// タイムアウト・異常終了で完了も解放もされなかったキーを、期限後の再送で確保し直せるようにする
+const IdempotencyLease = 35 * time.Second
+
+// IdempotencyRepository は Idempotency-Key ごとの処理結果の永続化を担当するインターフェース
+//
+// 処理の流れ:
+//
+//	ReserveIdempotencyKey → （ハンドラー実行）→ CompleteIdempotencyKey
+//	                                        └→ 失敗時は ReleaseIdempotencyKey（再試行を許可）
+type IdempotencyRepository interface {
+	// ReserveIdempotencyKey は処理中（in_progress）のレコードを作成してキーを確保する
+	// 有効期限内のレコードが既に存在する場合は ErrAlreadyExists をラップしたエラーを返す
+	// ただし確保の期限（IdempotencyLease）を過ぎた処理中のレコードは上書きして確保し直す
+	ReserveIdempotencyKey(ctx context.Context, organizerID string, key string, requestHash string) (*domain.IdempotencyRecord, error)
+
+	// GetIdempotencyRecord はキーに対応するレコードを取得
+	// 存在しない場合・有効期限切れの場合・確保の期限を過ぎた処理中の場合は ErrNotFound をラップしたエラーを返す
+	GetIdempotencyRecord(ctx context.Context, organizerID string, key string) (*domain.IdempotencyRecord, error)
+
+	// CompleteIdempotencyKey はレスポンスを保存し、レコードを完了（completed）状態にする
+	CompleteIdempotencyKey(ctx context.Context, organizerID string, key string, statusCode int, responseBody string) error
+
+	// ReleaseIdempotencyKey は処理中のレコードを削除し、同じキーでの再試行を許可する
+	ReleaseIdempotencyKey(ctx context.Context, organizerID string, key string) error
+}
+
+// idempotencyRecordKey は幹事IDと Idempotency-Key からレコードのキーを組み立てる
+// 幹事が異なれば同じキーを使っても衝突しない
+func idempotencyRecordKey(organizerID string, key string) string {
+	return organizerID + "#" + key
+}
+
+// newIdempotencyRecord は処理中状態のレコードを作成する
+func newIdempotencyRecord(organizerID string, key string, requestHash string, now time.Time) *domain.IdempotencyRecord {
+	return &domain.IdempotencyRecord{
+		Key:            idempotencyRecordKey(organizerID, key),
+		OrganizerID:    organizerID,
+		IdempotencyKey: key,
+		RequestHash:    requestHash,
+		Status:         domain.IdempotencyStatusInProgress,
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(IdempotencyTTL).Unix(),
+		LockedUntil:    now.Add(IdempotencyLease).Unix(),
+	}
+}
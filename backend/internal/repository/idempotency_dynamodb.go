@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// DynamoDBIdempotencyRepository はDynamoDBを使用したIdempotencyRepositoryの実装
+// テーブルはパーティションキー key（文字列）を持ち、expiresAt をTTL属性に設定する
+type DynamoDBIdempotencyRepository struct {
+	// client はDynamoDB操作用のAWS SDKクライアント
+	client *dynamodb.Client
+
+	// tableName は冪等性レコードを格納するDynamoDBテーブル名
+	// 例: kanji-log-idempotency-dev
+	tableName string
+
+	// clock は有効期限の計算・判定に使用する時刻の取得元
+	clock clock.Clock
+}
+
+// NewDynamoDBIdempotencyRepository は新しいDynamoDBIdempotencyRepositoryインスタンスを作成
+func NewDynamoDBIdempotencyRepository(client *dynamodb.Client, tableName string, opts ...Option) IdempotencyRepository {
+	o := newOptions(opts)
+	return &DynamoDBIdempotencyRepository{
+		client:    client,
+		tableName: tableName,
+		clock:     o.clock,
+	}
+}
+
+// ReserveIdempotencyKey は処理中のレコードを条件付きで挿入してキーを確保する
+// DynamoDBのTTL削除は即時ではないため、期限切れのレコードは条件式で上書きを許可する
+// 確保の期限（lockedUntil）を過ぎた処理中のレコードも、完了・解放されなかったものとして上書きを許可する
+func (r *DynamoDBIdempotencyRepository) ReserveIdempotencyKey(ctx context.Context, organizerID string, key string, requestHash string) (*domain.IdempotencyRecord, error) {
+	now := r.clock.Now()
+	record := newIdempotencyRecord(organizerID, key, requestHash, now)
+
+	item, err := attributevalue.MarshalMap(record)
+	if err != nil {
+		return nil, fmt.Errorf("冪等性レコードのマーシャリングに失敗: %w", err)
+	}
+
+	// 条件式：同じキーのレコードが存在しないか、有効期限切れか、確保の期限を過ぎた処理中の場合のみ挿入
+	// 同時に届いた再送のうち1つだけがキーを確保できる
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(r.tableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(#key) OR expiresAt <= :now OR (#status = :inProgress AND (attribute_not_exists(lockedUntil) OR lockedUntil <= :now))"),
+		ExpressionAttributeNames: map[string]string{
+			"#key":    "key",    // key はDynamoDBの予約語
+			"#status": "status", // status はDynamoDBの予約語
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":now":        &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Unix(), 10)},
+			":inProgress": &types.AttributeValueMemberS{Value: domain.IdempotencyStatusInProgress},
+		},
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return nil, fmt.Errorf("Idempotency-Key が既に使用されています: %s: %w", key, ErrAlreadyExists)
+		}
+		return nil, fmt.Errorf("DynamoDBへの冪等性レコード保存に失敗: %w", err)
+	}
+
+	return record, nil
+}
+
+// GetIdempotencyRecord はキーに対応するレコードを取得
+// TTLで未削除の期限切れレコード・確保の期限を過ぎた処理中のレコードは存在しないものとして扱う
+func (r *DynamoDBIdempotencyRepository) GetIdempotencyRecord(ctx context.Context, organizerID string, key string) (*domain.IdempotencyRecord, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(r.tableName),
+		Key:            r.itemKey(organizerID, key),
+		ConsistentRead: aws.Bool(true), // 直前の Reserve / Complete の結果を確実に読む
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DynamoDBからの冪等性レコード取得に失敗: %w", err)
+	}
+	if result.Item == nil {
+		return nil, fmt.Errorf("冪等性レコードが見つかりません: %s: %w", key, ErrNotFound)
+	}
+
+	var record domain.IdempotencyRecord
+	if err := attributevalue.UnmarshalMap(result.Item, &record); err != nil {
+		return nil, fmt.Errorf("冪等性レコードのアンマーシャリングに失敗: %w", err)
+	}
+	now := r.clock.Now()
+	if record.ExpiresAt <= now.Unix() {
+		return nil, fmt.Errorf("冪等性レコードの有効期限が切れています: %s: %w", key, ErrNotFound)
+	}
+	if record.Abandoned(now) {
+		return nil, fmt.Errorf("冪等性レコードの確保の期限が切れています: %s: %w", key, ErrNotFound)
+	}
+
+	return &record, nil
+}
+
+// CompleteIdempotencyKey はレスポンスを保存してレコードを完了状態にする
+func (r *DynamoDBIdempotencyRepository) CompleteIdempotencyKey(ctx context.Context, organizerID string, key string, statusCode int, responseBody string) error {
+	_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:           aws.String(r.tableName),
+		Key:                 r.itemKey(organizerID, key),
+		UpdateExpression:    aws.String("SET #status = :status, statusCode = :statusCode, responseBody = :responseBody"),
+		ConditionExpression: aws.String("attribute_exists(#key)"),
+		ExpressionAttributeNames: map[string]string{
+			"#key":    "key",
+			"#status": "status", // status はDynamoDBの予約語
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":status":       &types.AttributeValueMemberS{Value: domain.IdempotencyStatusCompleted},
+			":statusCode":   &types.AttributeValueMemberN{Value: strconv.Itoa(statusCode)},
+			":responseBody": &types.AttributeValueMemberS{Value: responseBody},
+		},
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("冪等性レコードが見つかりません: %s: %w", key, ErrNotFound)
+		}
+		return fmt.Errorf("DynamoDBでの冪等性レコード更新に失敗: %w", err)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey はレコードを削除して再試行を許可する
+// 既に削除されている場合もエラーにしない
+func (r *DynamoDBIdempotencyRepository) ReleaseIdempotencyKey(ctx context.Context, organizerID string, key string) error {
+	_, err := r.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(r.tableName),
+		Key:       r.itemKey(organizerID, key),
+	})
+	if err != nil {
+		return fmt.Errorf("DynamoDBでの冪等性レコード削除に失敗: %w", err)
+	}
+	return nil
+}
+
+// itemKey はDynamoDBのプライマリキーを組み立てる
+func (r *DynamoDBIdempotencyRepository) itemKey(organizerID string, key string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"key": &types.AttributeValueMemberS{Value: idempotencyRecordKey(organizerID, key)},
+	}
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// MemoryIdempotencyRepository はメモリ上に冪等性レコードを保持するIdempotencyRepositoryの実装
+// ローカル開発サーバーやテストで使用する（プロセス終了でデータは消える）
+type MemoryIdempotencyRepository struct {
+	mu      sync.Mutex
+	records map[string]*domain.IdempotencyRecord
+	clock   clock.Clock
+}
+
+// NewMemoryIdempotencyRepository は空のMemoryIdempotencyRepositoryを作成
+// 有効期限の判定には opts で指定した時刻（省略時はシステム時刻）を使用する
+func NewMemoryIdempotencyRepository(opts ...Option) *MemoryIdempotencyRepository {
+	o := newOptions(opts)
+	return &MemoryIdempotencyRepository{
+		records: make(map[string]*domain.IdempotencyRecord),
+		clock:   o.clock,
+	}
+}
+
+// ReserveIdempotencyKey は処理中のレコードを作成してキーを確保する
+// 期限切れのレコード・確保の期限を過ぎた処理中のレコードは存在しないものとして上書きする（DynamoDB実装と同じ挙動）
+func (r *MemoryIdempotencyRepository) ReserveIdempotencyKey(ctx context.Context, organizerID string, key string, requestHash string) (*domain.IdempotencyRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	recordKey := idempotencyRecordKey(organizerID, key)
+	if record, exists := r.liveRecord(recordKey); exists && !record.Abandoned(r.clock.Now()) {
+		return nil, fmt.Errorf("Idempotency-Key が既に使用されています: %s: %w", key, ErrAlreadyExists)
+	}
+
+	record := newIdempotencyRecord(organizerID, key, requestHash, r.clock.Now())
+	copied := *record
+	r.records[recordKey] = &copied
+	return record, nil
+}
+
+// GetIdempotencyRecord はキーに対応するレコードを取得
+func (r *MemoryIdempotencyRepository) GetIdempotencyRecord(ctx context.Context, organizerID string, key string) (*domain.IdempotencyRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, exists := r.liveRecord(idempotencyRecordKey(organizerID, key))
+	if !exists || record.Abandoned(r.clock.Now()) {
+		return nil, fmt.Errorf("冪等性レコードが見つかりません: %s: %w", key, ErrNotFound)
+	}
+	copied := *record
+	return &copied, nil
+}
+
+// CompleteIdempotencyKey はレスポンスを保存してレコードを完了状態にする
+func (r *MemoryIdempotencyRepository) CompleteIdempotencyKey(ctx context.Context, organizerID string, key string, statusCode int, responseBody string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, exists := r.liveRecord(idempotencyRecordKey(organizerID, key))
+	if !exists {
+		return fmt.Errorf("冪等性レコードが見つかりません: %s: %w", key, ErrNotFound)
+	}
+	record.Status = domain.IdempotencyStatusCompleted
+	record.StatusCode = statusCode
+	record.ResponseBody = responseBody
+	return nil
+}
+
+// ReleaseIdempotencyKey はレコードを削除して再試行を許可する
+func (r *MemoryIdempotencyRepository) ReleaseIdempotencyKey(ctx context.Context, organizerID string, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.records, idempotencyRecordKey(organizerID, key))
+	return nil
+}
+
+// liveRecord は有効期限内のレコードを返す（呼び出し側でロックを取得していること）
+func (r *MemoryIdempotencyRepository) liveRecord(recordKey string) (*domain.IdempotencyRecord, bool) {
+	record, exists := r.records[recordKey]
+	if !exists || record.ExpiresAt <= r.clock.Now().Unix() {
+		return nil, false
+	}
+	return record, true
+}
@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// MemoryEventRepository はメモリ上のmapを使用したEventRepositoryの実装
+// テストやローカル開発など、DynamoDBに接続できない環境で使用する
+// 並行アクセスに備えてミューテックスで保護している
+type MemoryEventRepository struct {
+	mu sync.RWMutex
+
+	// events はイベントIDをキーとしたイベントデータ
+	events map[string]*domain.Event
+
+	// clock はCreatedAt/UpdatedAtに設定する時刻の取得元
+	clock clock.Clock
+}
+
+// NewMemoryEventRepository は空のMemoryEventRepositoryインスタンスを作成
+func NewMemoryEventRepository(opts ...Option) *MemoryEventRepository {
+	o := newOptions(opts)
+	return &MemoryEventRepository{
+		events: make(map[string]*domain.Event),
+		clock:  o.clock,
+	}
+}
+
+// CreateEvent は新しいイベントをメモリに保存
+// DynamoDB実装と同じく、同じIDのイベントが既に存在する場合はエラーを返す
+func (r *MemoryEventRepository) CreateEvent(ctx context.Context, event *domain.Event) (*domain.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.events[event.ID]; exists {
+		return nil, fmt.Errorf("同じIDのイベントが既に存在します: %s: %w", event.ID, ErrAlreadyExists)
+	}
+
+	now := r.clock.Now()
+	event.CreatedAt = now
+	event.UpdatedAt = now
+
+	if event.Status == "" {
+		event.Status = "planning"
+	}
+	if event.Members == nil {
+		event.Members = []domain.Member{}
+	}
+
+	r.events[event.ID] = copyEvent(event)
+	return event, nil
+}
+
+// GetEvent はIDでイベントを取得
+// 存在しない場合は ErrNotFound をラップしたエラーを返す
+func (r *MemoryEventRepository) GetEvent(ctx context.Context, eventID string) (*domain.Event, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	event, exists := r.events[eventID]
+	if !exists {
+		return nil, fmt.Errorf("イベントが見つかりません: %s: %w", eventID, ErrNotFound)
+	}
+	return copyEvent(event), nil
+}
+
+// UpdateEvent は既存イベントを更新
+// DynamoDB実装と同じく、保存済みの版数（Version）が読み込んだ時点と異なる場合は ErrConflict を返す
+func (r *MemoryEventRepository) UpdateEvent(ctx context.Context, event *domain.Event) (*domain.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.events[event.ID]
+	if !exists {
+		return nil, fmt.Errorf("更新対象のイベントが見つかりません: %s: %w", event.ID, ErrNotFound)
+	}
+	if stored.Version != event.Version {
+		return nil, fmt.Errorf("イベントが並行して更新されました: %s: %w", event.ID, ErrConflict)
+	}
+
+	event.UpdatedAt = r.clock.Now()
+	event.Version++
+	r.events[event.ID] = copyEvent(event)
+	return event, nil
+}
+
+// DeleteEvent は指定されたイベントを削除
+func (r *MemoryEventRepository) DeleteEvent(ctx context.Context, eventID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.events[eventID]; !exists {
+		return fmt.Errorf("削除対象のイベントが見つかりません: %s: %w", eventID, ErrNotFound)
+	}
+	delete(r.events, eventID)
+	return nil
+}
+
+// ListEventsByOrganizer は幹事IDでイベント一覧を取得
+// 結果の順序を安定させるため、作成日時の昇順（同時刻はID順）で返す
+func (r *MemoryEventRepository) ListEventsByOrganizer(ctx context.Context, organizerID string, filters map[string]interface{}) ([]*domain.Event, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	status, hasStatus := filters["status"]
+
+	events := make([]*domain.Event, 0)
+	for _, event := range r.events {
+		if event.OrganizerID != organizerID {
+			continue
+		}
+		if hasStatus && event.Status != status.(string) {
+			continue
+		}
+		events = append(events, copyEvent(event))
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].CreatedAt.Equal(events[j].CreatedAt) {
+			return events[i].CreatedAt.Before(events[j].CreatedAt)
+		}
+		return events[i].ID < events[j].ID
+	})
+
+	return events, nil
+}
+
+// ListEventsBySeries は定期開催シリーズの開催回を取得
+// 結果の順序は ListEventsByOrganizer と同じく作成日時の昇順（同時刻はID順）
+func (r *MemoryEventRepository) ListEventsBySeries(ctx context.Context, seriesID string) ([]*domain.Event, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	events := make([]*domain.Event, 0)
+	for _, event := range r.events {
+		if event.SeriesID == seriesID {
+			events = append(events, copyEvent(event))
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].CreatedAt.Equal(events[j].CreatedAt) {
+			return events[i].CreatedAt.Before(events[j].CreatedAt)
+		}
+		return events[i].ID < events[j].ID
+	})
+
+	return events, nil
+}
+
+// copyEvent はイベントの複製を返す
+// 呼び出し側が返却値を変更しても保存済みデータに影響しないよう、スライス・マップ・ポインタはすべて複製する
+func copyEvent(event *domain.Event) *domain.Event {
+	copied := *event
+	copied.Members = copyMembers(event.Members)
+	copied.FormQuestions = copyFormQuestions(event.FormQuestions)
+	if event.Collaborators != nil {
+		copied.Collaborators = make([]domain.Collaborator, len(event.Collaborators))
+		for i, collaborator := range event.Collaborators {
+			collaborator.AcceptedAt = copyTime(collaborator.AcceptedAt)
+			copied.Collaborators[i] = collaborator
+		}
+	}
+	if event.Budget != nil {
+		budget := *event.Budget
+		copied.Budget = &budget
+	}
+	if event.RestaurantSearch != nil {
+		search := *event.RestaurantSearch
+		if search.Restaurants != nil {
+			search.Restaurants = make([]domain.Restaurant, len(event.RestaurantSearch.Restaurants))
+			for i, restaurant := range event.RestaurantSearch.Restaurants {
+				restaurant.Features = append([]string(nil), restaurant.Features...)
+				search.Restaurants[i] = restaurant
+			}
+		}
+		copied.RestaurantSearch = &search
+	}
+	if event.Venue != nil {
+		venue := *event.Venue
+		copied.Venue = &venue
+	}
+	if event.Reservation != nil {
+		reservation := *event.Reservation
+		reservation.ConfirmedAt = copyTime(event.Reservation.ConfirmedAt)
+		copied.Reservation = &reservation
+	}
+	if event.Settlement != nil {
+		settlement := *event.Settlement
+		settlement.Tiers = append([]domain.SettlementTier(nil), event.Settlement.Tiers...)
+		if settlement.Shares != nil {
+			settlement.Shares = make([]domain.MemberShare, len(event.Settlement.Shares))
+			for i, share := range event.Settlement.Shares {
+				share.PaidAt = copyTime(share.PaidAt)
+				share.RemindedAt = copyTime(share.RemindedAt)
+				settlement.Shares[i] = share
+			}
+		}
+		copied.Settlement = &settlement
+	}
+	return &copied
+}
+
+// copyMembers はメンバーを好み・回答日時も含めて複製する（nil はそのまま）
+// イベントとテンプレートの両方で使用する
+func copyMembers(members []domain.Member) []domain.Member {
+	if members == nil {
+		return nil
+	}
+	copied := make([]domain.Member, len(members))
+	for i, member := range members {
+		member.Preferences = copyPreferences(member.Preferences)
+		member.ResponseAt = copyTime(member.ResponseAt)
+		copied[i] = member
+	}
+	return copied
+}
+
+// copyFormQuestions はフォームの質問を選択肢も含めて複製する（nil はそのまま）
+func copyFormQuestions(questions []domain.FormQuestion) []domain.FormQuestion {
+	if questions == nil {
+		return nil
+	}
+	copied := make([]domain.FormQuestion, len(questions))
+	for i, question := range questions {
+		question.Options = append([]string(nil), question.Options...)
+		copied[i] = question
+	}
+	return copied
+}
+
+// copyTime は日時のポインタを複製する（nil はそのまま）
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	return &copied
+}
+
+// copyPreferences はメンバーの好み（JSONの自由形式）を入れ子のマップ・配列も含めて複製する
+func copyPreferences(preferences map[string]interface{}) map[string]interface{} {
+	if preferences == nil {
+		return nil
+	}
+	return copyJSONValue(preferences).(map[string]interface{})
+}
+
+// copyJSONValue は JSON・DynamoDB から読み込んだ値を再帰的に複製する
+func copyJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			copied[key] = copyJSONValue(item)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, item := range v {
+			copied[i] = copyJSONValue(item)
+		}
+		return copied
+	case []string:
+		return append([]string(nil), v...)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+func TestMemoryEventRepository(t *testing.T) {
+	ctx := context.Background()
+	fixed := clock.NewFixedClock(time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC))
+	repo := NewMemoryEventRepository(WithClock(fixed))
+
+	event := &domain.Event{ID: "evt_1", Title: "歓迎会", OrganizerID: "owner"}
+	if _, err := repo.CreateEvent(ctx, event); err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+	if event.Status != "planning" || event.Members == nil {
+		t.Errorf("初期値が設定されていません: Status=%q, Members=%v", event.Status, event.Members)
+	}
+
+	if _, err := repo.CreateEvent(ctx, &domain.Event{ID: "evt_1"}); err == nil {
+		t.Error("重複IDでエラーを期待しましたが、nilが返されました")
+	}
+
+	// 取得したイベントを変更しても保存済みデータに影響しないこと
+	got, err := repo.GetEvent(ctx, "evt_1")
+	if err != nil {
+		t.Fatalf("GetEvent() error = %v", err)
+	}
+	got.Title = "変更後"
+	again, _ := repo.GetEvent(ctx, "evt_1")
+	if again.Title != "歓迎会" {
+		t.Errorf("保存済みデータが変更されました: Title = %q", again.Title)
+	}
+
+	fixed.Advance(time.Hour)
+	got.Title = "送別会"
+	updated, err := repo.UpdateEvent(ctx, got)
+	if err != nil {
+		t.Fatalf("UpdateEvent() error = %v", err)
+	}
+	if !updated.UpdatedAt.After(updated.CreatedAt) {
+		t.Errorf("UpdatedAt が更新されていません: %v", updated.UpdatedAt)
+	}
+	if updated.Version != 1 {
+		t.Errorf("Version = %d, 1 を期待", updated.Version)
+	}
+
+	// 読み込んだ後に別の更新が保存されたイベントは上書きしないこと
+	stale := again
+	stale.Title = "古い内容での上書き"
+	if _, err := repo.UpdateEvent(ctx, stale); !errors.Is(err, ErrConflict) {
+		t.Errorf("古い版数での UpdateEvent() error = %v, ErrConflict を期待", err)
+	}
+	if saved, _ := repo.GetEvent(ctx, "evt_1"); saved.Title != "送別会" || saved.Version != 1 {
+		t.Errorf("Title = %q, Version = %d, 先に保存した更新が残ることを期待", saved.Title, saved.Version)
+	}
+	if _, err := repo.UpdateEvent(ctx, &domain.Event{ID: "evt_missing"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("存在しないイベントの UpdateEvent() error = %v, ErrNotFound を期待", err)
+	}
+
+	if err := repo.DeleteEvent(ctx, "evt_1"); err != nil {
+		t.Fatalf("DeleteEvent() error = %v", err)
+	}
+	if _, err := repo.GetEvent(ctx, "evt_1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("削除後の GetEvent() error = %v, ErrNotFound を期待", err)
+	}
+	if err := repo.DeleteEvent(ctx, "evt_1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("削除済みの DeleteEvent() error = %v, ErrNotFound を期待", err)
+	}
+}
+
+// 取得したイベントの入れ子の値（好み・選択肢・検索結果・日時）を変更しても保存済みデータに影響しないこと
+func TestMemoryEventRepositoryDeepCopy(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryEventRepository()
+	respondedAt := time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC)
+	event := &domain.Event{
+		ID: "evt_1", Title: "歓迎会", OrganizerID: "owner",
+		Members: []domain.Member{{Name: "田中", Status: "attending", ResponseAt: &respondedAt, Preferences: map[string]interface{}{
+			"allergies":   []interface{}{"えび"},
+			"budgetRange": map[string]interface{}{"min": float64(3000), "max": float64(5000)},
+		}}},
+		FormQuestions:    []domain.FormQuestion{{Options: []string{"和食", "洋食"}}},
+		RestaurantSearch: &domain.RestaurantSearch{Restaurants: []domain.Restaurant{{Name: "鳥心", Features: []string{"個室あり"}}}},
+		Settlement:       &domain.Settlement{Shares: []domain.MemberShare{{Name: "田中", PaidAt: &respondedAt}}},
+	}
+	if _, err := repo.CreateEvent(ctx, event); err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+
+	got, _ := repo.GetEvent(ctx, "evt_1")
+	got.Members[0].Preferences["allergies"].([]interface{})[0] = "かに"
+	got.Members[0].Preferences["budgetRange"].(map[string]interface{})["max"] = float64(9000)
+	*got.Members[0].ResponseAt = respondedAt.Add(time.Hour)
+	got.FormQuestions[0].Options[0] = "中華"
+	got.RestaurantSearch.Restaurants[0].Features[0] = "禁煙"
+	*got.Settlement.Shares[0].PaidAt = respondedAt.Add(time.Hour)
+
+	saved, _ := repo.GetEvent(ctx, "evt_1")
+	member := saved.Members[0]
+	if member.Preferences["allergies"].([]interface{})[0] != "えび" ||
+		member.Preferences["budgetRange"].(map[string]interface{})["max"] != float64(5000) ||
+		!member.ResponseAt.Equal(respondedAt) {
+		t.Errorf("Members[0] = %+v, 保存済みのメンバーが変更されないことを期待", member)
+	}
+	if saved.FormQuestions[0].Options[0] != "和食" || saved.RestaurantSearch.Restaurants[0].Features[0] != "個室あり" {
+		t.Errorf("FormQuestions = %+v, RestaurantSearch = %+v, 保存済みデータが変更されないことを期待", saved.FormQuestions, saved.RestaurantSearch)
+	}
+	if !saved.Settlement.Shares[0].PaidAt.Equal(respondedAt) {
+		t.Errorf("PaidAt = %v, 保存済みの支払い日時が変更されないことを期待", saved.Settlement.Shares[0].PaidAt)
+	}
+}
+
+// 取得したテンプレートの選択肢・メンバーの好みを変更しても保存済みデータに影響しないこと
+func TestMemoryTemplateRepositoryDeepCopy(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryTemplateRepository()
+	template := &domain.EventTemplate{
+		ID: "tpl_1", OrganizerID: "owner", Name: "送別会",
+		Members:       []domain.Member{{Name: "田中", Status: "pending", Preferences: map[string]interface{}{"allergies": []interface{}{"えび"}}}},
+		FormQuestions: []domain.FormQuestion{{Options: []string{"和食", "洋食"}}},
+	}
+	if _, err := repo.CreateTemplate(ctx, template); err != nil {
+		t.Fatalf("CreateTemplate() error = %v", err)
+	}
+
+	got, _ := repo.GetTemplate(ctx, "tpl_1")
+	got.Members[0].Preferences["allergies"].([]interface{})[0] = "かに"
+	got.FormQuestions[0].Options[0] = "中華"
+
+	saved, _ := repo.GetTemplate(ctx, "tpl_1")
+	if saved.Members[0].Preferences["allergies"].([]interface{})[0] != "えび" {
+		t.Errorf("Preferences = %v, 保存済みの好みが変更されないことを期待", saved.Members[0].Preferences)
+	}
+	if saved.FormQuestions[0].Options[0] != "和食" {
+		t.Errorf("Options = %v, 保存済みの選択肢が変更されないことを期待", saved.FormQuestions[0].Options)
+	}
+}
+
+func TestMemoryRecordRepositoryReactions(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRecordRepository()
+	if _, err := repo.CreateRecord(ctx, &domain.EventRecord{ID: "rec_1", OrganizerID: "owner", Rating: 3}); err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+
+	// いいね・報告の前に読み込んだ記録で内容を更新しても、いいね・報告は消えない
+	stale, _ := repo.GetRecord(ctx, "rec_1")
+	if _, err := repo.AddLike(ctx, "rec_1", "viewer"); err != nil {
+		t.Fatalf("AddLike() error = %v", err)
+	}
+	for _, reporterID := range []string{"reporter1", "reporter1", "reporter2"} {
+		if _, err := repo.AddReport(ctx, "rec_1", domain.RecordReport{ReporterID: reporterID, Reason: "spam"}, 2); err != nil {
+			t.Fatalf("AddReport(%s) error = %v", reporterID, err)
+		}
+	}
+	stale.Rating = 5
+	updated, err := repo.UpdateRecord(ctx, stale)
+	if err != nil {
+		t.Fatalf("UpdateRecord() error = %v", err)
+	}
+	if updated.Rating != 5 || len(updated.LikedBy) != 1 || len(updated.Reports) != 2 || updated.ModerationStatus != domain.ModerationPendingReview {
+		t.Errorf("UpdateRecord() = %+v, 評価の変更と保存済みのいいね1件・報告2件（審査待ち）を期待", updated)
+	}
+
+	removed, err := repo.RemoveLike(ctx, "rec_1", "viewer")
+	if err != nil || len(removed.LikedBy) != 0 {
+		t.Errorf("RemoveLike() = %+v, %v, いいね0件を期待", removed, err)
+	}
+	if _, err := repo.AddLike(ctx, "rec_missing", "viewer"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("存在しない記録の AddLike() error = %v, ErrNotFound を期待", err)
+	}
+}
+
+func TestMemoryIdempotencyRepository(t *testing.T) {
+	ctx := context.Background()
+	fixed := clock.NewFixedClock(time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC))
+	repo := NewMemoryIdempotencyRepository(WithClock(fixed))
+
+	record, err := repo.ReserveIdempotencyKey(ctx, "owner", "key-1", "hash-1")
+	if err != nil {
+		t.Fatalf("ReserveIdempotencyKey() error = %v", err)
+	}
+	if record.Completed() || record.ExpiresAt != fixed.Now().Add(IdempotencyTTL).Unix() {
+		t.Errorf("処理中・24時間後に失効するレコードを期待: %+v", record)
+	}
+	if _, err := repo.ReserveIdempotencyKey(ctx, "owner", "key-1", "hash-1"); !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("使用済みキーの ReserveIdempotencyKey() error = %v, ErrAlreadyExists を期待", err)
+	}
+	if _, err := repo.ReserveIdempotencyKey(ctx, "other", "key-1", "hash-1"); err != nil {
+		t.Errorf("別の幹事の同じキーで error = %v, nil を期待", err)
+	}
+
+	// 処理中のまま確保の期限を過ぎたキーは存在しないものとして扱い、確保し直せること
+	fixed.Advance(IdempotencyLease)
+	if _, err := repo.GetIdempotencyRecord(ctx, "owner", "key-1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("確保の期限切れの GetIdempotencyRecord() error = %v, ErrNotFound を期待", err)
+	}
+	if _, err := repo.ReserveIdempotencyKey(ctx, "owner", "key-1", "hash-1"); err != nil {
+		t.Fatalf("確保の期限切れの ReserveIdempotencyKey() error = %v, nil を期待", err)
+	}
+
+	if err := repo.CompleteIdempotencyKey(ctx, "owner", "key-1", 201, `{"success":true}`); err != nil {
+		t.Fatalf("CompleteIdempotencyKey() error = %v", err)
+	}
+	got, err := repo.GetIdempotencyRecord(ctx, "owner", "key-1")
+	if err != nil {
+		t.Fatalf("GetIdempotencyRecord() error = %v", err)
+	}
+	if !got.Completed() || got.StatusCode != 201 || got.ResponseBody != `{"success":true}` {
+		t.Errorf("保存したレスポンスが取得できません: %+v", got)
+	}
+	fixed.Advance(IdempotencyLease)
+	if _, err := repo.ReserveIdempotencyKey(ctx, "owner", "key-1", "hash-1"); !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("完了済みキーの確保の期限後の ReserveIdempotencyKey() error = %v, ErrAlreadyExists を期待", err)
+	}
+
+	// 有効期限切れのレコードは存在しないものとして扱い、キーを再確保できること
+	fixed.Advance(IdempotencyTTL)
+	if _, err := repo.GetIdempotencyRecord(ctx, "owner", "key-1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("期限切れの GetIdempotencyRecord() error = %v, ErrNotFound を期待", err)
+	}
+	if _, err := repo.ReserveIdempotencyKey(ctx, "owner", "key-1", "hash-2"); err != nil {
+		t.Errorf("期限切れキーの ReserveIdempotencyKey() error = %v, nil を期待", err)
+	}
+
+	if err := repo.ReleaseIdempotencyKey(ctx, "owner", "key-1"); err != nil {
+		t.Fatalf("ReleaseIdempotencyKey() error = %v", err)
+	}
+	if _, err := repo.GetIdempotencyRecord(ctx, "owner", "key-1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("解放後の GetIdempotencyRecord() error = %v, ErrNotFound を期待", err)
+	}
+}
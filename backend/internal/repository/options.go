@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+)
+
+// Option はリポジトリの依存関係を差し替えるための関数オプション
+type Option func(*options)
+
+// options はOptionで設定される依存関係の集合
+type options struct {
+	// clock はCreatedAt/UpdatedAtに設定する時刻の取得元
+	clock clock.Clock
+}
+
+// WithClock は時刻の取得元を差し替える
+// テストでCreatedAt/UpdatedAtを固定値にするために使用
+func WithClock(c clock.Clock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
+
+// newOptions はデフォルト値（システム時刻）にOptionを適用した結果を返す
+func newOptions(opts []Option) options {
+	o := options{
+		clock: clock.SystemClock{},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// RecordRepository は開催記録（幹事ログ）の永続化を担当するインターフェース
+type RecordRepository interface {
+	// CreateRecord は新しい記録を保存（CreatedAt・UpdatedAt はリポジトリで設定）
+	CreateRecord(ctx context.Context, record *domain.EventRecord) (*domain.EventRecord, error)
+
+	// GetRecord はIDで記録を取得
+	// 存在しない場合は ErrNotFound をラップしたエラーを返す
+	GetRecord(ctx context.Context, recordID string) (*domain.EventRecord, error)
+
+	// ListRecordsByOrganizer は幹事の記録を作成日時の新しい順で返す
+	ListRecordsByOrganizer(ctx context.Context, organizerID string) ([]*domain.EventRecord, error)
+
+	// ListSharedRecords は「みんなの記録」に共有中（IsShared）の記録を返す（順序は不定）
+	// 審査待ちの記録も含むため、表示の可否は呼び出し側で判定する
+	ListSharedRecords(ctx context.Context) ([]*domain.EventRecord, error)
+
+	// UpdateRecord は記録の内容を置き換える（UpdatedAt はリポジトリで設定）
+	// いいね・不適切報告・審査の状況（LikedBy・Reports・ModerationStatus）は並行して変わるため、
+	// record の値は使わず保存済みの値を保つ（変更は AddLike・RemoveLike・AddReport で行う）
+	// 存在しない場合は ErrNotFound をラップしたエラーを返す
+	UpdateRecord(ctx context.Context, record *domain.EventRecord) (*domain.EventRecord, error)
+
+	// AddLike は userID のいいねを追加し、追加後の記録を返す（いいね済みの場合は変更しない）
+	// 存在しない場合は ErrNotFound をラップしたエラーを返す
+	AddLike(ctx context.Context, recordID string, userID string) (*domain.EventRecord, error)
+
+	// RemoveLike は userID のいいねを取り消し、取り消し後の記録を返す（いいねしていない場合は変更しない）
+	// 存在しない場合は ErrNotFound をラップしたエラーを返す
+	RemoveLike(ctx context.Context, recordID string, userID string) (*domain.EventRecord, error)
+
+	// AddReport は不適切報告を追記し、追記後の記録を返す（同じ報告者が報告済みの場合は変更しない）
+	// 追記後の報告が hideAt 件以上になった場合は、同時に審査待ち（ModerationPendingReview）にする
+	// 存在しない場合は ErrNotFound をラップしたエラーを返す
+	AddReport(ctx context.Context, recordID string, report domain.RecordReport, hideAt int) (*domain.EventRecord, error)
+
+	// DeleteRecord は記録を削除
+	// 存在しない場合は ErrNotFound をラップしたエラーを返す
+	DeleteRecord(ctx context.Context, recordID string) error
+}
+
+// copyRecord は記録の複製を返す
+// 呼び出し側が返却値を変更しても保存済みデータに影響しないようにする
+func copyRecord(record *domain.EventRecord) *domain.EventRecord {
+	copied := *record
+	if record.Venue != nil {
+		venue := *record.Venue
+		copied.Venue = &venue
+	}
+	if record.Share != nil {
+		share := *record.Share
+		copied.Share = &share
+	}
+	copied.LikedBy = append([]string(nil), record.LikedBy...)
+	copied.Reports = append([]domain.RecordReport(nil), record.Reports...)
+	return &copied
+}
+
+// hasReported は reporterID の不適切報告が含まれるかを返す
+func hasReported(reports []domain.RecordReport, reporterID string) bool {
+	return slices.ContainsFunc(reports, func(report domain.RecordReport) bool {
+		return report.ReporterID == reporterID
+	})
+}
+
+// sortRecordsNewestFirst は記録を作成日時の新しい順（同時刻はID順）に並べ替える
+func sortRecordsNewestFirst(records []*domain.EventRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		if !records[i].CreatedAt.Equal(records[j].CreatedAt) {
+			return records[i].CreatedAt.After(records[j].CreatedAt)
+		}
+		return records[i].ID < records[j].ID
+	})
+}
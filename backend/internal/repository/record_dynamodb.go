@@ -0,0 +1,335 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// recordOrganizerIndex は幹事IDで記録を検索するGSI名
+const recordOrganizerIndex = "OrganizerIndex"
+
+// recordReactionAttributes は UpdateRecord で上書きしない属性（専用のメソッドで原子的に更新する）
+var recordReactionAttributes = []string{"id", "likedBy", "reports", "moderationStatus"}
+
+// recordOptionalAttributes は未設定の場合に UpdateRecord で削除する属性（omitempty の項目）
+var recordOptionalAttributes = []string{"venue", "share"}
+
+// reportMaxAttempts は AddReport が並行する報告と競合した場合に読み直す回数の上限
+const reportMaxAttempts = 3
+
+// DynamoDBRecordRepository はDynamoDBを使用したRecordRepositoryの実装
+// テーブルはパーティションキー id と、organizerId をキーとするGSI（OrganizerIndex）を持つ
+type DynamoDBRecordRepository struct {
+	// client はDynamoDB操作用のAWS SDKクライアント
+	client *dynamodb.Client
+
+	// tableName は記録を格納するDynamoDBテーブル名
+	// 例: kanji-log-event-records-dev
+	tableName string
+
+	// clock はCreatedAt・UpdatedAtに設定する時刻の取得元
+	clock clock.Clock
+}
+
+// NewDynamoDBRecordRepository は新しいDynamoDBRecordRepositoryインスタンスを作成
+func NewDynamoDBRecordRepository(client *dynamodb.Client, tableName string, opts ...Option) RecordRepository {
+	o := newOptions(opts)
+	return &DynamoDBRecordRepository{
+		client:    client,
+		tableName: tableName,
+		clock:     o.clock,
+	}
+}
+
+// CreateRecord は新しい記録をDynamoDBに保存
+func (r *DynamoDBRecordRepository) CreateRecord(ctx context.Context, record *domain.EventRecord) (*domain.EventRecord, error) {
+	now := r.clock.Now()
+	record.CreatedAt = now
+	record.UpdatedAt = now
+
+	item, err := attributevalue.MarshalMap(record)
+	if err != nil {
+		return nil, fmt.Errorf("記録のマーシャリングに失敗: %w", err)
+	}
+
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(r.tableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(id)"),
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return nil, fmt.Errorf("同じIDの記録が既に存在します: %s: %w", record.ID, ErrAlreadyExists)
+		}
+		return nil, fmt.Errorf("DynamoDBへの記録保存に失敗: %w", err)
+	}
+	return record, nil
+}
+
+// GetRecord はIDで記録を取得
+func (r *DynamoDBRecordRepository) GetRecord(ctx context.Context, recordID string) (*domain.EventRecord, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.tableName),
+		Key: map[string]types.AttributeValue{
+			"id": &types.AttributeValueMemberS{Value: recordID},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DynamoDBからの記録取得に失敗: %w", err)
+	}
+	if result.Item == nil {
+		return nil, fmt.Errorf("記録が見つかりません: %s: %w", recordID, ErrNotFound)
+	}
+
+	var record domain.EventRecord
+	if err := attributevalue.UnmarshalMap(result.Item, &record); err != nil {
+		return nil, fmt.Errorf("記録のアンマーシャリングに失敗: %w", err)
+	}
+	return &record, nil
+}
+
+// ListRecordsByOrganizer は OrganizerIndex をQueryして幹事の記録を取得
+// 1幹事あたりの記録数は開催回数程度の想定のため、全ページを読み込んでから並べ替える
+func (r *DynamoDBRecordRepository) ListRecordsByOrganizer(ctx context.Context, organizerID string) ([]*domain.EventRecord, error) {
+	paginator := dynamodb.NewQueryPaginator(r.client, &dynamodb.QueryInput{
+		TableName:              aws.String(r.tableName),
+		IndexName:              aws.String(recordOrganizerIndex),
+		KeyConditionExpression: aws.String("organizerId = :organizerId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":organizerId": &types.AttributeValueMemberS{Value: organizerID},
+		},
+	})
+
+	records := make([]*domain.EventRecord, 0)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DynamoDBでの記録一覧取得に失敗: %w", err)
+		}
+		var items []*domain.EventRecord
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			return nil, fmt.Errorf("記録のアンマーシャリングに失敗: %w", err)
+		}
+		records = append(records, items...)
+	}
+
+	sortRecordsNewestFirst(records)
+	return records, nil
+}
+
+// ListSharedRecords は isShared が true の記録をScanで取得
+// 共有記録は一覧のたびに絞り込み・いいね数の集計を行うため、全件を読み込む
+// 注意：件数が増えた場合は共有中の記録だけを持つGSIへの切り替えを検討する
+func (r *DynamoDBRecordRepository) ListSharedRecords(ctx context.Context) ([]*domain.EventRecord, error) {
+	paginator := dynamodb.NewScanPaginator(r.client, &dynamodb.ScanInput{
+		TableName:        aws.String(r.tableName),
+		FilterExpression: aws.String("isShared = :shared"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":shared": &types.AttributeValueMemberBOOL{Value: true},
+		},
+	})
+
+	records := make([]*domain.EventRecord, 0)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DynamoDBでの共有記録一覧取得に失敗: %w", err)
+		}
+		var items []*domain.EventRecord
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			return nil, fmt.Errorf("記録のアンマーシャリングに失敗: %w", err)
+		}
+		records = append(records, items...)
+	}
+	return records, nil
+}
+
+// UpdateRecord は記録の内容をUpdateItemで置き換える
+// PutItem で丸ごと置き換えると、読み込み後に追加されたいいね・不適切報告が消えるため、
+// いいね・不適切報告・審査の状況以外の属性のみを SET・REMOVE する
+func (r *DynamoDBRecordRepository) UpdateRecord(ctx context.Context, record *domain.EventRecord) (*domain.EventRecord, error) {
+	record.UpdatedAt = r.clock.Now()
+
+	item, err := attributevalue.MarshalMap(record)
+	if err != nil {
+		return nil, fmt.Errorf("記録のマーシャリングに失敗: %w", err)
+	}
+	for _, name := range recordReactionAttributes {
+		delete(item, name)
+	}
+
+	names := make([]string, 0, len(item))
+	for name := range item {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attributeNames := make(map[string]string, len(item)+len(recordOptionalAttributes))
+	attributeValues := make(map[string]types.AttributeValue, len(item))
+	sets := make([]string, 0, len(names))
+	for i, name := range names {
+		key := fmt.Sprintf("a%d", i)
+		attributeNames["#"+key] = name
+		attributeValues[":"+key] = item[name]
+		sets = append(sets, fmt.Sprintf("#%s = :%s", key, key))
+	}
+	expression := "SET " + strings.Join(sets, ", ")
+
+	removes := make([]string, 0, len(recordOptionalAttributes))
+	for _, name := range recordOptionalAttributes {
+		if _, exists := item[name]; !exists {
+			attributeNames["#"+name] = name
+			removes = append(removes, "#"+name)
+		}
+	}
+	if len(removes) > 0 {
+		expression += " REMOVE " + strings.Join(removes, ", ")
+	}
+
+	result, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(r.tableName),
+		Key:                       recordKey(record.ID),
+		UpdateExpression:          aws.String(expression),
+		ConditionExpression:       aws.String("attribute_exists(id)"),
+		ExpressionAttributeNames:  attributeNames,
+		ExpressionAttributeValues: attributeValues,
+		ReturnValues:              types.ReturnValueAllNew,
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return nil, fmt.Errorf("更新対象の記録が見つかりません: %s: %w", record.ID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("DynamoDBでの記録更新に失敗: %w", err)
+	}
+	return unmarshalRecord(result.Attributes)
+}
+
+// AddLike は文字列セット likedBy に userID を ADD する（いいね済みの場合は変わらない）
+func (r *DynamoDBRecordRepository) AddLike(ctx context.Context, recordID string, userID string) (*domain.EventRecord, error) {
+	return r.updateLikes(ctx, recordID, "ADD likedBy :users", userID)
+}
+
+// RemoveLike は文字列セット likedBy から userID を DELETE する（最後の1件の場合は属性ごと削除される）
+func (r *DynamoDBRecordRepository) RemoveLike(ctx context.Context, recordID string, userID string) (*domain.EventRecord, error) {
+	return r.updateLikes(ctx, recordID, "DELETE likedBy :users", userID)
+}
+
+// updateLikes はいいねの ADD・DELETE を実行し、更新後の記録を返す
+func (r *DynamoDBRecordRepository) updateLikes(ctx context.Context, recordID string, expression string, userID string) (*domain.EventRecord, error) {
+	result, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:           aws.String(r.tableName),
+		Key:                 recordKey(recordID),
+		UpdateExpression:    aws.String(expression),
+		ConditionExpression: aws.String("attribute_exists(id)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":users": &types.AttributeValueMemberSS{Value: []string{userID}},
+		},
+		ReturnValues: types.ReturnValueAllNew,
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return nil, fmt.Errorf("記録が見つかりません: %s: %w", recordID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("DynamoDBでのいいねの更新に失敗: %w", err)
+	}
+	return unmarshalRecord(result.Attributes)
+}
+
+// AddReport は不適切報告を list_append で追記する
+// 読み込んだ時点の報告件数を条件にして、並行する報告と競合した場合は読み直す。
+// 件数が分かっているため、審査待ちへの変更も同じ更新で行える
+func (r *DynamoDBRecordRepository) AddReport(ctx context.Context, recordID string, report domain.RecordReport, hideAt int) (*domain.EventRecord, error) {
+	reportValue, err := attributevalue.Marshal([]domain.RecordReport{report})
+	if err != nil {
+		return nil, fmt.Errorf("不適切報告のマーシャリングに失敗: %w", err)
+	}
+
+	for attempt := 0; attempt < reportMaxAttempts; attempt++ {
+		record, err := r.GetRecord(ctx, recordID)
+		if err != nil {
+			return nil, err
+		}
+		if hasReported(record.Reports, report.ReporterID) {
+			return record, nil
+		}
+
+		expression := "SET reports = list_append(if_not_exists(reports, :empty), :report)"
+		values := map[string]types.AttributeValue{
+			":empty":  &types.AttributeValueMemberL{Value: []types.AttributeValue{}},
+			":report": reportValue,
+			":count":  &types.AttributeValueMemberN{Value: strconv.Itoa(len(record.Reports))},
+		}
+		if len(record.Reports)+1 >= hideAt {
+			expression += ", moderationStatus = :pending"
+			values[":pending"] = &types.AttributeValueMemberS{Value: domain.ModerationPendingReview}
+		}
+
+		result, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+			TableName:                 aws.String(r.tableName),
+			Key:                       recordKey(recordID),
+			UpdateExpression:          aws.String(expression),
+			ConditionExpression:       aws.String("attribute_exists(id) AND (attribute_not_exists(reports) OR size(reports) = :count)"),
+			ExpressionAttributeValues: values,
+			ReturnValues:              types.ReturnValueAllNew,
+		})
+		if err == nil {
+			return unmarshalRecord(result.Attributes)
+		}
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if !errors.As(err, &conditionalCheckFailedException) {
+			return nil, fmt.Errorf("DynamoDBでの不適切報告の保存に失敗: %w", err)
+		}
+		// 記録の削除・並行する報告のいずれか。次の読み込みで判定する
+	}
+	return nil, fmt.Errorf("並行する不適切報告と競合したため保存できませんでした: %s", recordID)
+}
+
+// recordKey は記録のキーを返す
+func recordKey(recordID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"id": &types.AttributeValueMemberS{Value: recordID},
+	}
+}
+
+// unmarshalRecord はUpdateItemが返した更新後の属性を記録に変換する
+func unmarshalRecord(item map[string]types.AttributeValue) (*domain.EventRecord, error) {
+	var record domain.EventRecord
+	if err := attributevalue.UnmarshalMap(item, &record); err != nil {
+		return nil, fmt.Errorf("記録のアンマーシャリングに失敗: %w", err)
+	}
+	return &record, nil
+}
+
+// DeleteRecord は記録を物理削除
+func (r *DynamoDBRecordRepository) DeleteRecord(ctx context.Context, recordID string) error {
+	_, err := r.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(r.tableName),
+		Key: map[string]types.AttributeValue{
+			"id": &types.AttributeValueMemberS{Value: recordID},
+		},
+		ConditionExpression: aws.String("attribute_exists(id)"),
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("削除対象の記録が見つかりません: %s: %w", recordID, ErrNotFound)
+		}
+		return fmt.Errorf("DynamoDBでの記録削除に失敗: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// MemoryRecordRepository はメモリ上に記録を保持するRecordRepositoryの実装
+// ローカル開発サーバーやテストで使用する（プロセス終了でデータは消える）
+type MemoryRecordRepository struct {
+	mu      sync.RWMutex
+	records map[string]*domain.EventRecord
+	clock   clock.Clock
+}
+
+// NewMemoryRecordRepository は空のMemoryRecordRepositoryを作成
+func NewMemoryRecordRepository(opts ...Option) *MemoryRecordRepository {
+	o := newOptions(opts)
+	return &MemoryRecordRepository{
+		records: make(map[string]*domain.EventRecord),
+		clock:   o.clock,
+	}
+}
+
+// CreateRecord は新しい記録をメモリに保存
+func (r *MemoryRecordRepository) CreateRecord(ctx context.Context, record *domain.EventRecord) (*domain.EventRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.records[record.ID]; exists {
+		return nil, fmt.Errorf("同じIDの記録が既に存在します: %s: %w", record.ID, ErrAlreadyExists)
+	}
+
+	now := r.clock.Now()
+	record.CreatedAt = now
+	record.UpdatedAt = now
+	r.records[record.ID] = copyRecord(record)
+	return record, nil
+}
+
+// GetRecord はIDで記録を取得
+func (r *MemoryRecordRepository) GetRecord(ctx context.Context, recordID string) (*domain.EventRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	record, exists := r.records[recordID]
+	if !exists {
+		return nil, fmt.Errorf("記録が見つかりません: %s: %w", recordID, ErrNotFound)
+	}
+	return copyRecord(record), nil
+}
+
+// ListRecordsByOrganizer は幹事の記録を作成日時の新しい順で返す
+func (r *MemoryRecordRepository) ListRecordsByOrganizer(ctx context.Context, organizerID string) ([]*domain.EventRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	records := make([]*domain.EventRecord, 0)
+	for _, record := range r.records {
+		if record.OrganizerID == organizerID {
+			records = append(records, copyRecord(record))
+		}
+	}
+	sortRecordsNewestFirst(records)
+	return records, nil
+}
+
+// ListSharedRecords は共有中の記録を返す
+func (r *MemoryRecordRepository) ListSharedRecords(ctx context.Context) ([]*domain.EventRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	records := make([]*domain.EventRecord, 0)
+	for _, record := range r.records {
+		if record.IsShared {
+			records = append(records, copyRecord(record))
+		}
+	}
+	return records, nil
+}
+
+// UpdateRecord は記録の内容を置き換える（いいね・不適切報告・審査の状況は保存済みの値を保つ）
+func (r *MemoryRecordRepository) UpdateRecord(ctx context.Context, record *domain.EventRecord) (*domain.EventRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.records[record.ID]
+	if !exists {
+		return nil, fmt.Errorf("更新対象の記録が見つかりません: %s: %w", record.ID, ErrNotFound)
+	}
+
+	record.UpdatedAt = r.clock.Now()
+	record.LikedBy = append([]string(nil), stored.LikedBy...)
+	record.Reports = append([]domain.RecordReport(nil), stored.Reports...)
+	record.ModerationStatus = stored.ModerationStatus
+	r.records[record.ID] = copyRecord(record)
+	return record, nil
+}
+
+// AddLike は userID のいいねを追加する
+func (r *MemoryRecordRepository) AddLike(ctx context.Context, recordID string, userID string) (*domain.EventRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, exists := r.records[recordID]
+	if !exists {
+		return nil, fmt.Errorf("記録が見つかりません: %s: %w", recordID, ErrNotFound)
+	}
+	if !slices.Contains(record.LikedBy, userID) {
+		record.LikedBy = append(record.LikedBy, userID)
+	}
+	return copyRecord(record), nil
+}
+
+// RemoveLike は userID のいいねを取り消す
+func (r *MemoryRecordRepository) RemoveLike(ctx context.Context, recordID string, userID string) (*domain.EventRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, exists := r.records[recordID]
+	if !exists {
+		return nil, fmt.Errorf("記録が見つかりません: %s: %w", recordID, ErrNotFound)
+	}
+	record.LikedBy = slices.DeleteFunc(record.LikedBy, func(id string) bool { return id == userID })
+	return copyRecord(record), nil
+}
+
+// AddReport は不適切報告を追記する
+func (r *MemoryRecordRepository) AddReport(ctx context.Context, recordID string, report domain.RecordReport, hideAt int) (*domain.EventRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, exists := r.records[recordID]
+	if !exists {
+		return nil, fmt.Errorf("記録が見つかりません: %s: %w", recordID, ErrNotFound)
+	}
+	if !hasReported(record.Reports, report.ReporterID) {
+		record.Reports = append(record.Reports, report)
+		if len(record.Reports) >= hideAt {
+			record.ModerationStatus = domain.ModerationPendingReview
+		}
+	}
+	return copyRecord(record), nil
+}
+
+// DeleteRecord は記録を削除
+func (r *MemoryRecordRepository) DeleteRecord(ctx context.Context, recordID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.records[recordID]; !exists {
+		return fmt.Errorf("削除対象の記録が見つかりません: %s: %w", recordID, ErrNotFound)
+	}
+	delete(r.records, recordID)
+	return nil
+}
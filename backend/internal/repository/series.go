@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// SeriesRepository は定期開催シリーズの永続化を担当するインターフェース
+// 開催回（domain.Event）は EventRepository に保存し、SeriesID で紐付ける
+type SeriesRepository interface {
+	// CreateSeries は新しいシリーズを保存（CreatedAt/UpdatedAt はリポジトリで設定）
+	CreateSeries(ctx context.Context, series *domain.EventSeries) (*domain.EventSeries, error)
+
+	// GetSeries はIDでシリーズを取得
+	// 存在しない場合は ErrNotFound をラップしたエラーを返す
+	GetSeries(ctx context.Context, seriesID string) (*domain.EventSeries, error)
+
+	// UpdateSeries は既存シリーズを更新（UpdatedAt はリポジトリで設定）
+	// 存在しない場合は ErrNotFound をラップしたエラーを返す
+	UpdateSeries(ctx context.Context, series *domain.EventSeries) (*domain.EventSeries, error)
+}
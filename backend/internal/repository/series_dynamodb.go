@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// DynamoDBSeriesRepository はDynamoDBを使用したSeriesRepositoryの実装
+// テーブルはパーティションキー id のみを持つ（開催回の検索はイベントテーブル側で行う）
+type DynamoDBSeriesRepository struct {
+	// client はDynamoDB操作用のAWS SDKクライアント
+	client *dynamodb.Client
+
+	// tableName はシリーズを格納するDynamoDBテーブル名
+	// 例: kanji-log-event-series-dev
+	tableName string
+
+	// clock はCreatedAt/UpdatedAtに設定する時刻の取得元
+	clock clock.Clock
+}
+
+// NewDynamoDBSeriesRepository は新しいDynamoDBSeriesRepositoryインスタンスを作成
+func NewDynamoDBSeriesRepository(client *dynamodb.Client, tableName string, opts ...Option) SeriesRepository {
+	o := newOptions(opts)
+	return &DynamoDBSeriesRepository{
+		client:    client,
+		tableName: tableName,
+		clock:     o.clock,
+	}
+}
+
+// CreateSeries は新しいシリーズをDynamoDBに保存
+func (r *DynamoDBSeriesRepository) CreateSeries(ctx context.Context, series *domain.EventSeries) (*domain.EventSeries, error) {
+	now := r.clock.Now()
+	series.CreatedAt = now
+	series.UpdatedAt = now
+
+	if err := r.put(ctx, series, "attribute_not_exists(id)"); err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return nil, fmt.Errorf("同じIDのシリーズが既に存在します: %s: %w", series.ID, ErrAlreadyExists)
+		}
+		return nil, fmt.Errorf("DynamoDBへのシリーズ保存に失敗: %w", err)
+	}
+	return series, nil
+}
+
+// GetSeries はIDでシリーズを取得
+func (r *DynamoDBSeriesRepository) GetSeries(ctx context.Context, seriesID string) (*domain.EventSeries, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.tableName),
+		Key: map[string]types.AttributeValue{
+			"id": &types.AttributeValueMemberS{Value: seriesID},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DynamoDBからのシリーズ取得に失敗: %w", err)
+	}
+	if result.Item == nil {
+		return nil, fmt.Errorf("シリーズが見つかりません: %s: %w", seriesID, ErrNotFound)
+	}
+
+	var series domain.EventSeries
+	if err := attributevalue.UnmarshalMap(result.Item, &series); err != nil {
+		return nil, fmt.Errorf("シリーズのアンマーシャリングに失敗: %w", err)
+	}
+	return &series, nil
+}
+
+// UpdateSeries は既存シリーズを上書き保存
+func (r *DynamoDBSeriesRepository) UpdateSeries(ctx context.Context, series *domain.EventSeries) (*domain.EventSeries, error) {
+	series.UpdatedAt = r.clock.Now()
+
+	if err := r.put(ctx, series, "attribute_exists(id)"); err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return nil, fmt.Errorf("更新対象のシリーズが見つかりません: %s: %w", series.ID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("DynamoDBでのシリーズ更新に失敗: %w", err)
+	}
+	return series, nil
+}
+
+// put はシリーズを条件付きで PutItem する
+func (r *DynamoDBSeriesRepository) put(ctx context.Context, series *domain.EventSeries, condition string) error {
+	item, err := attributevalue.MarshalMap(series)
+	if err != nil {
+		return fmt.Errorf("シリーズのマーシャリングに失敗: %w", err)
+	}
+
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(r.tableName),
+		Item:                item,
+		ConditionExpression: aws.String(condition),
+	})
+	return err
+}
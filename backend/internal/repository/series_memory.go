@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// MemorySeriesRepository はメモリ上にシリーズを保持するSeriesRepositoryの実装
+// ローカル開発サーバーやテストで使用する（プロセス終了でデータは消える）
+type MemorySeriesRepository struct {
+	mu     sync.RWMutex
+	series map[string]*domain.EventSeries
+	clock  clock.Clock
+}
+
+// NewMemorySeriesRepository は空のMemorySeriesRepositoryを作成
+func NewMemorySeriesRepository(opts ...Option) *MemorySeriesRepository {
+	o := newOptions(opts)
+	return &MemorySeriesRepository{
+		series: make(map[string]*domain.EventSeries),
+		clock:  o.clock,
+	}
+}
+
+// CreateSeries は新しいシリーズをメモリに保存
+func (r *MemorySeriesRepository) CreateSeries(ctx context.Context, series *domain.EventSeries) (*domain.EventSeries, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.series[series.ID]; exists {
+		return nil, fmt.Errorf("同じIDのシリーズが既に存在します: %s: %w", series.ID, ErrAlreadyExists)
+	}
+
+	now := r.clock.Now()
+	series.CreatedAt = now
+	series.UpdatedAt = now
+	copied := *series
+	r.series[series.ID] = &copied
+	return series, nil
+}
+
+// GetSeries はIDでシリーズを取得
+func (r *MemorySeriesRepository) GetSeries(ctx context.Context, seriesID string) (*domain.EventSeries, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	series, exists := r.series[seriesID]
+	if !exists {
+		return nil, fmt.Errorf("シリーズが見つかりません: %s: %w", seriesID, ErrNotFound)
+	}
+	copied := *series
+	return &copied, nil
+}
+
+// UpdateSeries は既存シリーズを更新
+func (r *MemorySeriesRepository) UpdateSeries(ctx context.Context, series *domain.EventSeries) (*domain.EventSeries, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.series[series.ID]; !exists {
+		return nil, fmt.Errorf("更新対象のシリーズが見つかりません: %s: %w", series.ID, ErrNotFound)
+	}
+
+	series.UpdatedAt = r.clock.Now()
+	copied := *series
+	r.series[series.ID] = &copied
+	return series, nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// TemplateRepository はイベントテンプレートの永続化を担当するインターフェース
+type TemplateRepository interface {
+	// CreateTemplate は新しいテンプレートを保存（CreatedAt はリポジトリで設定）
+	CreateTemplate(ctx context.Context, template *domain.EventTemplate) (*domain.EventTemplate, error)
+
+	// GetTemplate はIDでテンプレートを取得
+	// 存在しない場合は ErrNotFound をラップしたエラーを返す
+	GetTemplate(ctx context.Context, templateID string) (*domain.EventTemplate, error)
+
+	// ListTemplatesByOrganizer は幹事が保存したテンプレートを保存日時の昇順で返す
+	ListTemplatesByOrganizer(ctx context.Context, organizerID string) ([]*domain.EventTemplate, error)
+
+	// DeleteTemplate はテンプレートを削除
+	// 存在しない場合は ErrNotFound をラップしたエラーを返す
+	DeleteTemplate(ctx context.Context, templateID string) error
+}
+
+// copyTemplate はテンプレートの複製を返す
+// 呼び出し側が返却値を変更しても保存済みデータに影響しないよう、copyEvent と同じく選択肢・好みまで複製する
+func copyTemplate(template *domain.EventTemplate) *domain.EventTemplate {
+	copied := *template
+	copied.FormQuestions = copyFormQuestions(template.FormQuestions)
+	copied.Members = copyMembers(template.Members)
+	if template.Budget != nil {
+		budget := *template.Budget
+		copied.Budget = &budget
+	}
+	return &copied
+}
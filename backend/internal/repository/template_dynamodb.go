@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// templateOrganizerIndex は幹事IDでテンプレートを検索するGSI名
+const templateOrganizerIndex = "OrganizerIndex"
+
+// DynamoDBTemplateRepository はDynamoDBを使用したTemplateRepositoryの実装
+// テーブルはパーティションキー id と、organizerId をキーとするGSI（OrganizerIndex）を持つ
+type DynamoDBTemplateRepository struct {
+	// client はDynamoDB操作用のAWS SDKクライアント
+	client *dynamodb.Client
+
+	// tableName はテンプレートを格納するDynamoDBテーブル名
+	// 例: kanji-log-event-templates-dev
+	tableName string
+
+	// clock はCreatedAtに設定する時刻の取得元
+	clock clock.Clock
+}
+
+// NewDynamoDBTemplateRepository は新しいDynamoDBTemplateRepositoryインスタンスを作成
+func NewDynamoDBTemplateRepository(client *dynamodb.Client, tableName string, opts ...Option) TemplateRepository {
+	o := newOptions(opts)
+	return &DynamoDBTemplateRepository{
+		client:    client,
+		tableName: tableName,
+		clock:     o.clock,
+	}
+}
+
+// CreateTemplate は新しいテンプレートをDynamoDBに保存
+func (r *DynamoDBTemplateRepository) CreateTemplate(ctx context.Context, template *domain.EventTemplate) (*domain.EventTemplate, error) {
+	template.CreatedAt = r.clock.Now()
+
+	item, err := attributevalue.MarshalMap(template)
+	if err != nil {
+		return nil, fmt.Errorf("テンプレートのマーシャリングに失敗: %w", err)
+	}
+
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(r.tableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(id)"),
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return nil, fmt.Errorf("同じIDのテンプレートが既に存在します: %s: %w", template.ID, ErrAlreadyExists)
+		}
+		return nil, fmt.Errorf("DynamoDBへのテンプレート保存に失敗: %w", err)
+	}
+
+	return template, nil
+}
+
+// GetTemplate はIDでテンプレートを取得
+func (r *DynamoDBTemplateRepository) GetTemplate(ctx context.Context, templateID string) (*domain.EventTemplate, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.tableName),
+		Key: map[string]types.AttributeValue{
+			"id": &types.AttributeValueMemberS{Value: templateID},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DynamoDBからのテンプレート取得に失敗: %w", err)
+	}
+	if result.Item == nil {
+		return nil, fmt.Errorf("テンプレートが見つかりません: %s: %w", templateID, ErrNotFound)
+	}
+
+	var template domain.EventTemplate
+	if err := attributevalue.UnmarshalMap(result.Item, &template); err != nil {
+		return nil, fmt.Errorf("テンプレートのアンマーシャリングに失敗: %w", err)
+	}
+	return &template, nil
+}
+
+// ListTemplatesByOrganizer は OrganizerIndex をQueryして幹事のテンプレートを取得
+// 1幹事あたりのテンプレート数は少ない想定のため、全ページを読み込んでから並べ替える
+func (r *DynamoDBTemplateRepository) ListTemplatesByOrganizer(ctx context.Context, organizerID string) ([]*domain.EventTemplate, error) {
+	paginator := dynamodb.NewQueryPaginator(r.client, &dynamodb.QueryInput{
+		TableName:              aws.String(r.tableName),
+		IndexName:              aws.String(templateOrganizerIndex),
+		KeyConditionExpression: aws.String("organizerId = :organizerId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":organizerId": &types.AttributeValueMemberS{Value: organizerID},
+		},
+	})
+
+	templates := make([]*domain.EventTemplate, 0)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DynamoDBでのテンプレート一覧取得に失敗: %w", err)
+		}
+		var items []*domain.EventTemplate
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			return nil, fmt.Errorf("テンプレートのアンマーシャリングに失敗: %w", err)
+		}
+		templates = append(templates, items...)
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		if !templates[i].CreatedAt.Equal(templates[j].CreatedAt) {
+			return templates[i].CreatedAt.Before(templates[j].CreatedAt)
+		}
+		return templates[i].ID < templates[j].ID
+	})
+	return templates, nil
+}
+
+// DeleteTemplate はテンプレートを物理削除
+func (r *DynamoDBTemplateRepository) DeleteTemplate(ctx context.Context, templateID string) error {
+	_, err := r.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(r.tableName),
+		Key: map[string]types.AttributeValue{
+			"id": &types.AttributeValueMemberS{Value: templateID},
+		},
+		ConditionExpression: aws.String("attribute_exists(id)"),
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("削除対象のテンプレートが見つかりません: %s: %w", templateID, ErrNotFound)
+		}
+		return fmt.Errorf("DynamoDBでのテンプレート削除に失敗: %w", err)
+	}
+	return nil
+}
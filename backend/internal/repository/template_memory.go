@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// MemoryTemplateRepository はメモリ上にテンプレートを保持するTemplateRepositoryの実装
+// ローカル開発サーバーやテストで使用する（プロセス終了でデータは消える）
+type MemoryTemplateRepository struct {
+	mu        sync.RWMutex
+	templates map[string]*domain.EventTemplate
+	clock     clock.Clock
+}
+
+// NewMemoryTemplateRepository は空のMemoryTemplateRepositoryを作成
+func NewMemoryTemplateRepository(opts ...Option) *MemoryTemplateRepository {
+	o := newOptions(opts)
+	return &MemoryTemplateRepository{
+		templates: make(map[string]*domain.EventTemplate),
+		clock:     o.clock,
+	}
+}
+
+// CreateTemplate は新しいテンプレートをメモリに保存
+func (r *MemoryTemplateRepository) CreateTemplate(ctx context.Context, template *domain.EventTemplate) (*domain.EventTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.templates[template.ID]; exists {
+		return nil, fmt.Errorf("同じIDのテンプレートが既に存在します: %s: %w", template.ID, ErrAlreadyExists)
+	}
+
+	template.CreatedAt = r.clock.Now()
+	r.templates[template.ID] = copyTemplate(template)
+	return template, nil
+}
+
+// GetTemplate はIDでテンプレートを取得
+func (r *MemoryTemplateRepository) GetTemplate(ctx context.Context, templateID string) (*domain.EventTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	template, exists := r.templates[templateID]
+	if !exists {
+		return nil, fmt.Errorf("テンプレートが見つかりません: %s: %w", templateID, ErrNotFound)
+	}
+	return copyTemplate(template), nil
+}
+
+// ListTemplatesByOrganizer は幹事のテンプレートを保存日時の昇順（同時刻はID順）で返す
+func (r *MemoryTemplateRepository) ListTemplatesByOrganizer(ctx context.Context, organizerID string) ([]*domain.EventTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	templates := make([]*domain.EventTemplate, 0)
+	for _, template := range r.templates {
+		if template.OrganizerID == organizerID {
+			templates = append(templates, copyTemplate(template))
+		}
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		if !templates[i].CreatedAt.Equal(templates[j].CreatedAt) {
+			return templates[i].CreatedAt.Before(templates[j].CreatedAt)
+		}
+		return templates[i].ID < templates[j].ID
+	})
+	return templates, nil
+}
+
+// DeleteTemplate はテンプレートを削除
+func (r *MemoryTemplateRepository) DeleteTemplate(ctx context.Context, templateID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.templates[templateID]; !exists {
+		return fmt.Errorf("削除対象のテンプレートが見つかりません: %s: %w", templateID, ErrNotFound)
+	}
+	delete(r.templates, templateID)
+	return nil
+}
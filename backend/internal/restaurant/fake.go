@@ -0,0 +1,108 @@
+package restaurant
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// fixtureJSON は Fake の既定の店舗データ（東京都内の架空の店舗）
+//
+//go:embed fixtures/restaurants.json
+var fixtureJSON []byte
+
+// Fake はあらかじめ用意した店舗データから検索する Provider
+// ネットワークを使わないため、APIキーのないローカル開発やテストで使用する
+type Fake struct {
+	restaurants []domain.Restaurant
+}
+
+// NewFake は restaurants を検索対象とする Fake を作成
+// Provider が空の店舗には "fake" を設定する
+func NewFake(restaurants []domain.Restaurant) *Fake {
+	f := &Fake{restaurants: make([]domain.Restaurant, 0, len(restaurants))}
+	for _, restaurant := range restaurants {
+		if restaurant.Provider == "" {
+			restaurant.Provider = f.Name()
+		}
+		f.restaurants = append(f.restaurants, restaurant)
+	}
+	return f
+}
+
+// NewFixtureFake は同梱の店舗データ（fixtures/restaurants.json）を検索対象とする Fake を作成
+func NewFixtureFake() (*Fake, error) {
+	var restaurants []domain.Restaurant
+	if err := json.Unmarshal(fixtureJSON, &restaurants); err != nil {
+		return nil, fmt.Errorf("店舗データの読み込みに失敗: %w", err)
+	}
+	return NewFake(restaurants), nil
+}
+
+// Name はプロバイダー名を返す
+func (f *Fake) Name() string {
+	return "fake"
+}
+
+// Search は条件に一致する店舗を店舗データの順に返す
+// エリアは店舗のエリア・最寄り駅・住所、キーワードは店名・ジャンル・特徴の部分一致で判定する
+func (f *Fake) Search(ctx context.Context, criteria domain.RestaurantSearchCriteria) ([]domain.Restaurant, error) {
+	count := searchCount(criteria)
+	restaurants := make([]domain.Restaurant, 0)
+	for _, restaurant := range f.restaurants {
+		if len(restaurants) >= count {
+			break
+		}
+		if !containsAny(criteria.Area, restaurant.Area, restaurant.Station, restaurant.Address) {
+			continue
+		}
+		if criteria.Genre != "" && !strings.Contains(restaurant.Genre, criteria.Genre) && !strings.Contains(criteria.Genre, restaurant.Genre) {
+			continue
+		}
+		if criteria.Keyword != "" && !containsAny(criteria.Keyword, append([]string{restaurant.Name, restaurant.Genre}, restaurant.Features...)...) {
+			continue
+		}
+		if criteria.Capacity > 0 && restaurant.Capacity > 0 && restaurant.Capacity < criteria.Capacity {
+			continue
+		}
+		if !budgetOverlaps(restaurant.BudgetMin, restaurant.BudgetMax, criteria) {
+			continue
+		}
+		restaurants = append(restaurants, copyRestaurant(restaurant))
+	}
+	return restaurants, nil
+}
+
+// Get は店舗IDで店舗を返す
+func (f *Fake) Get(ctx context.Context, id string) (*domain.Restaurant, error) {
+	for _, restaurant := range f.restaurants {
+		if restaurant.ID == id {
+			copied := copyRestaurant(restaurant)
+			return &copied, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+}
+
+// containsAny は values のいずれかが query を含むかを返す（query が空の場合は true）
+func containsAny(query string, values ...string) bool {
+	if query == "" {
+		return true
+	}
+	for _, value := range values {
+		if strings.Contains(value, query) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyRestaurant は呼び出し側の変更が店舗データに影響しないよう特徴のスライスを複製する
+func copyRestaurant(restaurant domain.Restaurant) domain.Restaurant {
+	restaurant.Features = append([]string(nil), restaurant.Features...)
+	return restaurant
+}
@@ -0,0 +1,102 @@
+package restaurant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+func TestFakeSearch(t *testing.T) {
+	fake, err := NewFixtureFake()
+	if err != nil {
+		t.Fatalf("NewFixtureFake() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		criteria domain.RestaurantSearchCriteria
+		wantIDs  []string
+	}{
+		{
+			name:     "エリアのみ（駅名・住所も対象）",
+			criteria: domain.RestaurantSearchCriteria{Area: "新宿"},
+			wantIDs:  []string{"fake_shinjuku_001", "fake_shinjuku_002", "fake_shinjuku_003"},
+		},
+		{
+			name:     "ジャンル",
+			criteria: domain.RestaurantSearchCriteria{Area: "渋谷", Genre: "居酒屋"},
+			wantIDs:  []string{"fake_shibuya_003"},
+		},
+		{
+			name:     "予算の上限",
+			criteria: domain.RestaurantSearchCriteria{Area: "新宿", BudgetMax: 4500},
+			wantIDs:  []string{"fake_shinjuku_001", "fake_shinjuku_002"},
+		},
+		{
+			name:     "席数",
+			criteria: domain.RestaurantSearchCriteria{Area: "新宿", Capacity: 50},
+			wantIDs:  []string{"fake_shinjuku_001", "fake_shinjuku_003"},
+		},
+		{
+			name:     "キーワードは特徴にも一致",
+			criteria: domain.RestaurantSearchCriteria{Area: "池袋", Keyword: "アレルギー対応"},
+			wantIDs:  []string{"fake_ikebukuro_002"},
+		},
+		{
+			name:     "件数",
+			criteria: domain.RestaurantSearchCriteria{Area: "渋谷", Count: 2},
+			wantIDs:  []string{"fake_shibuya_001", "fake_shibuya_002"},
+		},
+		{
+			name:     "該当なし",
+			criteria: domain.RestaurantSearchCriteria{Area: "札幌"},
+			wantIDs:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fake.Search(context.Background(), tt.criteria)
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			ids := make([]string, 0, len(got))
+			for _, restaurant := range got {
+				ids = append(ids, restaurant.ID)
+				if restaurant.Provider != "fake" {
+					t.Errorf("Provider = %q, fake を期待", restaurant.Provider)
+				}
+			}
+			if len(ids) != len(tt.wantIDs) {
+				t.Fatalf("Search() = %v, %v を期待", ids, tt.wantIDs)
+			}
+			for i := range ids {
+				if ids[i] != tt.wantIDs[i] {
+					t.Errorf("Search() = %v, %v を期待", ids, tt.wantIDs)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestFakeGet(t *testing.T) {
+	fake := NewFake([]domain.Restaurant{{ID: "shop_1", Name: "テスト酒場", Features: []string{"個室あり"}}})
+
+	got, err := fake.Get(context.Background(), "shop_1")
+	if err != nil || got.Name != "テスト酒場" {
+		t.Fatalf("Get() = %+v, error = %v", got, err)
+	}
+	// 返した値を変更しても店舗データには影響しない
+	got.Features[0] = "変更"
+	again, _ := fake.Get(context.Background(), "shop_1")
+	if again.Features[0] != "個室あり" {
+		t.Errorf("店舗データが変更されました: %v", again.Features)
+	}
+
+	if _, err := fake.Get(context.Background(), "shop_2"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() error = %v, ErrNotFound を期待", err)
+	}
+}
@@ -0,0 +1,339 @@
+package restaurant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// HotPepperBaseURL はホットペッパーグルメ サーチAPIのエンドポイント
+const HotPepperBaseURL = "https://webservice.recruit.co.jp/hotpepper/gourmet/v1/"
+
+// hotPepperMaxBudgetCodes は1回の検索で指定できる予算コードの最大数（APIの制約）
+const hotPepperMaxBudgetCodes = 2
+
+// hotPepperGenres はジャンル名とホットペッパーのジャンルコードの対応
+// 一覧にないジャンル名はキーワードとして検索する
+var hotPepperGenres = map[string]string{
+	"居酒屋":         "G001",
+	"ダイニングバー・バル":  "G002",
+	"創作料理":        "G003",
+	"和食":          "G004",
+	"洋食":          "G005",
+	"イタリアン・フレンチ":  "G006",
+	"中華":          "G007",
+	"焼肉・ホルモン":     "G008",
+	"アジア・エスニック料理": "G009",
+	"各国料理":        "G010",
+	"カラオケ・パーティ":   "G011",
+	"バー・カクテル":     "G012",
+	"ラーメン":        "G013",
+	"カフェ・スイーツ":    "G014",
+	"その他グルメ":      "G015",
+	"お好み焼き・もんじゃ":  "G016",
+	"韓国料理":        "G017",
+}
+
+// hotPepperBudget はホットペッパーの予算コードと1人あたりの金額の範囲
+type hotPepperBudget struct {
+	code string
+	min  int
+	max  int // 0 は上限なし
+}
+
+// hotPepperBudgets はホットペッパーの予算コードの一覧（金額の昇順）
+var hotPepperBudgets = []hotPepperBudget{
+	{code: "B009", min: 0, max: 500},
+	{code: "B010", min: 501, max: 1000},
+	{code: "B011", min: 1001, max: 1500},
+	{code: "B001", min: 1501, max: 2000},
+	{code: "B002", min: 2001, max: 3000},
+	{code: "B003", min: 3001, max: 4000},
+	{code: "B008", min: 4001, max: 5000},
+	{code: "B004", min: 5001, max: 7000},
+	{code: "B005", min: 7001, max: 10000},
+	{code: "B006", min: 10001, max: 15000},
+	{code: "B012", min: 15001, max: 20000},
+	{code: "B013", min: 20001, max: 30000},
+	{code: "B014", min: 30001, max: 0},
+}
+
+// HotPepper はホットペッパーグルメ サーチAPIを使用する Provider
+// APIキーはリクルートWEBサービスで発行したものを使用する
+type HotPepper struct {
+	// apiKey はリクルートWEBサービスのAPIキー
+	apiKey string
+
+	// baseURL はAPIのエンドポイント（テストではモックサーバーに差し替える）
+	baseURL string
+
+	// client はAPI呼び出しに使用するHTTPクライアント
+	client *http.Client
+}
+
+// HotPepperOption は HotPepper の接続先などを差し替えるための関数オプション
+type HotPepperOption func(*HotPepper)
+
+// WithBaseURL はAPIのエンドポイントを差し替える（テストでモックサーバーを使う場合に使用）
+func WithBaseURL(baseURL string) HotPepperOption {
+	return func(h *HotPepper) {
+		h.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient はAPI呼び出しに使用するHTTPクライアントを差し替える
+func WithHTTPClient(client *http.Client) HotPepperOption {
+	return func(h *HotPepper) {
+		h.client = client
+	}
+}
+
+// NewHotPepper は新しいHotPepperインスタンスを作成
+// 既定ではタイムアウト5秒のHTTPクライアントで HotPepperBaseURL に接続する
+func NewHotPepper(apiKey string, opts ...HotPepperOption) *HotPepper {
+	h := &HotPepper{
+		apiKey:  apiKey,
+		baseURL: HotPepperBaseURL,
+		client:  &http.Client{Timeout: 5 * time.Second},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Name はプロバイダー名を返す
+func (h *HotPepper) Name() string {
+	return "hotpepper"
+}
+
+// Search は条件に一致する店舗を検索
+// 予算の範囲が3つ以上の予算コードにまたがる場合はAPIの制約上予算を指定せずに検索し、結果を絞り込む
+func (h *HotPepper) Search(ctx context.Context, criteria domain.RestaurantSearchCriteria) ([]domain.Restaurant, error) {
+	params := url.Values{}
+	keywords := []string{criteria.Area}
+	if code, ok := hotPepperGenres[criteria.Genre]; ok {
+		params.Set("genre", code)
+	} else if criteria.Genre != "" {
+		keywords = append(keywords, criteria.Genre)
+	}
+	if criteria.Keyword != "" {
+		keywords = append(keywords, criteria.Keyword)
+	}
+	params.Set("keyword", strings.Join(keywords, " "))
+
+	codes := budgetCodes(criteria)
+	if len(codes) > 0 && len(codes) <= hotPepperMaxBudgetCodes {
+		params.Set("budget", strings.Join(codes, ","))
+	}
+	if criteria.Capacity > 0 {
+		params.Set("party_capacity", strconv.Itoa(criteria.Capacity))
+	}
+	params.Set("count", strconv.Itoa(searchCount(criteria)))
+
+	shops, err := h.call(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	restaurants := make([]domain.Restaurant, 0, len(shops))
+	for _, shop := range shops {
+		restaurant := shop.toRestaurant(h.Name())
+		if !budgetOverlaps(restaurant.BudgetMin, restaurant.BudgetMax, criteria) {
+			continue
+		}
+		restaurants = append(restaurants, restaurant)
+	}
+	return restaurants, nil
+}
+
+// Get は店舗IDで店舗の詳細を取得
+func (h *HotPepper) Get(ctx context.Context, id string) (*domain.Restaurant, error) {
+	params := url.Values{}
+	params.Set("id", id)
+
+	shops, err := h.call(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(shops) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	restaurant := shops[0].toRestaurant(h.Name())
+	return &restaurant, nil
+}
+
+// call はAPIキー・レスポンス形式を付けてAPIを呼び出し、店舗の一覧を返す
+func (h *HotPepper) call(ctx context.Context, params url.Values) ([]hotPepperShop, error) {
+	params.Set("key", h.apiKey)
+	params.Set("format", "json")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.baseURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("ホットペッパーAPIのリクエスト作成に失敗: %w", err)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		// *url.Error のメッセージにはAPIキーを含むURLが入るため、原因のエラーのみを残す
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: HTTPステータス %d", ErrUnavailable, resp.StatusCode)
+	}
+
+	var body hotPepperResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("%w: レスポンスの解析に失敗: %v", ErrUnavailable, err)
+	}
+	// エラー時もHTTPステータスは200のため、results.error で判定する
+	if len(body.Results.Error) > 0 {
+		apiErr := body.Results.Error[0]
+		return nil, fmt.Errorf("%w: code=%d %s", ErrUnavailable, apiErr.Code, apiErr.Message)
+	}
+	return body.Results.Shop, nil
+}
+
+// budgetCodes は予算の範囲と重なる予算コードを返す（予算の指定がない場合は nil）
+func budgetCodes(criteria domain.RestaurantSearchCriteria) []string {
+	if criteria.BudgetMin <= 0 && criteria.BudgetMax <= 0 {
+		return nil
+	}
+	var codes []string
+	for _, budget := range hotPepperBudgets {
+		if budgetOverlaps(budget.min, budget.max, criteria) {
+			codes = append(codes, budget.code)
+		}
+	}
+	return codes
+}
+
+// hotPepperResponse はホットペッパーグルメ サーチAPIのレスポンス
+type hotPepperResponse struct {
+	Results struct {
+		Shop  []hotPepperShop `json:"shop"`
+		Error []struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	} `json:"results"`
+}
+
+// hotPepperShop はレスポンスの店舗情報（使用する項目のみ）
+type hotPepperShop struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Address     string       `json:"address"`
+	StationName string       `json:"station_name"`
+	Lat         float64      `json:"lat"`
+	Lng         float64      `json:"lng"`
+	Capacity    flexibleInt  `json:"capacity"`
+	PrivateRoom string       `json:"private_room"`
+	FreeDrink   string       `json:"free_drink"`
+	FreeFood    string       `json:"free_food"`
+	NonSmoking  string       `json:"non_smoking"`
+	Course      string       `json:"course"`
+	Genre       hotPepperRef `json:"genre"`
+	Budget      hotPepperRef `json:"budget"`
+	MiddleArea  hotPepperRef `json:"middle_area"`
+	SmallArea   hotPepperRef `json:"small_area"`
+	URLs        struct {
+		PC string `json:"pc"`
+	} `json:"urls"`
+	Photo struct {
+		PC struct {
+			L string `json:"l"`
+		} `json:"pc"`
+	} `json:"photo"`
+}
+
+// hotPepperRef はコードと名前の組（ジャンル・予算・エリア）
+type hotPepperRef struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// flexibleInt は数値と文字列（未設定時は空文字列）のどちらでも返される項目
+type flexibleInt int
+
+// UnmarshalJSON は数値・数字の文字列を整数として読み込む（それ以外は 0）
+func (f *flexibleInt) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		*f = 0
+		return nil
+	}
+	*f = flexibleInt(n)
+	return nil
+}
+
+// toRestaurant は店舗情報を共通の形式に変換
+func (s hotPepperShop) toRestaurant(provider string) domain.Restaurant {
+	area := s.SmallArea.Name
+	if area == "" {
+		area = s.MiddleArea.Name
+	}
+
+	restaurant := domain.Restaurant{
+		ID:             s.ID,
+		Provider:       provider,
+		Name:           s.Name,
+		Genre:          s.Genre.Name,
+		Area:           area,
+		Station:        s.StationName,
+		Address:        s.Address,
+		Latitude:       s.Lat,
+		Longitude:      s.Lng,
+		PriceRange:     s.Budget.Name,
+		Capacity:       int(s.Capacity),
+		Features:       s.features(),
+		ImageURL:       s.Photo.PC.L,
+		ReservationURL: s.URLs.PC,
+	}
+	for _, budget := range hotPepperBudgets {
+		if budget.code == s.Budget.Code {
+			restaurant.BudgetMin, restaurant.BudgetMax = budget.min, budget.max
+			break
+		}
+	}
+	if s.Lat != 0 || s.Lng != 0 {
+		restaurant.MapURL = fmt.Sprintf("https://www.google.com/maps/search/?api=1&query=%f,%f", s.Lat, s.Lng)
+	}
+	return restaurant
+}
+
+// features は設備・サービスの項目から店舗の特徴を返す
+// 各項目は "あり"・"あり ：10名様まで" のような自由記述のため、先頭の語で判定する
+func (s hotPepperShop) features() []string {
+	var features []string
+	if strings.HasPrefix(s.PrivateRoom, "あり") {
+		features = append(features, "個室あり")
+	}
+	if strings.HasPrefix(s.FreeDrink, "あり") {
+		features = append(features, "飲み放題")
+	}
+	if strings.HasPrefix(s.FreeFood, "あり") {
+		features = append(features, "食べ放題")
+	}
+	if strings.HasPrefix(s.Course, "あり") {
+		features = append(features, "コースあり")
+	}
+	if s.NonSmoking == "全面禁煙" {
+		features = append(features, "禁煙")
+	}
+	return features
+}
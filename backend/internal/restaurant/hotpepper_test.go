@@ -0,0 +1,139 @@
+package restaurant
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// newHotPepperServer は body を返すモックAPIサーバーを起動し、受け取ったクエリを query に記録する
+func newHotPepperServer(t *testing.T, status int, body []byte, query *url.Values) *HotPepper {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if query != nil {
+			*query = r.URL.Query()
+		}
+		w.WriteHeader(status)
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return NewHotPepper("test-key", WithBaseURL(server.URL))
+}
+
+func TestHotPepperSearch(t *testing.T) {
+	body, err := os.ReadFile("testdata/hotpepper_search.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var query url.Values
+	h := newHotPepperServer(t, 200, body, &query)
+	got, err := h.Search(context.Background(), domain.RestaurantSearchCriteria{
+		Area: "新宿", Genre: "居酒屋", Keyword: "個室", BudgetMin: 3000, BudgetMax: 4000, Capacity: 12,
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	wantQuery := map[string]string{
+		"key":            "test-key",
+		"format":         "json",
+		"keyword":        "新宿 個室",
+		"genre":          "G001",
+		"budget":         "B002,B003",
+		"party_capacity": "12",
+		"count":          "20",
+	}
+	for key, want := range wantQuery {
+		if query.Get(key) != want {
+			t.Errorf("クエリ %s = %q, %q を期待", key, query.Get(key), want)
+		}
+	}
+
+	// 予算の範囲外（7001～10000円）の店舗は除外される
+	if len(got) != 1 {
+		t.Fatalf("Search() = %d件, 1件を期待: %+v", len(got), got)
+	}
+	shop := got[0]
+	if shop.ID != "J001234567" || shop.Provider != "hotpepper" || shop.Area != "西新宿" || shop.Station != "新宿" {
+		t.Errorf("店舗の基本情報が期待と異なります: %+v", shop)
+	}
+	if shop.BudgetMin != 3001 || shop.BudgetMax != 4000 || shop.PriceRange != "3001～4000円" || shop.Capacity != 80 {
+		t.Errorf("予算・席数が期待と異なります: %+v", shop)
+	}
+	wantFeatures := []string{"個室あり", "飲み放題", "コースあり", "禁煙"}
+	if len(shop.Features) != len(wantFeatures) {
+		t.Fatalf("Features = %v, %v を期待", shop.Features, wantFeatures)
+	}
+	for i, feature := range wantFeatures {
+		if shop.Features[i] != feature {
+			t.Errorf("Features = %v, %v を期待", shop.Features, wantFeatures)
+			break
+		}
+	}
+	if shop.MapURL == "" || shop.ReservationURL != "https://www.hotpepper.jp/strJ001234567/" {
+		t.Errorf("URLが期待と異なります: map=%q reservation=%q", shop.MapURL, shop.ReservationURL)
+	}
+}
+
+func TestHotPepperSearchWideBudget(t *testing.T) {
+	var query url.Values
+	h := newHotPepperServer(t, 200, []byte(`{"results":{"shop":[]}}`), &query)
+
+	// 3つ以上の予算コードにまたがる範囲は予算を指定せずに検索する
+	got, err := h.Search(context.Background(), domain.RestaurantSearchCriteria{Area: "渋谷", Genre: "もつ鍋", BudgetMin: 2000, BudgetMax: 6000})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if query.Has("budget") || query.Has("genre") || query.Get("keyword") != "渋谷 もつ鍋" {
+		t.Errorf("クエリ = %v, 予算・ジャンルコードなしでジャンル名をキーワードにすることを期待", query)
+	}
+	if got == nil || len(got) != 0 {
+		t.Errorf("Search() = %v, 空のスライスを期待", got)
+	}
+}
+
+func TestHotPepperErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{name: "APIキーが不正", status: 200, body: `{"results":{"error":[{"code":2000,"message":"認証エラー"}]}}`, wantErr: ErrUnavailable},
+		{name: "HTTPエラー", status: 503, body: ``, wantErr: ErrUnavailable},
+		{name: "不正なレスポンス", status: 200, body: `<html>`, wantErr: ErrUnavailable},
+		{name: "店舗が存在しない", status: 200, body: `{"results":{"shop":[]}}`, wantErr: ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHotPepperServer(t, tt.status, []byte(tt.body), nil)
+			if _, err := h.Get(context.Background(), "J000000000"); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Get() error = %v, %v を期待", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHotPepperErrorHidesAPIKey(t *testing.T) {
+	// 停止済みのサーバーに接続して通信エラーを起こす
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	h := NewHotPepper("secret-api-key", WithBaseURL(server.URL))
+
+	_, err := h.Get(context.Background(), "J000000000")
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("Get() error = %v, ErrUnavailable を期待", err)
+	}
+	if strings.Contains(err.Error(), "secret-api-key") {
+		t.Errorf("Get() error = %q, APIキーを含まないことを期待", err.Error())
+	}
+}
@@ -0,0 +1,59 @@
+// Package restaurant は飲み会の候補となる店舗の検索（レストラン検索プロバイダー）を提供する
+//
+// 外部サービスごとの差異は Provider の実装（HotPepper など）で吸収し、
+// 結果は domain.Restaurant の共通形式で返す。ローカル開発やテストでは
+// ネットワークを使わない Fake を使用する。
+package restaurant
+
+import (
+	"context"
+	"errors"
+
+	"github.com/luck-tech/kanji-log/backend/internal/domain"
+)
+
+// DefaultCount は検索件数が指定されていない場合の取得件数
+const DefaultCount = 20
+
+// プロバイダーが返すエラー
+var (
+	// ErrNotFound は指定した店舗IDの店舗が存在しないことを表す
+	ErrNotFound = errors.New("店舗が見つかりません")
+
+	// ErrUnavailable は外部サービスの呼び出しに失敗したことを表す（通信エラー・利用制限など）
+	ErrUnavailable = errors.New("レストラン検索サービスを利用できません")
+)
+
+// Provider はレストラン検索プロバイダー
+// 実装は外部サービスへの通信エラーを ErrUnavailable でラップして返す
+type Provider interface {
+	// Name はプロバイダー名（domain.Restaurant.Provider に設定する値）を返す
+	Name() string
+
+	// Search は条件に一致する店舗を返す（一致しない場合は空のスライス）
+	// Count が 0 の場合は DefaultCount 件まで返す
+	Search(ctx context.Context, criteria domain.RestaurantSearchCriteria) ([]domain.Restaurant, error)
+
+	// Get は店舗IDで店舗の詳細を返す（存在しない場合は ErrNotFound）
+	Get(ctx context.Context, id string) (*domain.Restaurant, error)
+}
+
+// searchCount は取得件数の既定値を補完した件数を返す
+func searchCount(criteria domain.RestaurantSearchCriteria) int {
+	if criteria.Count <= 0 {
+		return DefaultCount
+	}
+	return criteria.Count
+}
+
+// budgetOverlaps は店舗の予算帯が検索条件の予算の範囲と重なるかを返す
+// 上限・下限の 0 は「指定なし」として扱う
+func budgetOverlaps(restaurantMin int, restaurantMax int, criteria domain.RestaurantSearchCriteria) bool {
+	if criteria.BudgetMax > 0 && restaurantMin > criteria.BudgetMax {
+		return false
+	}
+	if criteria.BudgetMin > 0 && restaurantMax > 0 && restaurantMax < criteria.BudgetMin {
+		return false
+	}
+	return true
+}
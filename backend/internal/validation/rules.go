@@ -0,0 +1,248 @@
+package validation
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+)
+
+// エラーコード一覧
+// フロントエンドはこのコードで入力欄のエラー表示を切り替えるため、値を変更してはならない
+const (
+	// CodeRequired は必須項目が未入力
+	CodeRequired = "REQUIRED"
+
+	// CodeTooShort は文字数・要素数が下限未満（params.min）
+	CodeTooShort = "TOO_SHORT"
+
+	// CodeTooLong は文字数・要素数が上限超過（params.max）
+	CodeTooLong = "TOO_LONG"
+
+	// CodeTooSmall は数値が下限未満（params.min）
+	CodeTooSmall = "TOO_SMALL"
+
+	// CodeTooLarge は数値が上限超過（params.max）
+	CodeTooLarge = "TOO_LARGE"
+
+	// CodeInvalidChoice は許可された値以外（params.allowed）
+	CodeInvalidChoice = "INVALID_CHOICE"
+
+	// CodeInvalidFormat は日付・時刻などの形式が不正（params.format）
+	CodeInvalidFormat = "INVALID_FORMAT"
+
+	// CodePastDate は過去の日付
+	CodePastDate = "PAST_DATE"
+
+	// CodeInvalidCombination は他のフィールドとの組み合わせが不正（タグではなくハンドラーで判定）
+	CodeInvalidCombination = "INVALID_COMBINATION"
+)
+
+// builtinRules は New() で登録される組み込みルール
+func builtinRules() map[string]Rule {
+	return map[string]Rule{
+		// required: 空文字（空白のみを含む）・ゼロ値・nil を禁止
+		"required": {
+			Code: CodeRequired,
+			Check: func(f Field, _ string) bool {
+				return !isZero(f.Value)
+			},
+			Messages: map[i18n.Language]string{
+				i18n.Japanese: "{label}は必須です",
+				i18n.English:  "{label} is required",
+			},
+		},
+
+		// min: 文字列は最小文字数（rune数）、スライスは最小要素数
+		"min": {
+			Code: CodeTooShort,
+			Check: func(f Field, param string) bool {
+				n, ok := length(f.Value)
+				return ok && float64(n) >= mustParseNumber(param)
+			},
+			Messages: map[i18n.Language]string{
+				i18n.Japanese: "{label}は{param}{unit}以上で入力してください",
+				i18n.English:  "{label} must be at least {param} {unit}",
+			},
+			Params: numberParam("min"),
+		},
+
+		// max: 文字列は最大文字数（rune数）、スライスは最大要素数
+		"max": {
+			Code: CodeTooLong,
+			Check: func(f Field, param string) bool {
+				n, ok := length(f.Value)
+				return ok && float64(n) <= mustParseNumber(param)
+			},
+			Messages: map[i18n.Language]string{
+				i18n.Japanese: "{label}は{param}{unit}以内で入力してください",
+				i18n.English:  "{label} must be at most {param} {unit}",
+			},
+			Params: numberParam("max"),
+		},
+
+		// gte: 数値の下限（以上）
+		"gte": {
+			Code: CodeTooSmall,
+			Check: func(f Field, param string) bool {
+				n, ok := number(f.Value)
+				return ok && n >= mustParseNumber(param)
+			},
+			Messages: map[i18n.Language]string{
+				i18n.Japanese: "{label}は{param}以上で入力してください",
+				i18n.English:  "{label} must be {param} or more",
+			},
+			Params: numberParam("min"),
+		},
+
+		// lte: 数値の上限（以下）
+		"lte": {
+			Code: CodeTooLarge,
+			Check: func(f Field, param string) bool {
+				n, ok := number(f.Value)
+				return ok && n <= mustParseNumber(param)
+			},
+			Messages: map[i18n.Language]string{
+				i18n.Japanese: "{label}は{param}以下で入力してください",
+				i18n.English:  "{label} must be {param} or less",
+			},
+			Params: numberParam("max"),
+		},
+
+		// oneof: 空白区切りで列挙された値のいずれか
+		"oneof": {
+			Code: CodeInvalidChoice,
+			Check: func(f Field, param string) bool {
+				value := indirect(f.Value)
+				if value.Kind() != reflect.String {
+					return false
+				}
+				for _, allowed := range strings.Fields(param) {
+					if value.String() == allowed {
+						return true
+					}
+				}
+				return false
+			},
+			Messages: map[i18n.Language]string{
+				i18n.Japanese: "無効な{label}です: {value}",
+				i18n.English:  "Invalid {label}: {value}",
+			},
+			Params: func(_ Field, param string) map[string]interface{} {
+				return map[string]interface{}{"allowed": strings.Fields(param)}
+			},
+		},
+
+		// datetime: Goのレイアウト文字列（例: 2006-01-02, 15:04）に厳密に一致
+		"datetime": {
+			Code: CodeInvalidFormat,
+			Check: func(f Field, param string) bool {
+				_, ok := parseStrict(f.Value, param)
+				return ok
+			},
+			Messages: map[i18n.Language]string{
+				i18n.Japanese: "{label}の形式が正しくありません（{format}形式で入力してください）",
+				i18n.English:  "{label} must be in {format} format",
+			},
+			Params: func(_ Field, param string) map[string]interface{} {
+				return map[string]interface{}{"format": displayFormat(param)}
+			},
+		},
+	}
+}
+
+// NotPast は日付が過去でないこと（当日は可）を検証するカスタムルールを返す
+// 基準日は c.Now() のUTC日付。param には日付のレイアウト（例: 2006-01-02）を指定する
+//
+// 使用例:
+//
+//	v.Register("notpast", validation.NotPast(clock))
+//	Date string `validate:"omitempty,datetime=2006-01-02,notpast=2006-01-02"`
+func NotPast(c clock.Clock) Rule {
+	return Rule{
+		Code: CodePastDate,
+		Check: func(f Field, param string) bool {
+			date, ok := parseStrict(f.Value, param)
+			if !ok {
+				return false
+			}
+			today := c.Now().Truncate(24 * time.Hour)
+			return !date.Truncate(24 * time.Hour).Before(today)
+		},
+		Messages: map[i18n.Language]string{
+			i18n.Japanese: "過去の{label}は指定できません: {value}",
+			i18n.English:  "{label} cannot be in the past: {value}",
+		},
+		Params: func(_ Field, param string) map[string]interface{} {
+			return map[string]interface{}{"today": c.Now().Format(param)}
+		},
+	}
+}
+
+// numberParam はタグの数値パラメータを key に格納する Params 関数を返す
+// 例: numberParam("max") と max=100 → {"max": 100}
+func numberParam(key string) func(Field, string) map[string]interface{} {
+	return func(_ Field, param string) map[string]interface{} {
+		n := mustParseNumber(param)
+		if n == float64(int64(n)) {
+			return map[string]interface{}{key: int64(n)}
+		}
+		return map[string]interface{}{key: n}
+	}
+}
+
+// parseStrict は文字列を layout で解析し、再フォーマットした結果が入力と一致する場合のみ成功とする
+// time.Parse は "9:00" を "15:04" として受け付けてしまうため、ゼロ埋めまで厳密に確認する
+func parseStrict(value reflect.Value, layout string) (time.Time, bool) {
+	value = indirect(value)
+	if value.Kind() != reflect.String {
+		return time.Time{}, false
+	}
+	parsed, err := time.Parse(layout, value.String())
+	if err != nil || parsed.Format(layout) != value.String() {
+		return time.Time{}, false
+	}
+	return parsed, true
+}
+
+// length は文字列の文字数（rune数）、スライス・マップの要素数を返す
+func length(value reflect.Value) (int, bool) {
+	value = indirect(value)
+	switch value.Kind() {
+	case reflect.String:
+		return utf8.RuneCountInString(value.String()), true
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return value.Len(), true
+	default:
+		return 0, false
+	}
+}
+
+// number は数値型の値を float64 で返す
+func number(value reflect.Value) (float64, bool) {
+	value = indirect(value)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// mustParseNumber はタグのパラメータを数値に変換する
+// タグはコードに埋め込まれた定数のため、不正な値はプログラムの誤りとしてpanicする
+func mustParseNumber(param string) float64 {
+	n, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		panic("validation: 数値パラメータが不正です: " + param)
+	}
+	return n
+}
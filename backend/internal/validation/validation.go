@@ -0,0 +1,311 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+)
+
+// FieldError は1つのフィールドで発生したバリデーションエラー
+// フロントエンドが入力欄ごとにエラーを表示できるよう、JSONフィールド名で識別する
+//
+// JSON例:
+//
+//	{"field": "title", "code": "TOO_LONG", "message": "イベントタイトルは100文字以内で入力してください", "params": {"max": 100}}
+type FieldError struct {
+	// Field はエラーが発生したフィールドのJSON名（ネストは "budget.perPerson" のようにドット区切り）
+	Field string `json:"field"`
+
+	// Code は機械判定用の安定したエラーコード（例: "REQUIRED", "TOO_LONG"）
+	// メッセージの文言や言語が変わってもコードは変わらない
+	Code string `json:"code"`
+
+	// Message はリクエストの言語に合わせたユーザー向けエラーメッセージ
+	Message string `json:"message"`
+
+	// Params はメッセージの組み立てに使ったパラメータ（例: {"max": 100}）
+	// フロントエンドが独自の文言を表示する場合に使用する
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// Errors は構造体全体のバリデーションエラー一覧
+// 最初のエラーで止めず、全フィールドのエラーをまとめて返す
+type Errors []FieldError
+
+// Error はすべてのエラーメッセージを連結した文字列を返す
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fe := range e {
+		messages = append(messages, fe.Message)
+	}
+	return strings.Join(messages, "、")
+}
+
+// Field はルール関数に渡される検証対象フィールドの情報
+type Field struct {
+	// Name はフィールドのJSON名
+	Name string
+
+	// Value はフィールドの値
+	Value reflect.Value
+
+	// labels は言語ごとの表示名（label タグ: 日本語, label_en タグ: 英語）
+	labels map[i18n.Language]string
+}
+
+// Label は指定言語の表示名を返す（タグ未指定時はJSON名）
+func (f Field) Label(lang i18n.Language) string {
+	if label := f.labels[lang]; label != "" {
+		return label
+	}
+	return f.Name
+}
+
+// RuleFunc はルールの判定関数
+// 値が妥当な場合に true を返す
+type RuleFunc func(field Field, param string) bool
+
+// Rule はバリデーションルールの定義
+type Rule struct {
+	// Code は違反時に FieldError.Code に設定するエラーコード
+	Code string
+
+	// Check はルールの判定関数
+	Check RuleFunc
+
+	// Messages は言語ごとのエラーメッセージのテンプレート
+	// {label}: 表示名, {param}: パラメータ, {value}: 入力値,
+	// {format}: 日時レイアウトの表示形式（2006-01-02 → YYYY-MM-DD）,
+	// {unit}: 長さの単位（文字列は「文字」、スライスは「件」） に置換される
+	// 指定言語のテンプレートがない場合は日本語を使用する
+	Messages map[i18n.Language]string
+
+	// Params はタグのパラメータから FieldError.Params を組み立てる（任意）
+	Params func(field Field, param string) map[string]interface{}
+}
+
+// Validator は構造体の validate タグに従って入力値を検証する
+//
+// タグの書式（go-playground/validator と同じ）:
+//
+//	Title string `json:"title" label:"イベントタイトル" label_en:"Event title" validate:"required,min=1,max=100"`
+//
+// - ルールはカンマ区切りで左から順に評価し、フィールドごとに最初の違反のみ報告
+// - omitempty を指定したフィールドはゼロ値の場合に以降のルールをスキップ
+// - 文字列の min/max はバイト数ではなく文字数（rune数）で判定
+type Validator struct {
+	rules map[string]Rule
+}
+
+// New は組み込みルールを登録済みのValidatorを作成
+func New() *Validator {
+	v := &Validator{rules: make(map[string]Rule)}
+	for name, rule := range builtinRules() {
+		v.Register(name, rule)
+	}
+	return v
+}
+
+// Register はカスタムルールを登録する
+// 同名のルールが既に存在する場合は上書きする
+func (v *Validator) Register(name string, rule Rule) {
+	v.rules[name] = rule
+}
+
+// Validate は構造体（またはそのポインタ）を検証する
+// 違反がない場合は nil、ある場合は lang の言語のメッセージを持つ Errors を返す
+// 未登録のルール名がタグに含まれる場合はプログラムの誤りとしてpanicする
+func (v *Validator) Validate(s interface{}, lang i18n.Language) error {
+	value := reflect.ValueOf(s)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("validation: 構造体以外は検証できません: %s", value.Kind()))
+	}
+
+	var errs Errors
+	v.validateStruct(value, "", lang, &errs)
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// validateStruct は構造体の各フィールドを検証し、エラーを errs に追加する
+// 構造体型のフィールドは再帰的に検証する（prefix にはドット区切りの親フィールド名）
+func (v *Validator) validateStruct(value reflect.Value, prefix string, lang i18n.Language, errs *Errors) {
+	typ := value.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		name := jsonName(sf)
+		if name == "-" {
+			continue
+		}
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		field := Field{
+			Name:  name,
+			Value: value.Field(i),
+			labels: map[i18n.Language]string{
+				i18n.Japanese: sf.Tag.Get("label"),
+				i18n.English:  sf.Tag.Get("label_en"),
+			},
+		}
+
+		if fe, ok := v.validateField(field, sf.Tag.Get("validate"), lang); !ok {
+			*errs = append(*errs, fe)
+			continue
+		}
+
+		// ネストした構造体（およびそのポインタ）は再帰的に検証
+		nested := field.Value
+		if nested.Kind() == reflect.Ptr {
+			if nested.IsNil() {
+				continue
+			}
+			nested = nested.Elem()
+		}
+		if nested.Kind() == reflect.Struct && !isScalarStruct(nested.Type()) {
+			v.validateStruct(nested, name, lang, errs)
+		}
+	}
+}
+
+// validateField は1つのフィールドにタグのルールを順に適用する
+// 違反があった場合は最初の違反を FieldError として返す
+func (v *Validator) validateField(field Field, tag string, lang i18n.Language) (FieldError, bool) {
+	if tag == "" {
+		return FieldError{}, true
+	}
+
+	for _, part := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if name == "" {
+			continue
+		}
+
+		if name == "omitempty" {
+			if isZero(field.Value) {
+				return FieldError{}, true
+			}
+			continue
+		}
+
+		rule, exists := v.rules[name]
+		if !exists {
+			panic(fmt.Sprintf("validation: 未登録のルールです: %s", name))
+		}
+
+		if !rule.Check(field, param) {
+			fe := FieldError{
+				Field:   field.Name,
+				Code:    rule.Code,
+				Message: formatMessage(rule, field, param, lang),
+			}
+			if rule.Params != nil {
+				fe.Params = rule.Params(field, param)
+			}
+			return fe, false
+		}
+	}
+
+	return FieldError{}, true
+}
+
+// formatMessage はルールのメッセージテンプレートを指定言語で組み立てる
+func formatMessage(rule Rule, field Field, param string, lang i18n.Language) string {
+	template, exists := rule.Messages[lang]
+	if !exists {
+		template = rule.Messages[i18n.Japanese]
+	}
+
+	return strings.NewReplacer(
+		"{label}", field.Label(lang),
+		"{param}", param,
+		"{value}", fmt.Sprint(indirect(field.Value).Interface()),
+		"{format}", displayFormat(param),
+		"{unit}", lengthUnit(field.Value, lang),
+	).Replace(template)
+}
+
+// layoutFormat はGoの日時レイアウトを利用者向けの表記に変換する
+var layoutFormat = strings.NewReplacer(
+	"2006", "YYYY",
+	"01", "MM",
+	"02", "DD",
+	"15", "HH",
+	"04", "MM",
+)
+
+// displayFormat は日時レイアウトを利用者向けの表記（例: YYYY-MM-DD）で返す
+func displayFormat(layout string) string {
+	return layoutFormat.Replace(layout)
+}
+
+// lengthUnit は min/max のメッセージに使う長さの単位を返す
+func lengthUnit(value reflect.Value, lang i18n.Language) string {
+	isString := indirect(value).Kind() == reflect.String
+	switch {
+	case lang == i18n.English && isString:
+		return "characters"
+	case lang == i18n.English:
+		return "items"
+	case isString:
+		return "文字"
+	default:
+		return "件"
+	}
+}
+
+// jsonName は構造体フィールドのJSON名を返す（json タグ未指定時はフィールド名）
+func jsonName(sf reflect.StructField) string {
+	tag := sf.Tag.Get("json")
+	if tag == "" {
+		return sf.Name
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return sf.Name
+	}
+	return name
+}
+
+// isScalarStruct は time.Time のように単一の値として扱う構造体型かを判定する
+func isScalarStruct(typ reflect.Type) bool {
+	return typ.PkgPath() == "time"
+}
+
+// isZero は値がゼロ値（空文字、0、nil、空スライス等）かを判定する
+// 文字列は空白のみの場合もゼロ値として扱う
+func isZero(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(value.String()) == ""
+	case reflect.Slice, reflect.Map:
+		return value.IsNil() || value.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	default:
+		return value.IsZero()
+	}
+}
+
+// indirect はポインタを辿って実体の値を返す（nil の場合はそのまま返す）
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	return value
+}
@@ -0,0 +1,157 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luck-tech/kanji-log/backend/internal/clock"
+	"github.com/luck-tech/kanji-log/backend/internal/i18n"
+)
+
+type testBudget struct {
+	PerPerson int `json:"perPerson" label:"一人あたり予算" label_en:"Budget per person" validate:"gte=0,lte=50000"`
+}
+
+type testRequest struct {
+	Title   string      `json:"title" label:"タイトル" label_en:"Title" validate:"required,min=2,max=5"`
+	Kind    string      `json:"kind,omitempty" label:"種類" label_en:"Kind" validate:"omitempty,oneof=a b"`
+	Date    string      `json:"date,omitempty" label:"日付" label_en:"Date" validate:"omitempty,datetime=2006-01-02,notpast=2006-01-02"`
+	Time    string      `json:"time,omitempty" label:"時刻" label_en:"Time" validate:"omitempty,datetime=15:04"`
+	Tags    []string    `json:"tags,omitempty" validate:"max=2"`
+	Budget  *testBudget `json:"budget,omitempty"`
+	Ignored string      `json:"-" validate:"required"`
+}
+
+func newTestValidator() *Validator {
+	v := New()
+	v.Register("notpast", NotPast(clock.NewFixedClock(time.Date(2025, 9, 10, 12, 0, 0, 0, time.UTC))))
+	return v
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  testRequest
+		want []FieldError
+	}{
+		{
+			name: "すべて妥当",
+			req:  testRequest{Title: "歓迎会", Kind: "a", Date: "2025-09-10", Time: "19:00", Tags: []string{"x"}, Budget: &testBudget{PerPerson: 4000}},
+		},
+		{
+			name: "必須項目が空白のみ",
+			req:  testRequest{Title: "　 "},
+			want: []FieldError{{Field: "title", Code: CodeRequired, Message: "タイトルは必須です"}},
+		},
+		{
+			name: "文字数は rune 単位で数える",
+			req:  testRequest{Title: "あいうえおか"},
+			want: []FieldError{{Field: "title", Code: CodeTooLong, Message: "タイトルは5文字以内で入力してください", Params: map[string]interface{}{"max": int64(5)}}},
+		},
+		{
+			name: "最小文字数",
+			req:  testRequest{Title: "あ"},
+			want: []FieldError{{Field: "title", Code: CodeTooShort, Message: "タイトルは2文字以上で入力してください", Params: map[string]interface{}{"min": int64(2)}}},
+		},
+		{
+			name: "複数フィールドのエラーをまとめて返す",
+			req:  testRequest{Title: "", Kind: "c", Date: "2024-02-30", Time: "9:00", Tags: []string{"1", "2", "3"}},
+			want: []FieldError{
+				{Field: "title", Code: CodeRequired, Message: "タイトルは必須です"},
+				{Field: "kind", Code: CodeInvalidChoice, Message: "無効な種類です: c", Params: map[string]interface{}{"allowed": []string{"a", "b"}}},
+				{Field: "date", Code: CodeInvalidFormat, Message: "日付の形式が正しくありません（YYYY-MM-DD形式で入力してください）", Params: map[string]interface{}{"format": "YYYY-MM-DD"}},
+				{Field: "time", Code: CodeInvalidFormat, Message: "時刻の形式が正しくありません（HH:MM形式で入力してください）", Params: map[string]interface{}{"format": "HH:MM"}},
+				{Field: "tags", Code: CodeTooLong, Message: "tagsは2件以内で入力してください", Params: map[string]interface{}{"max": int64(2)}},
+			},
+		},
+		{
+			name: "過去日",
+			req:  testRequest{Title: "歓迎会", Date: "2025-09-09"},
+			want: []FieldError{{Field: "date", Code: CodePastDate, Message: "過去の日付は指定できません: 2025-09-09", Params: map[string]interface{}{"today": "2025-09-10"}}},
+		},
+		{
+			name: "ネストした構造体はドット区切りのフィールド名",
+			req:  testRequest{Title: "歓迎会", Budget: &testBudget{PerPerson: -1}},
+			want: []FieldError{{Field: "budget.perPerson", Code: CodeTooSmall, Message: "一人あたり予算は0以上で入力してください", Params: map[string]interface{}{"min": int64(0)}}},
+		},
+	}
+
+	v := newTestValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Validate(&tt.req, i18n.Japanese)
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+
+			var got Errors
+			if !errors.As(err, &got) {
+				t.Fatalf("Validate() error = %v, validation.Errors を期待", err)
+			}
+			if !reflect.DeepEqual([]FieldError(got), tt.want) {
+				t.Errorf("Validate() =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateEnglishMessages(t *testing.T) {
+	req := testRequest{Title: "", Kind: "c", Date: "2025-09-09", Time: "9:00", Tags: []string{"1", "2", "3"}, Budget: &testBudget{PerPerson: 60000}}
+	want := []string{
+		"Title is required",
+		"Invalid Kind: c",
+		"Date cannot be in the past: 2025-09-09",
+		"Time must be in HH:MM format",
+		"tags must be at most 2 items",
+		"Budget per person must be 50000 or less",
+	}
+
+	var got Errors
+	if !errors.As(newTestValidator().Validate(&req, i18n.English), &got) {
+		t.Fatal("validation.Errors を期待しました")
+	}
+	if len(got) != len(want) {
+		t.Fatalf("エラー件数 = %d, %d を期待: %+v", len(got), len(want), got)
+	}
+	for i, fe := range got {
+		if fe.Message != want[i] {
+			t.Errorf("[%d] Message = %q, %q を期待", i, fe.Message, want[i])
+		}
+	}
+}
+
+func TestValidateCodesDoNotDependOnLanguage(t *testing.T) {
+	v := newTestValidator()
+	req := testRequest{Title: "あいうえおか"}
+
+	var ja, en Errors
+	errors.As(v.Validate(&req, i18n.Japanese), &ja)
+	errors.As(v.Validate(&req, i18n.English), &en)
+	if len(ja) != 1 || len(en) != 1 || ja[0].Code != en[0].Code || !reflect.DeepEqual(ja[0].Params, en[0].Params) {
+		t.Errorf("言語によってコード・パラメータが変化しました: ja=%+v en=%+v", ja, en)
+	}
+}
+
+func TestErrorsError(t *testing.T) {
+	errs := Errors{{Message: "タイトルは必須です"}, {Message: "時刻の形式が正しくありません"}}
+	if got := errs.Error(); !strings.Contains(got, "タイトルは必須です") || !strings.Contains(got, "時刻の形式が正しくありません") {
+		t.Errorf("Error() = %q", got)
+	}
+}
+
+func TestValidateUnknownRulePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("未登録ルールで panic を期待しました")
+		}
+	}()
+	_ = New().Validate(&struct {
+		Name string `validate:"unknown"`
+	}{}, i18n.Japanese)
+}
@@ -68,11 +68,25 @@ module "iam" {
   
   environment        = "dev"                      # 環境名（ロール名に付与される）
   dynamodb_table_arn = module.dynamodb.table_arn # DynamoDBテーブルのARN（他モジュールからの参照）
+
+  additional_table_arns = [
+    module.dynamodb.idempotency_table_arn,  # Idempotency-Key の保存先
+    module.dynamodb.audit_table_arn,        # 監査ログ（イベントの変更履歴）
+    module.dynamodb.template_table_arn,     # イベントテンプレート（ローカルサーバーから利用）
+    module.dynamodb.series_table_arn,       # 定期開催シリーズ（ローカルサーバーから利用）
+    module.dynamodb.record_table_arn,       # 開催記録（ローカルサーバーから利用）
+  ]
 }
 
 # Lambda関数（サーバーレス関数群）
 # 汎用的なlambdaモジュールを使用して複数の関数を実体化
 
+# CORSを許可するフロントエンドのオリジン（全Lambda共通）
+# dev環境ではローカルのExpo Web開発サーバーのみ許可する
+locals {
+  cors_allowed_origins = ["http://localhost:8081"]
+}
+
 # Hello Lambda関数（テスト用）
 module "hello_lambda" {
   source        = "../../modules/lambda"
@@ -80,6 +94,8 @@ module "hello_lambda" {
   environment   = "dev"
   role_arn      = module.iam.lambda_execution_role_arn
   source_file   = "../../../backend/hello-lambda.zip"
+
+  cors_allowed_origins = local.cors_allowed_origins
 }
 
 # Create Event Lambda関数（イベント作成API）
@@ -90,6 +106,16 @@ module "create_event_lambda" {
   role_arn      = module.iam.lambda_execution_role_arn
   source_file   = "../../../backend/create-event-lambda.zip"
   table_name    = module.dynamodb.table_name
+
+  cors_allowed_origins = local.cors_allowed_origins
+
+  # dev環境のみ x-organizer-id ヘッダーによる簡易認証を許可
+  # Cognitoオーソライザー導入後は削除する（prdでは絶対に設定しない）
+  extra_environment = {
+    AUTH_DEV_MODE          = "true"
+    IDEMPOTENCY_TABLE_NAME = module.dynamodb.idempotency_table_name  # 再送による重複作成の防止
+    AUDIT_TABLE_NAME       = module.dynamodb.audit_table_name        # イベント作成の監査ログ
+  }
 }
 
 # API Gateway（HTTPSエンドポイント）
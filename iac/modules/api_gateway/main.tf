@@ -159,15 +159,15 @@ resource "aws_api_gateway_integration" "events_post_lambda" {
 }
 
 # CORS プリフライト応答設定
-resource "aws_api_gateway_integration" "events_options_mock" {
+# 許可オリジンの判定はLambda側（CORS_ALLOWED_ORIGINS）で行うため、OPTIONS もLambdaに統合する
+resource "aws_api_gateway_integration" "events_options_lambda" {
   rest_api_id = aws_api_gateway_rest_api.main.id
   resource_id = aws_api_gateway_resource.events.id
   http_method = aws_api_gateway_method.events_options.http_method
 
-  type = "MOCK"
-  request_templates = {
-    "application/json" = "{\"statusCode\": 200}"
-  }
+  integration_http_method = "POST"
+  type                    = "AWS_PROXY"
+  uri                     = var.create_event_lambda_invoke_arn
 }
 
 # POST /events のレスポンス設定
@@ -216,20 +216,6 @@ resource "aws_api_gateway_method_response" "events_post_response_400" {
   }
 }
 
-# OPTIONS メソッドのレスポンス設定
-resource "aws_api_gateway_method_response" "events_options_response_200" {
-  rest_api_id = aws_api_gateway_rest_api.main.id
-  resource_id = aws_api_gateway_resource.events.id
-  http_method = aws_api_gateway_method.events_options.http_method
-  status_code = "200"
-
-  response_parameters = {
-    "method.response.header.Access-Control-Allow-Headers" = true
-    "method.response.header.Access-Control-Allow-Methods" = true
-    "method.response.header.Access-Control-Allow-Origin"  = true
-  }
-}
-
 # 統合レスポンス設定
 resource "aws_api_gateway_integration_response" "events_post_integration_response" {
   rest_api_id = aws_api_gateway_rest_api.main.id
@@ -244,21 +230,6 @@ resource "aws_api_gateway_integration_response" "events_post_integration_respons
   depends_on = [aws_api_gateway_integration.events_post_lambda]
 }
 
-resource "aws_api_gateway_integration_response" "events_options_integration_response" {
-  rest_api_id = aws_api_gateway_rest_api.main.id
-  resource_id = aws_api_gateway_resource.events.id
-  http_method = aws_api_gateway_method.events_options.http_method
-  status_code = aws_api_gateway_method_response.events_options_response_200.status_code
-
-  response_parameters = {
-    "method.response.header.Access-Control-Allow-Headers" = "'Content-Type,X-Amz-Date,Authorization,X-Api-Key,X-Amz-Security-Token,x-organizer-id'"
-    "method.response.header.Access-Control-Allow-Methods" = "'POST,OPTIONS'"
-    "method.response.header.Access-Control-Allow-Origin"  = "'*'"
-  }
-
-  depends_on = [aws_api_gateway_integration.events_options_mock]
-}
-
 # リクエストバリデーター
 resource "aws_api_gateway_request_validator" "events_validator" {
   name                        = "${var.api_name}-${var.environment}-events-validator"
@@ -315,8 +286,7 @@ resource "aws_api_gateway_deployment" "main" {
     aws_api_gateway_integration_response.hello_integration_response,
     aws_api_gateway_integration.events_post_lambda,
     aws_api_gateway_integration_response.events_post_integration_response,
-    aws_api_gateway_integration.events_options_mock,
-    aws_api_gateway_integration_response.events_options_integration_response,
+    aws_api_gateway_integration.events_options_lambda,
   ]
 
   rest_api_id = aws_api_gateway_rest_api.main.id
@@ -332,7 +302,7 @@ resource "aws_api_gateway_deployment" "main" {
       aws_api_gateway_method.events_post.id,
       aws_api_gateway_integration.events_post_lambda.id,
       aws_api_gateway_method.events_options.id,
-      aws_api_gateway_integration.events_options_mock.id,
+      aws_api_gateway_integration.events_options_lambda.id,
     ]))
   }
 
@@ -49,6 +49,141 @@ resource "aws_dynamodb_table" "events" {
   }
 }
 
+# 冪等性レコードテーブルの作成
+# POST /events の Idempotency-Key ごとに初回のレスポンスを保存し、
+# タイムアウト後の再送でイベントが重複作成されるのを防ぐ
+resource "aws_dynamodb_table" "idempotency" {
+  name         = "kanji-log-idempotency-${var.environment}"  # 例: kanji-log-idempotency-dev
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "key"                                       # "<幹事ID>#<Idempotency-Key>"
+
+  attribute {
+    name = "key"
+    type = "S"
+  }
+
+  # 24時間経過したレコードを自動削除（expiresAt は Unix 秒）
+  # TTL による削除は遅延するため、アプリ側でも有効期限を判定している
+  ttl {
+    attribute_name = "expiresAt"
+    enabled        = true
+  }
+
+  tags = {
+    Name        = "kanji-log-idempotency-${var.environment}"
+    Environment = var.environment
+    Project     = "kanji-log"
+  }
+}
+
+# 監査ログテーブルの作成
+# イベントの作成・更新・削除ごとに変更者と差分を追記し、GET /events/{eventId}/activity で参照する
+resource "aws_dynamodb_table" "audit" {
+  name         = "kanji-log-event-audit-${var.environment}"  # 例: kanji-log-event-audit-dev
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "eventId"                                   # イベントごとに履歴をまとめる
+  range_key    = "sk"                                        # "<記録日時>#<監査ログID>"（記録順に並ぶ）
+
+  attribute {
+    name = "eventId"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "S"
+  }
+
+  tags = {
+    Name        = "kanji-log-event-audit-${var.environment}"
+    Environment = var.environment
+    Project     = "kanji-log"
+  }
+}
+
+# イベントテンプレートテーブルの作成
+# /templates 系のルートはローカルサーバーのみで提供しているため、Lambda からは参照しない
+# ローカルサーバーを TEMPLATE_TABLE_NAME でこのテーブルに向けて使用する
+resource "aws_dynamodb_table" "templates" {
+  name         = "kanji-log-event-templates-${var.environment}"  # 例: kanji-log-event-templates-dev
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  # 幹事が保存したテンプレート一覧の取得（ListTemplatesByOrganizer）で使用
+  global_secondary_index {
+    name            = "OrganizerIndex"
+    hash_key        = "organizerId"
+    projection_type = "ALL"
+  }
+
+  attribute {
+    name = "organizerId"
+    type = "S"
+  }
+
+  tags = {
+    Name        = "kanji-log-event-templates-${var.environment}"
+    Environment = var.environment
+    Project     = "kanji-log"
+  }
+}
+
+# 定期開催シリーズテーブルの作成
+# 開催回はイベントテーブルに seriesId 付きで保存するため、このテーブルは繰り返しルールのみを持つ
+# /series 系のルートはローカルサーバーのみで提供しているため、SERIES_TABLE_NAME で参照する
+resource "aws_dynamodb_table" "series" {
+  name         = "kanji-log-event-series-${var.environment}"  # 例: kanji-log-event-series-dev
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  tags = {
+    Name        = "kanji-log-event-series-${var.environment}"
+    Environment = var.environment
+    Project     = "kanji-log"
+  }
+}
+
+# 開催記録テーブルの作成
+# /records 系のルートはローカルサーバーのみで提供しているため、RECORD_TABLE_NAME で参照する
+resource "aws_dynamodb_table" "records" {
+  name         = "kanji-log-event-records-${var.environment}"  # 例: kanji-log-event-records-dev
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  # 幹事自身の記録一覧・個人統計（ListRecordsByOrganizer）で使用
+  global_secondary_index {
+    name            = "OrganizerIndex"
+    hash_key        = "organizerId"
+    projection_type = "ALL"
+  }
+
+  attribute {
+    name = "organizerId"
+    type = "S"
+  }
+
+  tags = {
+    Name        = "kanji-log-event-records-${var.environment}"
+    Environment = var.environment
+    Project     = "kanji-log"
+  }
+}
+
 # =============================================================================
 # アウトプット値：他のモジュールや環境から参照される値
 # =============================================================================
@@ -62,3 +197,53 @@ output "table_arn" {
   description = "DynamoDBテーブルのARN（IAM権限設定で使用）"
   value       = aws_dynamodb_table.events.arn
 }
+
+output "idempotency_table_name" {
+  description = "冪等性レコードテーブルの完全な名前"
+  value       = aws_dynamodb_table.idempotency.name
+}
+
+output "idempotency_table_arn" {
+  description = "冪等性レコードテーブルのARN（IAM権限設定で使用）"
+  value       = aws_dynamodb_table.idempotency.arn
+}
+
+output "audit_table_name" {
+  description = "監査ログテーブルの完全な名前"
+  value       = aws_dynamodb_table.audit.name
+}
+
+output "audit_table_arn" {
+  description = "監査ログテーブルのARN（IAM権限設定で使用）"
+  value       = aws_dynamodb_table.audit.arn
+}
+
+output "template_table_name" {
+  description = "イベントテンプレートテーブルの完全な名前"
+  value       = aws_dynamodb_table.templates.name
+}
+
+output "template_table_arn" {
+  description = "イベントテンプレートテーブルのARN（IAM権限設定で使用）"
+  value       = aws_dynamodb_table.templates.arn
+}
+
+output "series_table_name" {
+  description = "定期開催シリーズテーブルの完全な名前"
+  value       = aws_dynamodb_table.series.name
+}
+
+output "series_table_arn" {
+  description = "定期開催シリーズテーブルのARN（IAM権限設定で使用）"
+  value       = aws_dynamodb_table.series.arn
+}
+
+output "record_table_name" {
+  description = "開催記録テーブルの完全な名前"
+  value       = aws_dynamodb_table.records.name
+}
+
+output "record_table_arn" {
+  description = "開催記録テーブルのARN（IAM権限設定で使用）"
+  value       = aws_dynamodb_table.records.arn
+}
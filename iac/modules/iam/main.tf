@@ -10,6 +10,12 @@ variable "dynamodb_table_arn" {
   type        = string
 }
 
+variable "additional_table_arns" {
+  description = "メインテーブル以外にアクセスを許可するDynamoDBテーブルのARN（冪等性レコード等）"
+  type        = list(string)
+  default     = []
+}
+
 variable "environment" {
   description = "環境名（ロール名に付与される）"
   type        = string
@@ -69,10 +75,14 @@ resource "aws_iam_role_policy" "dynamodb_policy" {
           "dynamodb:Query",           # 条件検索（GSI使用時等）
           "dynamodb:Scan"             # 全件スキャン
         ]
-        Resource = [
-          var.dynamodb_table_arn,                    # メインテーブル
-          "${var.dynamodb_table_arn}/index/*"        # 全てのGSI（Global Secondary Index）
-        ]
+        Resource = concat(
+          [
+            var.dynamodb_table_arn,                    # メインテーブル
+            "${var.dynamodb_table_arn}/index/*"        # 全てのGSI（Global Secondary Index）
+          ],
+          var.additional_table_arns,                   # 冪等性レコード等の補助テーブル
+          [for arn in var.additional_table_arns : "${arn}/index/*"]  # 補助テーブルのGSI（OrganizerIndex等）
+        )
       }
     ]
   })
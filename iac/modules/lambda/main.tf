@@ -33,6 +33,24 @@ variable "table_name" {
   default     = ""
 }
 
+variable "extra_environment" {
+  description = "追加の環境変数（例: AUTH_DEV_MODE, AUTH_JWKS_FILE）"
+  type        = map(string)
+  default     = {}
+}
+
+variable "cors_allowed_origins" {
+  description = "CORSを許可するオリジン（空の場合はどのオリジンも許可しない）"
+  type        = list(string)
+  default     = []
+}
+
+variable "log_level" {
+  description = "ログの出力レベル（debug / info / warn / error）"
+  type        = string
+  default     = "info"
+}
+
 variable "timeout" {
   description = "Lambda関数のタイムアウト（秒）"
   type        = number
@@ -63,8 +81,11 @@ resource "aws_lambda_function" "this" {
     variables = merge(
       {
         ENVIRONMENT = var.environment
+        LOG_LEVEL   = var.log_level
       },
-      var.table_name != "" ? { TABLE_NAME = var.table_name } : {}
+      var.table_name != "" ? { TABLE_NAME = var.table_name } : {},
+      length(var.cors_allowed_origins) > 0 ? { CORS_ALLOWED_ORIGINS = join(",", var.cors_allowed_origins) } : {},
+      var.extra_environment
     )
   }
 